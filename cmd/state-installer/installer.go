@@ -62,7 +62,7 @@ func (i *Installer) Install() (rerr error) {
 			prompter.SetForce(true)
 		}
 		defaultChoice := i.Params.nonInteractive
-		confirm, err := prompter.Confirm("", locale.T("installer_prompt_is_admin"), &defaultChoice, ptr.To(true))
+		confirm, err := prompter.Confirm(prompt.IDInstallerAdmin, "", locale.T("installer_prompt_is_admin"), &defaultChoice, ptr.To(true))
 		if err != nil {
 			return errs.Wrap(err, "Not confirmed")
 		}
@@ -0,0 +1,176 @@
+package mcpserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// StreamableHTTPEndpoint is where clients connect to with the streamable HTTP transport.
+	StreamableHTTPEndpoint = "/mcp"
+	// SSEEndpoint is where clients connect to with the SSE transport.
+	SSEEndpoint     = "/sse"
+	messageEndpoint = "/message"
+
+	// sessionIDHeader and sseSessionIDParam identify the session of requests to the streamable HTTP and SSE transports.
+	sessionIDHeader   = "Mcp-Session-Id"
+	sseSessionIDParam = "sessionId"
+)
+
+// shutdownTimeout is how long open connections are given to finish when the HTTP server shuts down.
+var shutdownTimeout = 5 * time.Second
+
+// NewToken returns a random token for clients of the HTTP transport to authenticate with.
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err, "Could not generate token")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HTTPHandler returns the handler serving the MCP server with the streamable HTTP transport at /mcp, and with the SSE
+// transport at /sse. Every request must carry the token as a bearer token. The base URL is what SSE clients are told
+// to post their messages to.
+func (m *Handler) HTTPHandler(baseURL, token string) http.Handler {
+	sse := server.NewSSEServer(m.Server,
+		server.WithBaseURL(baseURL),
+		server.WithSSEEndpoint(SSEEndpoint),
+		server.WithMessageEndpoint(messageEndpoint),
+	)
+
+	// Streamable HTTP clients receive responses in the response to their request, whereas SSE clients receive them on
+	// their event stream.
+	streamable := m.subscriptions.httpFilter(server.NewStreamableHTTPServer(m.Server),
+		func(r *http.Request) string { return r.Header.Get(sessionIDHeader) },
+		func(w http.ResponseWriter, _ string, response []byte) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(append(response, '\n'))
+		})
+	messages := m.subscriptions.httpFilter(sse,
+		func(r *http.Request) string { return r.URL.Query().Get(sseSessionIDParam) },
+		func(w http.ResponseWriter, session string, response []byte) {
+			if err := sse.SendEventToSession(session, json.RawMessage(response)); err != nil {
+				http.Error(w, "Could not respond to session", http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+	mux := http.NewServeMux()
+	mux.Handle(StreamableHTTPEndpoint, streamable)
+	mux.Handle(SSEEndpoint, sse)
+	mux.Handle(messageEndpoint, messages)
+	return authorize(token, mux)
+}
+
+// ServeLocalHTTP serves the MCP server over HTTP on the given loopback address until interrupted. Ready is called with
+// the base URL of the server once it accepts connections.
+func (m *Handler) ServeLocalHTTP(addr, token string, ready func(baseURL string)) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return errs.Wrap(err, "Invalid address: %s", addr)
+	}
+	if !isLoopback(host) {
+		return errs.New("The HTTP transport can only be bound to a loopback address, not %s", host)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errs.Wrap(err, "Could not listen on %s", addr)
+	}
+	baseURL := "http://" + listener.Addr().String()
+
+	srv := &http.Server{Handler: m.HTTPHandler(baseURL, token)}
+
+	ctx, cancel := signalContext()
+	defer cancel()
+	go m.subscriptions.watch(ctx, m.Server)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			logging.Debug("Could not shut down gracefully: %v", err)
+			srv.Close()
+		}
+	}()
+
+	ready(baseURL)
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return errs.Wrap(err, "Server error")
+	}
+	return nil
+}
+
+// authorize only lets requests through that carry the token, and that do not come from a web page on another host.
+func authorize(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Browsers send the origin of the page making the request, which must not be able to reach us.
+		if origin := r.Header.Get("Origin"); origin != "" {
+			u, err := url.Parse(origin)
+			if err != nil || !isLoopback(u.Hostname()) {
+				http.Error(w, "Forbidden origin", http.StatusForbidden)
+				return
+			}
+		}
+
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// httpFilter handles resource subscription requests posted to an HTTP transport, and passes all other requests on.
+// SessionID returns the session a request belongs to, and reply delivers the response to a subscription request.
+func (s *subscriptions) httpFilter(next http.Handler, sessionID func(*http.Request) string, reply func(w http.ResponseWriter, session string, response []byte)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session := sessionID(r)
+		// Requests outside of a session are left to the transport, which rejects them.
+		if r.Method != http.MethodPost || session == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Could not read request", http.StatusBadRequest)
+			return
+		}
+		if response, ok := s.handle(session, body); ok {
+			reply(w, session, response)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,123 @@
+package mcpserver
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHTTPServer(t *testing.T, token string) *httptest.Server {
+	m := New(func() (*primer.Values, func() error, error) {
+		return primer.New(), func() error { return nil }, nil
+	})
+	srv := httptest.NewUnstartedServer(nil)
+	srv.Config.Handler = m.HTTPHandler("http://"+srv.Listener.Addr().String(), token)
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func post(t *testing.T, url, token, origin, body string) (*http.Response, string) {
+	return postSession(t, url, token, origin, "", body)
+}
+
+func postSession(t *testing.T, url, token, origin, session, body string) (*http.Response, string) {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if session != "" {
+		req.Header.Set(sessionIDHeader, session)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(b)
+}
+
+func TestHTTPHandler(t *testing.T) {
+	token, err := NewToken()
+	require.NoError(t, err)
+	srv := newTestHTTPServer(t, token)
+	url := srv.URL + StreamableHTTPEndpoint
+	initialize := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`
+
+	resp, _ := post(t, url, "", "", initialize)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = post(t, url, "not-the-token", "", initialize)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = post(t, url, token, "https://example.com", initialize)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, "pages on other hosts must not reach the server")
+
+	resp, body := post(t, url, token, "http://localhost:3000", initialize)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `"serverInfo"`)
+	assert.Contains(t, body, `"subscribe":true`)
+
+	session := resp.Header.Get(sessionIDHeader)
+	require.NotEmpty(t, session)
+
+	resp, body = postSession(t, url, token, "", session, `{"jsonrpc":"2.0","id":2,"method":"resources/subscribe","params":{"uri":"state://project/ActiveState/cli/buildscript"}}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":2,"result":{}}`+"\n", body)
+}
+
+func TestHTTPHandler_SSESubscribe(t *testing.T) {
+	token, err := NewToken()
+	require.NoError(t, err)
+	srv := newTestHTTPServer(t, token)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+SSEEndpoint, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	events := bufio.NewReader(resp.Body)
+
+	// The first event tells the client where to post its messages to.
+	nextData := func() string {
+		for {
+			line, err := events.ReadString('\n')
+			require.NoError(t, err)
+			if data, ok := strings.CutPrefix(line, "data: "); ok {
+				return strings.TrimSpace(data)
+			}
+		}
+	}
+	endpoint := nextData()
+	require.Contains(t, endpoint, messageEndpoint)
+	if !strings.HasPrefix(endpoint, "http") {
+		endpoint = srv.URL + endpoint
+	}
+
+	resp, _ = post(t, endpoint, token, "", `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Contains(t, nextData(), `"serverInfo"`)
+
+	resp, _ = post(t, endpoint, token, "", `{"jsonrpc":"2.0","id":2,"method":"resources/subscribe","params":{"uri":"state://project/ActiveState/cli/buildscript"}}`)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":2,"result":{}}`, nextData(), "SSE clients receive the response on their event stream")
+}
+
+func TestServeLocalHTTPLoopbackOnly(t *testing.T) {
+	m := New(nil)
+	err := m.ServeLocalHTTP("0.0.0.0:0", "token", func(string) { t.Fatal("server must not start") })
+	assert.Error(t, err)
+}
@@ -0,0 +1,150 @@
+package mcpserver
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runbits/runtime/progress"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const methodNotificationProgress = "notifications/progress"
+
+// progressDrainTimeout is how long progress that was already written is still relayed once a tool call returns.
+var progressDrainTimeout = time.Second
+
+// progressRelay relays the runtime progress of a tool call to the client as progress notifications. Runtime progress
+// is written as NDJSON to a socket only this relay listens on, and every event becomes a notification.
+type progressRelay struct {
+	srv      *server.MCPServer
+	ctx      context.Context
+	token    mcp.ProgressToken
+	path     string
+	listener net.Listener
+	conns    []net.Conn
+	closed   bool
+	events   int
+	mutex    sync.Mutex
+	wg       sync.WaitGroup
+}
+
+// relayProgress starts relaying runtime progress if the client asked for progress notifications, and returns the
+// function that stops it.
+func (m *Handler) relayProgress(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (func(), error) {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return func() {}, nil
+	}
+
+	r, err := newProgressRelay(ctx, m.Server, request.Params.Meta.ProgressToken)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not relay progress")
+	}
+	target := strings.Join([]string{progress.NDJSONFormat, progress.TargetUnix, r.path}, ":")
+	if err := p.Config().SetOverride(constants.RuntimeProgressConfig, target); err != nil {
+		r.close()
+		return nil, errs.Wrap(err, "Could not override progress config")
+	}
+	return r.close, nil
+}
+
+func newProgressRelay(ctx context.Context, srv *server.MCPServer, token mcp.ProgressToken) (*progressRelay, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, errs.Wrap(err, "Could not generate socket name")
+	}
+	path := filepath.Join(os.TempDir(), fmt.Sprintf("state-mcp-progress-%s.sock", hex.EncodeToString(b)))
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not listen on progress socket")
+	}
+
+	r := &progressRelay{srv: srv, ctx: ctx, token: token, path: path, listener: listener}
+	r.wg.Add(1)
+	go r.accept()
+	return r, nil
+}
+
+func (r *progressRelay) accept() {
+	defer r.wg.Done()
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			return // the listener was closed
+		}
+		r.mutex.Lock()
+		r.conns = append(r.conns, conn)
+		if r.closed {
+			r.drain(conn)
+		}
+		r.mutex.Unlock()
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			defer conn.Close()
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				r.relay(scanner.Bytes())
+			}
+		}()
+	}
+}
+
+func (r *progressRelay) relay(line []byte) {
+	var record map[string]interface{}
+	if err := json.Unmarshal(line, &record); err != nil {
+		logging.Debug("Could not parse progress event: %v", err)
+		return
+	}
+
+	r.mutex.Lock()
+	r.events++
+	params := map[string]any{
+		"progressToken": r.token,
+		"progress":      r.events,
+		"message":       record["event"],
+	}
+	r.mutex.Unlock()
+
+	if err := r.srv.SendNotificationToClient(r.ctx, methodNotificationProgress, params); err != nil {
+		logging.Debug("Could not send progress notification: %v", err)
+	}
+}
+
+// close stops listening for progress and waits for the progress that was already written to be relayed.
+func (r *progressRelay) close() {
+	if err := r.listener.Close(); err != nil {
+		logging.Debug("Could not close progress socket: %v", err)
+	}
+	r.mutex.Lock()
+	r.closed = true
+	for _, conn := range r.conns {
+		r.drain(conn)
+	}
+	r.mutex.Unlock()
+	r.wg.Wait()
+	if err := os.Remove(r.path); err != nil && !os.IsNotExist(err) {
+		logging.Debug("Could not remove progress socket: %v", err)
+	}
+}
+
+// drain lets the connection be read for a little longer, so that progress that was already written is still relayed.
+func (r *progressRelay) drain(conn net.Conn) {
+	if err := conn.SetReadDeadline(time.Now().Add(progressDrainTimeout)); err != nil {
+		logging.Debug("Could not set progress read deadline: %v", err)
+	}
+}
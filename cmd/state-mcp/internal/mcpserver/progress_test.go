@@ -0,0 +1,66 @@
+package mcpserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ActiveState/cli/internal/runbits/runtime/progress"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *testSession) Initialize()                                         {}
+func (s *testSession) Initialized() bool                                   { return true }
+func (s *testSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return s.notifications }
+func (s *testSession) SessionID() string                                   { return "test" }
+
+func TestProgressRelay(t *testing.T) {
+	srv := server.NewMCPServer("test", "1")
+	session := &testSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	ctx := srv.WithContext(context.Background(), session)
+
+	r, err := newProgressRelay(ctx, srv, mcp.ProgressToken("token"))
+	require.NoError(t, err)
+
+	target, err := progress.ParseTarget(progress.NDJSONFormat + ":" + progress.TargetUnix + ":" + r.path)
+	require.NoError(t, err)
+	assert.Equal(t, progress.TargetUnix, target.Kind)
+
+	conn, err := net.Dial("unix", r.path)
+	require.NoError(t, err)
+	_, err = conn.Write([]byte(`{"event":"Start"}` + "\n" + `{"event":"Success"}` + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	// The runtime connects when its setup starts, long before the tool call returns and the relay is closed.
+	require.Eventually(t, func() bool {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return len(r.conns) == 1
+	}, time.Second, time.Millisecond)
+	r.close()
+
+	require.Len(t, session.notifications, 2)
+	first := <-session.notifications
+	assert.Equal(t, methodNotificationProgress, first.Method)
+	assert.Equal(t, mcp.ProgressToken("token"), first.Params.AdditionalFields["progressToken"])
+	assert.Equal(t, 1, first.Params.AdditionalFields["progress"])
+	assert.Equal(t, "Start", first.Params.AdditionalFields["message"])
+	second := <-session.notifications
+	assert.Equal(t, 2, second.Params.AdditionalFields["progress"])
+	assert.Equal(t, "Success", second.Params.AdditionalFields["message"])
+}
+
+func TestRelayProgressWithoutToken(t *testing.T) {
+	m := New(nil)
+	stop, err := m.relayProgress(context.Background(), nil, mcp.CallToolRequest{})
+	require.NoError(t, err)
+	stop()
+}
@@ -3,6 +3,10 @@ package mcpserver
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/ActiveState/cli/internal/constants"
 	"github.com/ActiveState/cli/internal/errs"
@@ -14,43 +18,100 @@ import (
 
 type ToolHandlerFunc func(context.Context, *primer.Values, mcp.CallToolRequest) (*mcp.CallToolResult, error)
 
+type ResourceHandlerFunc func(context.Context, *primer.Values, mcp.ReadResourceRequest) ([]mcp.ResourceContents, error)
+
 // Handler wraps the MCP server and provides methods for adding tools and resources
 type Handler struct {
-	Server      *server.MCPServer
-	primeGetter func() (*primer.Values, func() error, error)
+	Server        *server.MCPServer
+	primeGetter   func() (*primer.Values, func() error, error)
+	subscriptions *subscriptions
 }
 
 func New(primeGetter func() (*primer.Values, func() error, error)) *Handler {
+	subs := newSubscriptions()
+	hooks := &server.Hooks{}
+	hooks.AddOnUnregisterSession(func(_ context.Context, session server.ClientSession) {
+		subs.drop(session.SessionID())
+	})
+
 	s := server.NewMCPServer(
 		constants.StateMCPCmd,
 		constants.VersionNumber,
+		server.WithResourceCapabilities(true, false),
+		server.WithHooks(hooks),
 	)
 
 	mcpHandler := &Handler{
-		Server:      s,
-		primeGetter: primeGetter,
+		Server:        s,
+		primeGetter:   primeGetter,
+		subscriptions: subs,
 	}
 
 	return mcpHandler
 }
 
-func (m Handler) ServeStdio() error {
-	if err := server.ServeStdio(m.Server); err != nil {
+// WatchResources enables notifying subscribers of resource changes. Sources returns the files a resource is derived
+// from, and subscribers are notified when any of them changes.
+func (m *Handler) WatchResources(sources SourcesFunc) {
+	m.subscriptions.sources = sources
+}
+
+func (m *Handler) ServeStdio() error {
+	ctx, cancel := signalContext()
+	defer cancel()
+
+	// The MCP library does not handle resource subscriptions, so we handle those requests before passing the rest on.
+	stdout := &syncWriter{w: os.Stdout}
+	stdin, pw := io.Pipe()
+	go m.subscriptions.filter(os.Stdin, pw, stdout)
+	go m.subscriptions.watch(ctx, m.Server)
+
+	if err := server.NewStdioServer(m.Server).Listen(ctx, stdin, stdout); err != nil {
 		logging.Error("Server error: %v\n", err)
 	}
 	return nil
 }
 
+// signalContext returns a context that is cancelled when the server is interrupted or terminated.
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		select {
+		case <-sigChan:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(sigChan)
+	}()
+	return ctx, cancel
+}
+
 // addResource adds a resource to the MCP server with error handling and logging
-func (m *Handler) AddResource(resource mcp.Resource, handler server.ResourceHandlerFunc) {
-	m.Server.AddResource(resource, func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
-		r, err := handler(ctx, request)
+func (m *Handler) AddResource(resource mcp.Resource, handler ResourceHandlerFunc) {
+	m.Server.AddResource(resource, m.resourceHandler(resource.Name, handler))
+}
+
+// AddResourceTemplate adds a resource template to the MCP server with error handling and logging
+func (m *Handler) AddResourceTemplate(template mcp.ResourceTemplate, handler ResourceHandlerFunc) {
+	m.Server.AddResourceTemplate(template, server.ResourceTemplateHandlerFunc(m.resourceHandler(template.Name, handler)))
+}
+
+func (m *Handler) resourceHandler(name string, handler ResourceHandlerFunc) server.ResourceHandlerFunc {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		p, closer, err := m.primeGetter()
+		if err != nil {
+			return nil, errs.Wrap(err, "Failed to get primer")
+		}
+		defer closer()
+		r, err := handler(ctx, p, request)
 		if err != nil {
-			logging.Error("%s: Error handling resource request: %v", resource.Name, err)
-			return nil, errs.Wrap(err, "Failed to handle resource request")
+			logging.Error("%s: Error handling resource request: %v", name, errs.JoinMessage(err))
+			return nil, fmt.Errorf("%s: %s", name, errs.JoinMessage(err))
 		}
 		return r, nil
-	})
+	}
 }
 
 // addPrompt adds a prompt to the MCP server with error handling and logging
@@ -72,6 +133,11 @@ func (m *Handler) AddTool(tool mcp.Tool, handler ToolHandlerFunc) {
 			return nil, errs.Wrap(err, "Failed to get primer")
 		}
 		defer closer()
+		stopProgress, err := m.relayProgress(ctx, p, request)
+		if err != nil {
+			return nil, errs.Wrap(err, "Failed to relay progress")
+		}
+		defer stopProgress()
 		r, err = handler(ctx, p, request)
 		if err != nil {
 			logging.Error("%s: Error handling tool request: %v", tool.Name, errs.JoinMessage(err))
@@ -0,0 +1,214 @@
+package mcpserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	methodResourcesSubscribe   = "resources/subscribe"
+	methodResourcesUnsubscribe = "resources/unsubscribe"
+)
+
+// watchInterval is how often the sources of subscribed resources are checked for changes.
+var watchInterval = 2 * time.Second
+
+// SourcesFunc returns the files the resource with the given URI is derived from.
+type SourcesFunc func(uri string) []string
+
+// stdioSessionID is the ID the MCP library gives the one session of the stdio transport.
+const stdioSessionID = "stdio"
+
+// subscriptions tracks the resources each client session subscribed to, along with the state of their sources when
+// last checked.
+type subscriptions struct {
+	mutex    sync.Mutex
+	sessions map[string]map[string]string // session ID -> uri -> fingerprint of its sources
+	sources  SourcesFunc
+}
+
+// update is a change to a resource that a session subscribed to.
+type update struct {
+	session string
+	uri     string
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{sessions: map[string]map[string]string{}}
+}
+
+func (s *subscriptions) subscribe(session, uri string) {
+	fingerprint := s.fingerprint(uri)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.sessions[session] == nil {
+		s.sessions[session] = map[string]string{}
+	}
+	s.sessions[session][uri] = fingerprint
+}
+
+func (s *subscriptions) unsubscribe(session, uri string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.sessions[session], uri)
+	if len(s.sessions[session]) == 0 {
+		delete(s.sessions, session)
+	}
+}
+
+// drop removes the subscriptions of a session that ended.
+func (s *subscriptions) drop(session string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.sessions, session)
+}
+
+// changed returns the subscribed resources whose sources changed since they were last checked, for each session that
+// subscribed to them.
+func (s *subscriptions) changed() []update {
+	s.mutex.Lock()
+	subscribed := []update{}
+	for session, uris := range s.sessions {
+		for uri := range uris {
+			subscribed = append(subscribed, update{session, uri})
+		}
+	}
+	s.mutex.Unlock()
+
+	changed := []update{}
+	fingerprints := map[string]string{}
+	for _, u := range subscribed {
+		fingerprint, ok := fingerprints[u.uri]
+		if !ok {
+			fingerprint = s.fingerprint(u.uri)
+			fingerprints[u.uri] = fingerprint
+		}
+		s.mutex.Lock()
+		previous, ok := s.sessions[u.session][u.uri]
+		if ok && previous != fingerprint {
+			s.sessions[u.session][u.uri] = fingerprint
+			changed = append(changed, u)
+		}
+		s.mutex.Unlock()
+	}
+	return changed
+}
+
+// fingerprint sums up the modification times and sizes of the sources of a resource.
+func (s *subscriptions) fingerprint(uri string) string {
+	if s.sources == nil {
+		return ""
+	}
+	type stat struct {
+		Path    string    `json:"path"`
+		ModTime time.Time `json:"mod_time"`
+		Size    int64     `json:"size"`
+	}
+	stats := []stat{}
+	for _, path := range s.sources(uri) {
+		st := stat{Path: path}
+		if info, err := os.Stat(path); err == nil {
+			st.ModTime, st.Size = info.ModTime(), info.Size()
+		}
+		stats = append(stats, st)
+	}
+	b, _ := json.Marshal(stats)
+	return string(b)
+}
+
+// watch notifies clients of changes to the resources they subscribed to until the context is cancelled.
+func (s *subscriptions) watch(ctx context.Context, srv *server.MCPServer) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, u := range s.changed() {
+				logging.Debug("Resource changed: %s", u.uri)
+				if err := srv.SendNotificationToSpecificClient(u.session, mcp.MethodNotificationResourceUpdated, map[string]any{"uri": u.uri}); err != nil {
+					logging.Debug("Could not notify session %s of the change to %s: %v", u.session, u.uri, err)
+				}
+			}
+		}
+	}
+}
+
+// filter reads JSON-RPC messages of the stdio session from in, handles resource subscription requests by writing
+// their responses to out, and passes all other messages on to next.
+func (s *subscriptions) filter(in io.Reader, next *io.PipeWriter, out io.Writer) {
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			if response, ok := s.handle(stdioSessionID, line); ok {
+				if _, err := out.Write(append(response, '\n')); err != nil {
+					logging.Error("Could not write response: %v", err)
+				}
+			} else if _, err := next.Write(line); err != nil {
+				logging.Error("Could not pass on message: %v", err)
+				return
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				logging.Error("Could not read message: %v", err)
+			}
+			next.CloseWithError(err)
+			return
+		}
+	}
+}
+
+// handle handles the message if it is a resource subscription request of the given session, and returns the response
+// to it. It returns false for other messages.
+func (s *subscriptions) handle(session string, line []byte) ([]byte, bool) {
+	var request struct {
+		ID     any    `json:"id"`
+		Method string `json:"method"`
+		Params struct {
+			URI string `json:"uri"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal(line, &request); err != nil {
+		return nil, false
+	}
+
+	switch request.Method {
+	case methodResourcesSubscribe:
+		s.subscribe(session, request.Params.URI)
+	case methodResourcesUnsubscribe:
+		s.unsubscribe(session, request.Params.URI)
+	default:
+		return nil, false
+	}
+
+	response, err := json.Marshal(mcp.NewJSONRPCResponse(mcp.NewRequestId(request.ID), mcp.Result{}))
+	if err != nil {
+		logging.Error("Could not marshal %s response: %v", request.Method, err)
+		return nil, true
+	}
+	return response, true
+}
+
+// syncWriter serializes writes, so that responses written by different goroutines do not interleave.
+type syncWriter struct {
+	mutex sync.Mutex
+	w     io.Writer
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.w.Write(p)
+}
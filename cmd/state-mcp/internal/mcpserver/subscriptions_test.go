@@ -0,0 +1,60 @@
+package mcpserver
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionsFilter(t *testing.T) {
+	s := newSubscriptions()
+	out := &bytes.Buffer{}
+	pr, pw := io.Pipe()
+
+	in := strings.Join([]string{
+		`{"jsonrpc":"2.0","id":1,"method":"resources/subscribe","params":{"uri":"state://project/ActiveState/cli/buildscript"}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"resources/read","params":{"uri":"state://project/ActiveState/cli/buildscript"}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"resources/unsubscribe","params":{"uri":"state://project/ActiveState/cli/buildplan"}}`,
+	}, "\n") + "\n"
+	go s.filter(strings.NewReader(in), pw, out)
+
+	passed, err := io.ReadAll(pr)
+	require.NoError(t, err)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":2,"method":"resources/read","params":{"uri":"state://project/ActiveState/cli/buildscript"}}`+"\n", string(passed))
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":{}}`+"\n"+`{"jsonrpc":"2.0","id":3,"result":{}}`+"\n", out.String())
+	assert.Contains(t, s.sessions[stdioSessionID], "state://project/ActiveState/cli/buildscript")
+}
+
+func TestSubscriptionsChanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "buildscript.as")
+	require.NoError(t, os.WriteFile(path, []byte("main = runtime"), 0644))
+
+	s := newSubscriptions()
+	s.sources = func(uri string) []string { return []string{path} }
+	s.subscribe("a", "state://project/ActiveState/cli/buildscript")
+	s.subscribe("b", "state://project/ActiveState/cli/buildscript")
+	s.subscribe("b", "state://project/ActiveState/cli/buildplan")
+	assert.Empty(t, s.changed())
+
+	require.NoError(t, os.WriteFile(path, []byte("main = solve_legacy"), 0644))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	assert.ElementsMatch(t, []update{
+		{"a", "state://project/ActiveState/cli/buildscript"},
+		{"b", "state://project/ActiveState/cli/buildscript"},
+		{"b", "state://project/ActiveState/cli/buildplan"},
+	}, s.changed(), "every session is told about the resources it subscribed to")
+	assert.Empty(t, s.changed(), "a change is only reported once")
+
+	s.unsubscribe("a", "state://project/ActiveState/cli/buildscript")
+	s.drop("b")
+	assert.Empty(t, s.sessions)
+	require.NoError(t, os.Remove(path))
+	assert.Empty(t, s.changed())
+}
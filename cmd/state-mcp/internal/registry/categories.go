@@ -3,7 +3,9 @@ package registry
 type Category string
 
 const (
-	CategoryDebug Category = "debug"
+	CategoryDebug    Category = "debug"
+	CategoryProject  Category = "project"
+	CategoryCommands Category = "commands"
 )
 
 type Categories []Category
@@ -19,5 +21,7 @@ func (c Categories) String() []string {
 func GetCategories() Categories {
 	return Categories{
 		CategoryDebug,
+		CategoryProject,
+		CategoryCommands,
 	}
 }
@@ -0,0 +1,129 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/installation"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// commandToolsArgs is the hidden command that lists the commands the State Tool exposes as tools.
+var commandToolsArgs = []string{"export", "_mcp-tools", "--output", "json"}
+
+// commandFlags are passed to every command that is run as a tool.
+var commandFlags = []string{"--output", "json", "--non-interactive"}
+
+// LoadCommandTools asks the State Tool for the commands it exposes as tools, which are those that support structured
+// output and are allowed by its config.
+func LoadCommandTools(ctx context.Context) ([]*captain.ToolSpec, error) {
+	exe, err := installation.StateExec()
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not find State Tool")
+	}
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, exe, commandToolsArgs...)
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errs.Wrap(err, "Could not list command tools: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	specs := []*captain.ToolSpec{}
+	if err := json.Unmarshal(stdout.Bytes(), &specs); err != nil {
+		return nil, errs.Wrap(err, "Could not parse command tools")
+	}
+	return specs, nil
+}
+
+// CommandTool returns the tool that runs the command described by the spec, and returns its structured output. Unless
+// the command has an input of the same name, the tool takes the directory of the project to run the command in.
+func CommandTool(spec *captain.ToolSpec) (Tool, error) {
+	properties := map[string]interface{}{}
+	if p, ok := spec.InputSchema["properties"].(map[string]interface{}); ok {
+		for k, v := range p {
+			properties[k] = v
+		}
+	}
+	_, hasProjectDir := properties["project_dir"]
+	if !hasProjectDir {
+		properties["project_dir"] = map[string]interface{}{"type": "string", "description": projectDirDescription}
+	}
+
+	schema := map[string]interface{}{}
+	for k, v := range spec.InputSchema {
+		schema[k] = v
+	}
+	schema["properties"] = properties
+
+	rawSchema, err := json.Marshal(schema)
+	if err != nil {
+		return Tool{}, errs.Wrap(err, "Could not marshal input schema of %s", spec.Name)
+	}
+
+	return Tool{
+		Category: CategoryCommands,
+		Tool:     mcp.NewToolWithRawSchema(spec.Name, spec.Description, rawSchema),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			exe, err := installation.StateExec()
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(errs.Wrap(err, "Could not find State Tool"))), nil
+			}
+			cmd, err := command(ctx, exe, spec, request.GetArguments(), !hasProjectDir)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			return runCommand(cmd), nil
+		},
+	}, nil
+}
+
+// command returns the State Tool command that the tool of the given spec runs for the given input. If takesDir is
+// true, the "project_dir" input is the directory to run the command in rather than an input of the command.
+func command(ctx context.Context, exe string, spec *captain.ToolSpec, arguments map[string]interface{}, takesDir bool) (*exec.Cmd, error) {
+	input := map[string]interface{}{}
+	for k, v := range arguments {
+		input[k] = v
+	}
+	var dir string
+	if takesDir {
+		dir, _ = input["project_dir"].(string)
+		delete(input, "project_dir")
+	}
+
+	args, err := spec.CommandLine(input, commandFlags...)
+	if err != nil {
+		return nil, errs.Wrap(err, "Invalid input for %s", spec.Name)
+	}
+	cmd := exec.CommandContext(ctx, exe, args...)
+	cmd.Dir = dir
+	return cmd, nil
+}
+
+// runCommand runs the given State Tool command, and returns its JSON output. Commands report errors as JSON too, so
+// the output is returned as the error if the command failed.
+func runCommand(cmd *exec.Cmd) *mcp.CallToolResult {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stdout.String())
+		if msg == "" {
+			msg = strings.TrimSpace(stderr.String())
+		}
+		if msg == "" {
+			msg = err.Error()
+		}
+		return mcp.NewToolResultError(msg)
+	}
+
+	if stdout.Len() == 0 {
+		return mcp.NewToolResultText("{}")
+	}
+	return mcp.NewToolResultText(stdout.String())
+}
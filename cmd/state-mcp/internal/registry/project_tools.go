@@ -0,0 +1,263 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ActiveState/cli/internal/analytics/client/blackhole"
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
+	"github.com/ActiveState/cli/internal/runners/history"
+	"github.com/ActiveState/cli/internal/runners/install"
+	"github.com/ActiveState/cli/internal/runners/manifest"
+	"github.com/ActiveState/cli/internal/runners/mcp/buildscript"
+	"github.com/ActiveState/cli/internal/runners/packages"
+	"github.com/ActiveState/cli/internal/runners/swtch"
+	"github.com/ActiveState/cli/internal/runners/uninstall"
+	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const projectDirDescription = "The directory of the project (or any directory inside it), defaults to the working directory of the server"
+
+func withProjectDir() mcp.ToolOption {
+	return mcp.WithString("project_dir", mcp.Description(projectDirDescription))
+}
+
+// projectPrimer returns a primer for the project the request is about, whose output is JSON written to the returned
+// buffer. This lets tools return the structured output of runners rather than the text meant for humans.
+func projectPrimer(p *primer.Values, request mcp.CallToolRequest) (*primer.Values, *bytes.Buffer, error) {
+	buf := &bytes.Buffer{}
+	out, err := output.New(string(output.JSONFormatName), &output.Config{
+		OutWriter:   buf,
+		ErrWriter:   io.Discard,
+		Colored:     false,
+		Interactive: false,
+	})
+	if err != nil {
+		return nil, nil, errs.Wrap(err, "Failed to create output")
+	}
+
+	an := blackhole.New()
+	values := []any{out, p.Auth(), p.Subshell(), p.Conditional(), p.Config(), p.IPComm(), p.SvcModel(), an, prompt.New(out, an)}
+
+	// Runners report a missing project themselves, but a project directory that was asked for must exist.
+	dir := request.GetString("project_dir", "")
+	if dir == "" {
+		if wd, err := osutils.Getwd(); err == nil {
+			if proj, err := project.FromPath(wd); err == nil {
+				values = append(values, proj)
+			}
+		}
+	} else {
+		proj, err := project.FromPath(dir)
+		if err != nil {
+			return nil, nil, errs.Wrap(err, "Could not load project at %s", dir)
+		}
+		values = append(values, proj)
+	}
+
+	return primer.New(values...), buf, nil
+}
+
+// structuredResult returns the JSON the runner wrote, or the error it returned.
+func structuredResult(buf *bytes.Buffer, err error) *mcp.CallToolResult {
+	if err != nil {
+		return mcp.NewToolResultError(errs.JoinMessage(err))
+	}
+	if buf.Len() == 0 {
+		return mcp.NewToolResultText("{}")
+	}
+	return mcp.NewToolResultText(buf.String())
+}
+
+func packagesArg(request mcp.CallToolRequest) (captain.PackagesValue, error) {
+	raw, err := request.RequireStringSlice("packages")
+	if err != nil {
+		return nil, errs.Wrap(err, "packages are required")
+	}
+	pkgs := captain.PackagesValue{}
+	for _, r := range raw {
+		if _, err := pkgs.Add(r); err != nil {
+			return nil, errs.Wrap(err, "invalid package %s", r)
+		}
+	}
+	return pkgs, nil
+}
+
+func SearchPackagesTool() Tool {
+	return Tool{
+		Category: CategoryProject,
+		Tool: mcp.NewTool(
+			"search_packages",
+			mcp.WithDescription("Searches the ActiveState catalog for packages, returning their latest versions, namespaces and known vulnerabilities"),
+			mcp.WithString("query", mcp.Required(), mcp.Description("The package to search for, optionally prefixed with its namespace, e.g. 'requests' or 'language/python:requests'")),
+			mcp.WithString("language", mcp.Description("The language to search packages for, defaults to the language of the project")),
+			mcp.WithBoolean("exact", mcp.Description("Only return packages whose name matches the query exactly")),
+			withProjectDir(),
+		),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := request.RequireString("query")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("a query is required: %s", errs.JoinMessage(err))), nil
+			}
+
+			params := packages.SearchRunParams{
+				Language:  request.GetString("language", ""),
+				ExactTerm: request.GetBool("exact", false),
+			}
+			if err := params.Ingredient.Set(query); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid query: %s", errs.JoinMessage(err))), nil
+			}
+
+			pp, buf, err := projectPrimer(p, request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			err = packages.NewSearch(pp).Run(params, model.NamespacePackage)
+			return structuredResult(buf, err), nil
+		},
+	}
+}
+
+func InstallPackagesTool() Tool {
+	return Tool{
+		Category: CategoryProject,
+		Tool: mcp.NewTool(
+			"install_packages",
+			mcp.WithDescription("Adds packages to the project and updates its runtime. Use dry_run first to see which packages would be added, removed or updated without changing the project"),
+			mcp.WithArray("packages", mcp.Required(), mcp.WithStringItems(), mcp.Description("The packages to install, e.g. 'requests' or 'requests@2.31.0'")),
+			mcp.WithBoolean("dry_run", mcp.Description("Only report the changes that would be made to the runtime")),
+			withProjectDir(),
+		),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pkgs, err := packagesArg(request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+
+			pp, buf, err := projectPrimer(p, request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			err = install.New(pp, model.NamespacePackage).Run(install.Params{Packages: pkgs, DryRun: request.GetBool("dry_run", false)})
+			return structuredResult(buf, err), nil
+		},
+	}
+}
+
+func UninstallPackagesTool() Tool {
+	return Tool{
+		Category: CategoryProject,
+		Tool: mcp.NewTool(
+			"uninstall_packages",
+			mcp.WithDescription("Removes packages from the project and updates its runtime. Use dry_run first to see which packages would be removed or updated without changing the project"),
+			mcp.WithArray("packages", mcp.Required(), mcp.WithStringItems(), mcp.Description("The packages to uninstall, e.g. 'requests'")),
+			mcp.WithBoolean("dry_run", mcp.Description("Only report the changes that would be made to the runtime")),
+			withProjectDir(),
+		),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pkgs, err := packagesArg(request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+
+			pp, buf, err := projectPrimer(p, request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			err = uninstall.New(pp, model.NamespacePackage).Run(uninstall.Params{Packages: pkgs, DryRun: request.GetBool("dry_run", false)})
+			return structuredResult(buf, err), nil
+		},
+	}
+}
+
+func ListManifestTool() Tool {
+	return Tool{
+		Category: CategoryProject,
+		Tool: mcp.NewTool(
+			"list_manifest",
+			mcp.WithDescription("Lists the requirements of the project with their requested and resolved versions, licenses and known vulnerabilities"),
+			mcp.WithBoolean("expand", mcp.Description("Include the full namespace of each requirement")),
+			withProjectDir(),
+		),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pp, buf, err := projectPrimer(p, request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			err = manifest.NewManifest(pp).Run(manifest.Params{Expand: request.GetBool("expand", false)})
+			return structuredResult(buf, err), nil
+		},
+	}
+}
+
+func ShowBuildScriptTool() Tool {
+	return Tool{
+		Category: CategoryProject,
+		Tool: mcp.NewTool(
+			"show_buildscript",
+			mcp.WithDescription("Shows the build script of the project, which declares its requirements, platforms and build time"),
+			withProjectDir(),
+		),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pp, buf, err := projectPrimer(p, request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			err = buildscript.New(pp).Run(buildscript.NewParams())
+			return structuredResult(buf, err), nil
+		},
+	}
+}
+
+func SwitchBranchTool() Tool {
+	return Tool{
+		Category: CategoryProject,
+		Tool: mcp.NewTool(
+			"switch_branch",
+			mcp.WithDescription("Switches the project to another branch or commit, and updates its runtime accordingly"),
+			mcp.WithString("branch", mcp.Required(), mcp.Description("The name of the branch, or the ID of the commit, to switch to")),
+			withProjectDir(),
+		),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			branch, err := request.RequireString("branch")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("a branch is required: %s", errs.JoinMessage(err))), nil
+			}
+
+			pp, buf, err := projectPrimer(p, request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			err = swtch.New(pp).Run(swtch.SwitchParams{Identifier: branch})
+			return structuredResult(buf, err), nil
+		},
+	}
+}
+
+func ListHistoryTool() Tool {
+	return Tool{
+		Category: CategoryProject,
+		Tool: mcp.NewTool(
+			"list_history",
+			mcp.WithDescription("Lists the commits of the project, most recent first, with the changes each of them made"),
+			withProjectDir(),
+		),
+		Handler: func(ctx context.Context, p *primer.Values, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pp, buf, err := projectPrimer(p, request)
+			if err != nil {
+				return mcp.NewToolResultError(errs.JoinMessage(err)), nil
+			}
+			err = history.NewHistory(pp).Run(&history.HistoryParams{})
+			return structuredResult(buf, err), nil
+		},
+	}
+}
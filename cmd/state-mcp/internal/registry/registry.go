@@ -4,6 +4,8 @@ import (
 	"context"
 	"slices"
 
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/primer"
 	"github.com/mark3labs/mcp-go/mcp"
 )
@@ -21,14 +23,16 @@ type Prompt struct {
 }
 
 type Registry struct {
-	tools   map[Category][]Tool
-	prompts map[Category][]Prompt
+	tools     map[Category][]Tool
+	prompts   map[Category][]Prompt
+	resources map[Category][]Resource
 }
 
 func New() *Registry {
 	r := &Registry{
-		tools:   make(map[Category][]Tool),
-		prompts: make(map[Category][]Prompt),
+		tools:     make(map[Category][]Tool),
+		prompts:   make(map[Category][]Prompt),
+		resources: make(map[Category][]Resource),
 	}
 
 	r.RegisterTool(ProjectErrorsTool())
@@ -40,9 +44,23 @@ func New() *Registry {
 	r.RegisterTool(CreateIngredientRevisionTool())
 	r.RegisterTool(RebuildProjectTool())
 
+	r.RegisterTool(SearchPackagesTool())
+	r.RegisterTool(InstallPackagesTool())
+	r.RegisterTool(UninstallPackagesTool())
+	r.RegisterTool(ListManifestTool())
+	r.RegisterTool(ShowBuildScriptTool())
+	r.RegisterTool(SwitchBranchTool())
+	r.RegisterTool(ListHistoryTool())
+
 	r.RegisterPrompt(ProjectPrompt())
 	r.RegisterPrompt(IngredientPrompt())
 
+	r.RegisterResource(ProjectFileResource())
+	r.RegisterResource(BuildScriptResource())
+	r.RegisterResource(BuildPlanResource())
+	r.RegisterResource(EnvironmentResource())
+	r.RegisterResource(BuildLogsResource())
+
 	return r
 }
 
@@ -53,6 +71,28 @@ func (r *Registry) RegisterTool(tool Tool) {
 	r.tools[tool.Category] = append(r.tools[tool.Category], tool)
 }
 
+// RegisterCommandTools registers the tools of the given commands, except for those whose names are already taken by
+// the tools written for the MCP server.
+func (r *Registry) RegisterCommandTools(specs []*captain.ToolSpec) error {
+	taken := map[string]bool{}
+	for _, tools := range r.tools {
+		for _, tool := range tools {
+			taken[tool.Name] = true
+		}
+	}
+	for _, spec := range specs {
+		if taken[spec.Name] {
+			continue
+		}
+		tool, err := CommandTool(spec)
+		if err != nil {
+			return errs.Wrap(err, "Could not create tool for %s", spec.Name)
+		}
+		r.RegisterTool(tool)
+	}
+	return nil
+}
+
 func (r *Registry) RegisterPrompt(prompt Prompt) {
 	if _, ok := r.prompts[prompt.Category]; !ok {
 		r.prompts[prompt.Category] = []Prompt{}
@@ -60,42 +100,53 @@ func (r *Registry) RegisterPrompt(prompt Prompt) {
 	r.prompts[prompt.Category] = append(r.prompts[prompt.Category], prompt)
 }
 
-func (r *Registry) GetTools(requestCategories ...string) []Tool {
-	if len(requestCategories) == 0 {
-		for _, category := range GetCategories() {
-			if category == CategoryDebug {
-				// Debug must be explicitly requested
-				continue
-			}
-			requestCategories = append(requestCategories, string(category))
+func (r *Registry) RegisterResource(resource Resource) {
+	if _, ok := r.resources[resource.Category]; !ok {
+		r.resources[resource.Category] = []Resource{}
+	}
+	r.resources[resource.Category] = append(r.resources[resource.Category], resource)
+}
+
+// requestedCategories returns the categories that were requested, in the order they are declared in. Without a
+// request this is every category but debug, which must be explicitly requested.
+func requestedCategories(requestCategories []string) Categories {
+	result := Categories{}
+	for _, category := range GetCategories() {
+		if len(requestCategories) == 0 && category == CategoryDebug {
+			continue
+		}
+		if len(requestCategories) == 0 || slices.Contains(requestCategories, string(category)) {
+			result = append(result, category)
 		}
 	}
-	categories := GetCategories()
+	return result
+}
+
+// IsRequested returns whether the category is among the requested categories.
+func IsRequested(category Category, requestCategories ...string) bool {
+	return slices.Contains(requestedCategories(requestCategories), category)
+}
+
+func (r *Registry) GetTools(requestCategories ...string) []Tool {
 	result := []Tool{}
-	for _, category := range categories {
-		if slices.Contains(requestCategories, string(category)) {
-			result = append(result, r.tools[category]...)
-		}
+	for _, category := range requestedCategories(requestCategories) {
+		result = append(result, r.tools[category]...)
 	}
 	return result
 }
 
 func (r *Registry) GetPrompts(requestCategories ...string) []Prompt {
-	if len(requestCategories) == 0 {
-		for _, category := range GetCategories() {
-			if category == CategoryDebug {
-				// Debug must be explicitly requested
-				continue
-			}
-			requestCategories = append(requestCategories, string(category))
-		}
-	}
-	categories := GetCategories()
 	result := []Prompt{}
-	for _, category := range categories {
-		if slices.Contains(requestCategories, string(category)) {
-			result = append(result, r.prompts[category]...)
-		}
+	for _, category := range requestedCategories(requestCategories) {
+		result = append(result, r.prompts[category]...)
+	}
+	return result
+}
+
+func (r *Registry) GetResources(requestCategories ...string) []Resource {
+	result := []Resource{}
+	for _, category := range requestedCategories(requestCategories) {
+		result = append(result, r.resources[category]...)
 	}
 	return result
 }
@@ -0,0 +1,112 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func toolNames(tools []Tool) []string {
+	names := []string{}
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+func TestGetTools(t *testing.T) {
+	r := New()
+
+	defaults := toolNames(r.GetTools())
+	assert.Contains(t, defaults, "install_packages")
+	assert.NotContains(t, defaults, "rebuild_project", "debug tools must be requested explicitly")
+
+	debug := toolNames(r.GetTools(string(CategoryDebug)))
+	assert.Contains(t, debug, "rebuild_project")
+	assert.NotContains(t, debug, "install_packages")
+
+	for _, tool := range r.GetTools(string(CategoryProject)) {
+		assert.Equal(t, CategoryProject, tool.Category, tool.Name)
+		assert.NotNil(t, tool.Handler, tool.Name)
+	}
+}
+
+func TestGetResources(t *testing.T) {
+	r := New()
+
+	kinds := []string{}
+	for _, resource := range r.GetResources() {
+		assert.NotNil(t, resource.Read, resource.Kind)
+		assert.NotNil(t, resource.Sources, resource.Kind)
+		kinds = append(kinds, resource.Kind)
+	}
+	assert.ElementsMatch(t, []string{"activestate.yaml", "buildscript", "buildplan", "environment", "buildlogs"}, kinds)
+	assert.Empty(t, r.GetResources(string(CategoryDebug)))
+}
+
+func TestParseProjectURI(t *testing.T) {
+	namespace, kind, err := parseProjectURI(BuildScriptResource().ProjectURI("ActiveState/cli"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ActiveState/cli", namespace)
+	assert.Equal(t, "buildscript", kind)
+
+	for _, uri := range []string{
+		"file://ActiveState/cli/buildscript",
+		"state://project/ActiveState/buildscript",
+		"state://project/ActiveState/cli/buildscript/extra",
+		"state://project/ActiveState//buildscript",
+	} {
+		_, _, err := parseProjectURI(uri)
+		assert.Error(t, err, uri)
+	}
+}
+
+func TestRegisterCommandTools(t *testing.T) {
+	r := New()
+	err := r.RegisterCommandTools([]*captain.ToolSpec{
+		{
+			Name:        "manifest",
+			Command:     []string{"manifest"},
+			Description: "Shows the requirements of the project",
+			InputSchema: map[string]interface{}{"type": "object", "properties": map[string]interface{}{}},
+		},
+		{
+			Name:        "install_packages",
+			Command:     []string{"install"},
+			InputSchema: map[string]interface{}{"type": "object"},
+		},
+	})
+	require.NoError(t, err)
+
+	commands := r.GetTools(string(CategoryCommands))
+	require.Len(t, commands, 1, "tools written for the server take precedence")
+	assert.Equal(t, "manifest", commands[0].Name)
+	assert.Contains(t, string(commands[0].RawInputSchema), `"project_dir"`)
+	assert.Len(t, toolNames(r.GetTools()), len(toolNames(r.GetTools(string(CategoryProject), string(CategoryCommands)))))
+}
+
+func TestCommand(t *testing.T) {
+	spec := &captain.ToolSpec{
+		Name:      "install",
+		Command:   []string{"install"},
+		Flags:     map[string]string{"namespace": "namespace"},
+		Arguments: []string{"packages"},
+	}
+
+	cmd, err := command(context.Background(), "state", spec, map[string]interface{}{
+		"namespace":   "language/python",
+		"packages":    []interface{}{"requests", "-weird"},
+		"project_dir": "/path/to/project",
+	}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"state", "install", "--namespace=language/python", "--output", "json", "--non-interactive", "--", "requests", "-weird",
+	}, cmd.Args, "flags must be passed before the arguments")
+	assert.Equal(t, "/path/to/project", cmd.Dir)
+
+	_, err = command(context.Background(), "state", spec, map[string]interface{}{"project_dir": "/path/to/project"}, false)
+	assert.Error(t, err, "project_dir is not an input of the command")
+}
@@ -0,0 +1,289 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runners/mcp/buildscript"
+	"github.com/ActiveState/cli/pkg/buildlogs"
+	"github.com/ActiveState/cli/pkg/localcommit"
+	bpModel "github.com/ActiveState/cli/pkg/platform/model/buildplanner"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/projectfile"
+	"github.com/ActiveState/cli/pkg/runtime"
+	"github.com/ActiveState/cli/pkg/runtime_helpers"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const projectURIScheme = "state://project/"
+
+// Resource is a kind of project state that can be read by URI, e.g. state://project/ActiveState/cli/buildscript.
+type Resource struct {
+	Kind        string
+	Description string
+	MIMEType    string
+	Category    Category
+	// Read returns the contents of the resource for the given project.
+	Read func(p *primer.Values, proj *project.Project) (string, error)
+	// Sources returns the files the resource is derived from, so subscribers can be notified when it changes.
+	Sources func(proj *project.Project) []string
+}
+
+// ProjectURI returns the URI of the resource for the project with the given namespace.
+func (r Resource) ProjectURI(namespace string) string {
+	return projectURIScheme + namespace + "/" + r.Kind
+}
+
+// Template returns the resource template that matches the resource for any project.
+func (r Resource) Template() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(
+		r.ProjectURI("{owner}/{name}"),
+		r.Kind,
+		mcp.WithTemplateDescription(r.Description),
+		mcp.WithTemplateMIMEType(r.MIMEType),
+	)
+}
+
+// Resource returns the resource for the project with the given namespace.
+func (r Resource) Resource(namespace string) mcp.Resource {
+	return mcp.NewResource(
+		r.ProjectURI(namespace),
+		fmt.Sprintf("%s %s", namespace, r.Kind),
+		mcp.WithResourceDescription(r.Description),
+		mcp.WithMIMEType(r.MIMEType),
+	)
+}
+
+// Handler reads the resource for the project addressed by the URI of the request.
+func (r Resource) Handler(ctx context.Context, p *primer.Values, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	namespace, kind, err := parseProjectURI(request.Params.URI)
+	if err != nil {
+		return nil, errs.Wrap(err, "Invalid resource URI")
+	}
+	if kind != r.Kind {
+		return nil, errs.New("Resource URI %s is not a %s", request.Params.URI, r.Kind)
+	}
+
+	proj, err := lookupProject(p.Config(), namespace)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not find project")
+	}
+
+	text, err := r.Read(p, proj)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read %s", r.Kind)
+	}
+
+	return []mcp.ResourceContents{mcp.TextResourceContents{
+		URI:      request.Params.URI,
+		MIMEType: r.MIMEType,
+		Text:     text,
+	}}, nil
+}
+
+// parseProjectURI returns the project namespace and the kind of resource the URI addresses.
+func parseProjectURI(uri string) (string, string, error) {
+	if !strings.HasPrefix(uri, projectURIScheme) {
+		return "", "", errs.New("URI %s does not start with %s", uri, projectURIScheme)
+	}
+	parts := strings.Split(strings.TrimPrefix(uri, projectURIScheme), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", errs.New("URI %s is not of the form %s<owner>/<name>/<resource>", uri, projectURIScheme)
+	}
+	return parts[0] + "/" + parts[1], parts[2], nil
+}
+
+// lookupProject returns the local checkout of the project with the given namespace, preferring the one in the
+// working directory of the server.
+func lookupProject(cfg projectfile.ConfigGetter, namespace string) (*project.Project, error) {
+	if wd, err := osutils.Getwd(); err == nil {
+		if proj, err := project.FromPath(wd); err == nil && strings.EqualFold(proj.NamespaceString(), namespace) {
+			return proj, nil
+		}
+	}
+	for _, path := range projectfile.GetProjectPaths(cfg, namespace) {
+		if proj, err := project.FromExactPath(path); err == nil {
+			return proj, nil
+		}
+	}
+	return nil, errs.New("No local checkout of %s was found", namespace)
+}
+
+// ResourceSources returns the source files of the resource with the given URI, or nothing if the URI does not address
+// a known resource of a project that is checked out.
+func (r *Registry) ResourceSources(cfg projectfile.ConfigGetter) func(uri string) []string {
+	return func(uri string) []string {
+		namespace, kind, err := parseProjectURI(uri)
+		if err != nil {
+			return nil
+		}
+		for _, resources := range r.resources {
+			for _, resource := range resources {
+				if resource.Kind != kind {
+					continue
+				}
+				proj, err := lookupProject(cfg, namespace)
+				if err != nil {
+					return nil
+				}
+				return resource.Sources(proj)
+			}
+		}
+		return nil
+	}
+}
+
+func projectSources(proj *project.Project, extra ...string) []string {
+	return append([]string{proj.Source().Path(), filepath.Join(proj.Dir(), constants.BuildScriptFileName)}, extra...)
+}
+
+func marshalResource(v interface{}) (string, error) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", errs.Wrap(err, "Could not marshal resource")
+	}
+	return string(b), nil
+}
+
+func ProjectFileResource() Resource {
+	return Resource{
+		Kind:        constants.ConfigFileName,
+		Description: "The activestate.yaml of the project, which declares its commit, scripts, constants and events",
+		MIMEType:    "application/yaml",
+		Category:    CategoryProject,
+		Read: func(p *primer.Values, proj *project.Project) (string, error) {
+			b, err := fileutils.ReadFile(proj.Source().Path())
+			if err != nil {
+				return "", errs.Wrap(err, "Could not read project file")
+			}
+			return string(b), nil
+		},
+		Sources: func(proj *project.Project) []string {
+			return []string{proj.Source().Path()}
+		},
+	}
+}
+
+func BuildScriptResource() Resource {
+	return Resource{
+		Kind:        "buildscript",
+		Description: "The build script of the project, which declares its requirements, platforms and build time",
+		MIMEType:    "text/plain",
+		Category:    CategoryProject,
+		Read: func(p *primer.Values, proj *project.Project) (string, error) {
+			commitID, err := localcommit.Get(proj.Dir())
+			if err != nil {
+				return "", errs.Wrap(err, "Unable to get local commit")
+			}
+			script, _, err := buildscript.ForProject(p.Auth(), p.SvcModel(), proj, commitID)
+			if err != nil {
+				return "", err
+			}
+			b, err := script.Marshal()
+			if err != nil {
+				return "", errs.Wrap(err, "Could not marshal build script")
+			}
+			return string(b), nil
+		},
+		Sources: func(proj *project.Project) []string {
+			return projectSources(proj)
+		},
+	}
+}
+
+func BuildPlanResource() Resource {
+	return Resource{
+		Kind:        "buildplan",
+		Description: "The build plan the commit of the project resolves to, listing every artifact of its runtime along with its build status",
+		MIMEType:    "application/json",
+		Category:    CategoryProject,
+		Read: func(p *primer.Values, proj *project.Project) (string, error) {
+			commitID, err := localcommit.Get(proj.Dir())
+			if err != nil {
+				return "", errs.Wrap(err, "Unable to get local commit")
+			}
+			commit, err := bpModel.NewBuildPlannerModel(p.Auth(), p.SvcModel()).FetchCommit(commitID, proj.Owner(), proj.Name(), nil)
+			if err != nil {
+				return "", errs.Wrap(err, "Could not fetch build plan")
+			}
+			b, err := commit.BuildPlan().Marshal()
+			if err != nil {
+				return "", errs.Wrap(err, "Could not marshal build plan")
+			}
+			return string(b), nil
+		},
+		Sources: func(proj *project.Project) []string {
+			return projectSources(proj)
+		},
+	}
+}
+
+func EnvironmentResource() Resource {
+	return Resource{
+		Kind:        "environment",
+		Description: "The environment variables the runtime of the project sets up, as used by state shell and state exec",
+		MIMEType:    "application/json",
+		Category:    CategoryProject,
+		Read: func(p *primer.Values, proj *project.Project) (string, error) {
+			rt, err := runtime_helpers.FromProject(proj)
+			if err != nil {
+				return "", errs.Wrap(err, "Could not get runtime")
+			}
+			return marshalResource(struct {
+				Runtime     string            `json:"runtime"`
+				Executables string            `json:"executables"`
+				Env         map[string]string `json:"environment"`
+			}{rt.Path(), runtime.ExecutorsPath(rt.Path()), rt.Env(false).VariablesWithExecutors})
+		},
+		Sources: func(proj *project.Project) []string {
+			return projectSources(proj, runtime.ProfilePath(runtime_helpers.TargetDirFromProject(proj)))
+		},
+	}
+}
+
+func BuildLogsResource() Resource {
+	return Resource{
+		Kind:        "buildlogs",
+		Description: "The archived build logs of the artifacts of the commit of the project, including the full log of every failed build",
+		MIMEType:    "application/json",
+		Category:    CategoryProject,
+		Read: func(p *primer.Values, proj *project.Project) (string, error) {
+			commitID, err := localcommit.Get(proj.Dir())
+			if err != nil {
+				return "", errs.Wrap(err, "Unable to get local commit")
+			}
+			archive := buildlogs.New()
+			entries, err := archive.ForCommit(commitID)
+			if err != nil {
+				return "", errs.Wrap(err, "Could not read build logs")
+			}
+
+			type logOutput struct {
+				buildlogs.Entry
+				Log []string `json:"log,omitempty"` // only set for failed builds
+			}
+			logs := []logOutput{}
+			for _, entry := range entries {
+				l := logOutput{Entry: entry}
+				if entry.Failed() {
+					if l.Log, err = archive.Lines(entry); err != nil {
+						return "", errs.Wrap(err, "Could not read build log of %s", entry.Name)
+					}
+				}
+				logs = append(logs, l)
+			}
+			return marshalResource(logs)
+		},
+		Sources: func(proj *project.Project) []string {
+			return projectSources(proj, buildlogs.New().IndexPath())
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -10,8 +12,13 @@ import (
 
 	"github.com/ActiveState/cli/cmd/state-mcp/internal/mcpserver"
 	"github.com/ActiveState/cli/cmd/state-mcp/internal/registry"
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/events"
 	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/runners/export/mcptools"
+	"github.com/ActiveState/cli/pkg/project"
 )
 
 func main() {
@@ -31,30 +38,116 @@ func main() {
 
 	// Parse command line flags
 	rawFlag := flag.String("categories", "", "Comma separated list of categories to register tools for")
+	transport := flag.String("transport", transportStdio, "The transport to serve the MCP server over, either 'stdio' or 'http'")
+	addr := flag.String("addr", "127.0.0.1:0", "The loopback address to serve the HTTP transport on, a port of 0 picks a free port")
 	flag.Parse()
 
-	mcps := setupServer(strings.Split(*rawFlag, ",")...)
+	// Without categories the server registers all categories that do not need to be requested explicitly.
+	var categories []string
+	if *rawFlag != "" {
+		categories = strings.Split(*rawFlag, ",")
+	}
+	cfg, err := config.New()
+	if err != nil {
+		logging.Error("Could not load config: %v", errs.JoinMessage(err))
+		return
+	}
+	defer cfg.Close()
+
+	mcps := setupServer(cfg, categories...)
+
+	switch *transport {
+	case transportStdio:
+		// Start the stdio server
+		logging.Info("Starting MCP server")
+		if err := mcps.ServeStdio(); err != nil {
+			logging.Error("Server error: %v\n", err)
+		}
+	case transportHTTP:
+		if err := serveHTTP(mcps, *addr); err != nil {
+			logging.Error("Server error: %v", errs.JoinMessage(err))
+			fmt.Fprintln(os.Stderr, errs.JoinMessage(err))
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown transport: %s\n", *transport)
+	}
+}
+
+const (
+	transportStdio = "stdio"
+	transportHTTP  = "http"
+)
+
+// connectionInfo tells whoever started the HTTP server how to connect to it.
+type connectionInfo struct {
+	URL    string `json:"url"`
+	SSEURL string `json:"sse_url"`
+	Token  string `json:"token"`
+}
 
-	// Start the stdio server
-	logging.Info("Starting MCP server")
-	if err := mcps.ServeStdio(); err != nil {
-		logging.Error("Server error: %v\n", err)
+// serveHTTP serves the MCP server over HTTP with a token that is only valid for this server, which is written to
+// stdout along with the URLs to connect to once the server is ready.
+func serveHTTP(mcps *mcpserver.Handler, addr string) error {
+	token, err := mcpserver.NewToken()
+	if err != nil {
+		return errs.Wrap(err, "Could not create token")
 	}
+
+	logging.Info("Starting MCP server on %s", addr)
+	return mcps.ServeLocalHTTP(addr, token, func(baseURL string) {
+		b, err := json.Marshal(connectionInfo{baseURL + mcpserver.StreamableHTTPEndpoint, baseURL + mcpserver.SSEEndpoint, token})
+		if err != nil {
+			logging.Error("Could not marshal connection info: %v", err)
+			return
+		}
+		fmt.Println(string(b))
+	})
 }
 
-func setupServer(categories ...string) *mcpserver.Handler {
+func setupServer(cfg *config.Instance, categories ...string) *mcpserver.Handler {
 	mcps := mcpserver.New(newPrimer)
 
-	registry := registry.New()
-	tools := registry.GetTools(categories...)
+	reg := registry.New()
+	if registry.IsRequested(registry.CategoryCommands, categories...) {
+		// The State Tool is asked for its commands, so that new commands become tools without changes to the server.
+		specs, err := registry.LoadCommandTools(context.Background())
+		if err != nil {
+			logging.Error("Could not load command tools: %v", errs.JoinMessage(err))
+		} else if err := reg.RegisterCommandTools(specs); err != nil {
+			logging.Error("Could not register command tools: %v", errs.JoinMessage(err))
+		}
+	}
+
+	tools := reg.GetTools(categories...)
 	for _, tool := range tools {
+		// Tools that change anything are only exposed if the user allows them. Debug tools are exempt, as they have to
+		// be requested explicitly.
+		if tool.Category != registry.CategoryDebug && !mcptools.AllowedByConfig(cfg, tool.Name) {
+			logging.Debug("Tool %s is not allowed by the config", tool.Name)
+			continue
+		}
 		mcps.AddTool(tool.Tool, tool.Handler)
 	}
 
-	prompts := registry.GetPrompts(categories...)
+	prompts := reg.GetPrompts(categories...)
 	for _, prompt := range prompts {
 		mcps.AddPrompt(prompt.Prompt, prompt.Handler)
 	}
 
+	// Resources of any checked out project can be read through their templates, while those of the project the
+	// server runs in are listed as well.
+	var proj *project.Project
+	if wd, err := osutils.Getwd(); err == nil {
+		proj, _ = project.FromPath(wd)
+	}
+	resources := reg.GetResources(categories...)
+	for _, resource := range resources {
+		mcps.AddResourceTemplate(resource.Template(), resource.Handler)
+		if proj != nil {
+			mcps.AddResource(resource.Resource(proj.NamespaceString()), resource.Handler)
+		}
+	}
+	mcps.WatchResources(reg.ResourceSources(cfg))
+
 	return mcps
 }
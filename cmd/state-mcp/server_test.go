@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ActiveState/cli/cmd/state-mcp/internal/registry"
+	"github.com/ActiveState/cli/internal/config"
 	"github.com/ActiveState/cli/internal/logging"
 )
 
@@ -15,7 +16,12 @@ Fails due to state-svc not being detected when run as regular test,
 works when running with debugger. Problem for another day.
 `)
 	logging.CurrentHandler().SetVerbose(true)
-	mcpHandler := setupServer(string(registry.CategoryDebug))
+	cfg, err := config.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cfg.Close()
+	mcpHandler := setupServer(cfg, string(registry.CategoryDebug))
 	msg := mcpHandler.Server.HandleMessage(context.Background(), json.RawMessage(`{
 		"jsonrpc": "2.0",
 		"id": 1,
@@ -195,14 +195,14 @@ func main() {
 	}
 }
 
-func execute(out output.Outputer, prompt prompt.Prompter, cfg *config.Instance, an analytics.Dispatcher, args []string, params *Params) error {
+func execute(out output.Outputer, prompter prompt.Prompter, cfg *config.Instance, an analytics.Dispatcher, args []string, params *Params) error {
 	if params.nonInteractive {
-		prompt.SetInteractive(false)
+		prompter.SetInteractive(false)
 	}
 	defaultChoice := params.nonInteractive
 	msg := locale.Tr("tos_disclaimer", constants.TermsOfServiceURLLatest)
 	msg += locale.Tr("tos_disclaimer_prompt", constants.TermsOfServiceURLLatest)
-	cont, err := prompt.Confirm(locale.Tr("install_remote_title"), msg, &defaultChoice, nil)
+	cont, err := prompter.Confirm(prompt.IDRemoteInstallerConfirm, locale.Tr("install_remote_title"), msg, &defaultChoice, nil)
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
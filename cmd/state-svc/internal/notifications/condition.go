@@ -21,6 +21,27 @@ type ConditionParams struct {
 	StateVersion Version
 	Command      string
 	Flags        []string
+	Project      ConditionProject
+}
+
+// ConditionProject describes the project that the command runs against. Its fields are empty if there is none.
+type ConditionProject struct {
+	Namespace string
+	Owner     string // the organization that owns the project
+	Name      string
+	CommitID  string
+	// RuntimeAgeDays is the number of days since the runtime of the project was last set up, or -1 if it never was
+	RuntimeAgeDays int
+	cveCount       func() int
+}
+
+// CVECount returns the number of vulnerabilities in the commit of the project. As this requires an API request it is
+// only looked up when a condition uses it.
+func (p ConditionProject) CVECount() int {
+	if p.cveCount == nil {
+		return 0
+	}
+	return p.cveCount()
 }
 
 type Version struct {
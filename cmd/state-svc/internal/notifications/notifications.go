@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -11,31 +12,44 @@ import (
 	"github.com/ActiveState/cli/internal/config"
 	"github.com/ActiveState/cli/internal/constants"
 	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
 	"github.com/ActiveState/cli/internal/graph"
 	"github.com/ActiveState/cli/internal/httputil"
 	"github.com/ActiveState/cli/internal/logging"
 	"github.com/ActiveState/cli/internal/poller"
 	"github.com/ActiveState/cli/internal/runbits/panics"
 	"github.com/ActiveState/cli/internal/strutils"
+	"github.com/ActiveState/cli/pkg/localcommit"
 	auth "github.com/ActiveState/cli/pkg/platform/authentication"
+	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/runtime"
+	"github.com/ActiveState/cli/pkg/runtime_helpers"
 	"github.com/ActiveState/cli/pkg/sysinfo"
 	"github.com/blang/semver"
+	"github.com/patrickmn/go-cache"
 
 	configMediator "github.com/ActiveState/cli/internal/mediators/config"
 )
 
 func init() {
 	configMediator.RegisterOption(constants.NotificationsURLConfig, configMediator.String, "", constants.NotificationsOverrideEnvVarName)
+	configMediator.RegisterOption(constants.NotificationsDirConfig, configMediator.String, "")
 }
 
 const ConfigKeyLastReport = "notifications.last_reported"
 
+// localDirName is the directory in the config dir that local notification files are read from, unless configured
+// otherwise
+const localDirName = "notifications"
+
 type Notifications struct {
 	cfg        *config.Instance
 	auth       *auth.Auth
 	baseParams *ConditionParams
 	poll       *poller.Poller
 	checkMutex sync.Mutex
+	cveCounts  *cache.Cache
 }
 
 func New(cfg *config.Instance, auth *auth.Auth) (*Notifications, error) {
@@ -64,9 +78,10 @@ func New(cfg *config.Instance, auth *auth.Auth) (*Notifications, error) {
 			StateChannel: constants.ChannelName,
 			StateVersion: NewVersionFromSemver(stateVersion),
 		},
-		cfg:  cfg,
-		auth: auth,
-		poll: poll,
+		cfg:       cfg,
+		auth:      auth,
+		poll:      poll,
+		cveCounts: cache.New(time.Hour, 10*time.Minute),
 	}
 
 	configMediator.AddListener(constants.NotificationsURLConfig, func() {
@@ -88,19 +103,17 @@ func (m *Notifications) Close() error {
 	return nil
 }
 
-func (m *Notifications) Check(command string, flags []string) ([]*graph.NotificationInfo, error) {
+func (m *Notifications) Check(command string, flags []string, projectPath string) ([]*graph.NotificationInfo, error) {
 	// Prevent multiple checks at the same time, which could lead to the same notification showing multiple times
 	m.checkMutex.Lock()
 	defer m.checkMutex.Unlock()
 
-	cacheValue := m.poll.ValueFromCache()
-	if cacheValue == nil {
-		return []*graph.NotificationInfo{}, nil
+	allNotifications, err := m.all()
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not get notifications")
 	}
-
-	allNotifications, ok := cacheValue.([]*graph.NotificationInfo)
-	if !ok {
-		return nil, errs.New("cacheValue has unexpected type: %T", cacheValue)
+	if len(allNotifications) == 0 {
+		return []*graph.NotificationInfo{}, nil
 	}
 
 	conditionParams := *m.baseParams // copy
@@ -108,6 +121,7 @@ func (m *Notifications) Check(command string, flags []string) ([]*graph.Notifica
 	conditionParams.UserName = m.auth.WhoAmI()
 	conditionParams.Command = command
 	conditionParams.Flags = flags
+	conditionParams.Project = m.projectParams(projectPath)
 
 	if id := m.auth.UserID(); id != nil {
 		conditionParams.UserID = id.String()
@@ -115,6 +129,8 @@ func (m *Notifications) Check(command string, flags []string) ([]*graph.Notifica
 
 	logging.Debug("Checking %d notifications with params: %#v", len(allNotifications), conditionParams)
 
+	allNotifications = undismissed(allNotifications, m.cfg.GetStringMap(constants.NotificationsSnoozedConfig), m.cfg.GetStringMap(constants.NotificationsAcknowledgedConfig), time.Now())
+
 	lastReportMap := m.cfg.GetStringMap(ConfigKeyLastReport)
 	msgs, err := check(&conditionParams, allNotifications, lastReportMap, time.Now())
 	if err != nil {
@@ -130,6 +146,122 @@ func (m *Notifications) Check(command string, flags []string) ([]*graph.Notifica
 	return msgs, nil
 }
 
+// List returns the notifications that have been shown to the user and that are still within their date range, so
+// that the user can snooze or acknowledge them.
+func (m *Notifications) List() ([]*graph.NotificationInfo, error) {
+	m.checkMutex.Lock()
+	defer m.checkMutex.Unlock()
+
+	allNotifications, err := m.all()
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not get notifications")
+	}
+
+	lastReportMap := m.cfg.GetStringMap(ConfigKeyLastReport)
+	result := []*graph.NotificationInfo{}
+	for _, notification := range allNotifications {
+		if _, shown := lastReportMap[notification.ID]; !shown {
+			continue
+		}
+		inRange, err := notificationInDateRange(notification, time.Now())
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not check if notification %s is in date range", notification.ID)
+		}
+		if inRange {
+			result = append(result, notification)
+		}
+	}
+
+	return result, nil
+}
+
+// all returns the notifications from the notifications endpoint along with those from local notification files
+func (m *Notifications) all() ([]*graph.NotificationInfo, error) {
+	allNotifications := []*graph.NotificationInfo{}
+	if cacheValue := m.poll.ValueFromCache(); cacheValue != nil {
+		remote, ok := cacheValue.([]*graph.NotificationInfo)
+		if !ok {
+			return nil, errs.New("cacheValue has unexpected type: %T", cacheValue)
+		}
+		allNotifications = append(allNotifications, remote...)
+	}
+
+	return append(allNotifications, readLocal(m.cfg)...), nil
+}
+
+// projectParams returns the condition parameters for the project at the given path
+func (m *Notifications) projectParams(projectPath string) ConditionProject {
+	params := ConditionProject{RuntimeAgeDays: -1}
+	if projectPath == "" {
+		return params
+	}
+
+	proj, err := project.FromExactPath(projectPath)
+	if err != nil {
+		logging.Debug("Could not load project at %s for notification conditions: %v", projectPath, errs.JoinMessage(err))
+		return params
+	}
+	params.Namespace = proj.NamespaceString()
+	params.Owner = proj.Owner()
+	params.Name = proj.Name()
+
+	if commitID, err := localcommit.Get(proj.Dir()); err == nil {
+		params.CommitID = commitID.String()
+		params.cveCount = func() int { return m.cveCount(commitID.String()) }
+	}
+
+	if stat, err := os.Stat(runtime.HashPath(runtime_helpers.TargetDirFromProject(proj))); err == nil {
+		params.RuntimeAgeDays = int(time.Since(stat.ModTime()) / (24 * time.Hour))
+	}
+
+	return params
+}
+
+// cveCount returns the number of vulnerabilities in the given commit, which is cached as it requires an API request
+func (m *Notifications) cveCount(commitID string) int {
+	if count, ok := m.cveCounts.Get(commitID); ok {
+		return count.(int)
+	}
+
+	count := 0
+	vulnerabilities, err := model.FetchCommitVulnerabilities(m.auth, commitID)
+	if err != nil {
+		logging.Debug("Could not fetch vulnerabilities of commit %s for notification conditions: %v", commitID, errs.JoinMessage(err))
+	} else {
+		for _, severity := range vulnerabilities.VulnerabilityHistogram {
+			count += severity.Count
+		}
+	}
+
+	m.cveCounts.Set(commitID, count, cache.DefaultExpiration)
+	return count
+}
+
+// undismissed filters out the notifications that the user snoozed or acknowledged. Notifications that exit can not be
+// dismissed, as they exist to stop commands from running.
+func undismissed(notifications []*graph.NotificationInfo, snoozed, acknowledged map[string]interface{}, baseTime time.Time) []*graph.NotificationInfo {
+	result := []*graph.NotificationInfo{}
+	for _, notification := range notifications {
+		if notification.Interrupt != graph.NotificationInterruptTypeExit {
+			if _, ok := acknowledged[notification.ID]; ok {
+				logging.Debug("Skipping notification %s as it was acknowledged", notification.ID)
+				continue
+			}
+			if until, ok := snoozed[notification.ID].(string); ok {
+				untilTime, err := time.Parse(time.RFC3339, until)
+				if err != nil {
+					logging.Warning("Could not parse the time notification %s is snoozed until: %v", notification.ID, until)
+				} else if baseTime.Before(untilTime) {
+					logging.Debug("Skipping notification %s as it is snoozed until %s", notification.ID, until)
+					continue
+				}
+			}
+		}
+		result = append(result, notification)
+	}
+	return result
+}
+
 func notificationInDateRange(notification *graph.NotificationInfo, baseTime time.Time) (bool, error) {
 	if notification.StartDate != "" {
 		startDate, err := time.Parse(time.RFC3339, notification.StartDate)
@@ -250,6 +382,45 @@ func fetch(cfg *config.Instance) ([]*graph.NotificationInfo, error) {
 		}
 	}
 
+	return parse(body)
+}
+
+// readLocal reads the notification files (*.json) in the local notifications directory, which allows administrators
+// to ship notifications without hosting them
+func readLocal(cfg *config.Instance) []*graph.NotificationInfo {
+	dir := cfg.GetString(constants.NotificationsDirConfig)
+	if dir == "" {
+		dir = filepath.Join(cfg.ConfigPath(), localDirName)
+	}
+	if !fileutils.DirExists(dir) {
+		return nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		logging.Warning("Could not list notification files in %s: %v", dir, err)
+		return nil
+	}
+
+	notifications := []*graph.NotificationInfo{}
+	for _, file := range files {
+		body, err := os.ReadFile(file)
+		if err != nil {
+			logging.Warning("Could not read notification file %s: %v", file, err)
+			continue
+		}
+		fileNotifications, err := parse(body)
+		if err != nil {
+			logging.Warning("Could not parse notification file %s: %v", file, errs.JoinMessage(err))
+			continue
+		}
+		notifications = append(notifications, fileNotifications...)
+	}
+
+	return notifications
+}
+
+func parse(body []byte) ([]*graph.NotificationInfo, error) {
 	var notifications []*graph.NotificationInfo
 	if err := json.Unmarshal(body, &notifications); err != nil {
 		return nil, errs.Wrap(err, "Could not unmarshall notifications information")
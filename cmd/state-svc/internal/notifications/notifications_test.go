@@ -339,6 +339,54 @@ func Test_check(t *testing.T) {
 			[]string{"A"},
 			false,
 		},
+		{
+			"Project Conditions",
+			args{
+				params: &ConditionParams{
+					Project: ConditionProject{Namespace: "ActiveState/cli", Owner: "ActiveState", Name: "cli", RuntimeAgeDays: 45},
+				},
+				notifications: []*graph.NotificationInfo{
+					{ID: "A", Condition: `eq .Project.Owner "ActiveState"`},
+					{ID: "B", Condition: `eq .Project.Namespace "ActiveState/other"`},
+					{ID: "C", Condition: `gt .Project.RuntimeAgeDays 30`},
+					{ID: "D", Condition: `gt .Project.RuntimeAgeDays 60`},
+				},
+				lastReportMap: map[string]interface{}{},
+				baseTime:      baseTime,
+			},
+			[]string{"A", "C"},
+			false,
+		},
+		{
+			"Project CVE Count Condition",
+			args{
+				params: &ConditionParams{
+					Project: ConditionProject{cveCount: func() int { return 3 }},
+				},
+				notifications: []*graph.NotificationInfo{
+					{ID: "A", Condition: `gt .Project.CVECount 0`},
+					{ID: "B", Condition: `gt .Project.CVECount 5`},
+				},
+				lastReportMap: map[string]interface{}{},
+				baseTime:      baseTime,
+			},
+			[]string{"A"},
+			false,
+		},
+		{
+			"No Project",
+			args{
+				params: &ConditionParams{Project: ConditionProject{RuntimeAgeDays: -1}},
+				notifications: []*graph.NotificationInfo{
+					{ID: "A", Condition: `eq .Project.Namespace ""`},
+					{ID: "B", Condition: `gt .Project.CVECount 0`},
+				},
+				lastReportMap: map[string]interface{}{},
+				baseTime:      baseTime,
+			},
+			[]string{"A"},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -357,3 +405,31 @@ func Test_check(t *testing.T) {
 		})
 	}
 }
+
+func Test_undismissed(t *testing.T) {
+	baseTime := time.Now()
+	notifications := []*graph.NotificationInfo{
+		{ID: "A"},
+		{ID: "B"},
+		{ID: "C"},
+		{ID: "D"},
+		{ID: "E", Interrupt: graph.NotificationInterruptTypeExit},
+	}
+	snoozed := map[string]interface{}{
+		"B": baseTime.Add(time.Hour).Format(time.RFC3339),
+		"C": baseTime.Add(-1 * time.Hour).Format(time.RFC3339),
+		"E": baseTime.Add(time.Hour).Format(time.RFC3339),
+	}
+	acknowledged := map[string]interface{}{
+		"D": baseTime.Format(time.RFC3339),
+	}
+
+	gotIDs := []string{}
+	for _, notification := range undismissed(notifications, snoozed, acknowledged, baseTime) {
+		gotIDs = append(gotIDs, notification.ID)
+	}
+	wantIDs := []string{"A", "C", "E"}
+	if !reflect.DeepEqual(gotIDs, wantIDs) {
+		t.Errorf("undismissed() got = %v, want %v", gotIDs, wantIDs)
+	}
+}
@@ -247,10 +247,16 @@ func (r *Resolver) ReportRuntimeUsage(_ context.Context, pid int, exec, source s
 	return &graph.ReportRuntimeUsageResponse{Received: true}, nil
 }
 
-func (r *Resolver) CheckNotifications(ctx context.Context, command string, flags []string) ([]*graph.NotificationInfo, error) {
+func (r *Resolver) CheckNotifications(ctx context.Context, command string, flags []string, projectPath *string) ([]*graph.NotificationInfo, error) {
 	defer func() { panics.LogAndPanic(recover(), debug.Stack()) }()
 	logging.Debug("Check notifications resolver")
-	return r.messages.Check(command, flags)
+	return r.messages.Check(command, flags, ptr.From(projectPath, ""))
+}
+
+func (r *Resolver) Notifications(ctx context.Context) ([]*graph.NotificationInfo, error) {
+	defer func() { panics.LogAndPanic(recover(), debug.Stack()) }()
+	logging.Debug("Notifications resolver")
+	return r.messages.List()
 }
 
 func (r *Resolver) ConfigChanged(ctx context.Context, key string) (*graph.ConfigChangedResponse, error) {
@@ -277,6 +283,50 @@ func (r *Resolver) GetProcessesInUse(ctx context.Context, execDir string) ([]*gr
 	return processes, nil
 }
 
+func (r *Resolver) RuntimeUsage(ctx context.Context) ([]*graph.RuntimeUsage, error) {
+	defer func() { panics.LogAndPanic(recover(), debug.Stack()) }()
+	logging.Debug("Runtime usage resolver")
+
+	usage := r.rtwatch.Usage()
+	result := make([]*graph.RuntimeUsage, 0, len(usage))
+	for _, u := range usage {
+		result = append(result, &graph.RuntimeUsage{
+			Exec:        u.Exec,
+			Source:      u.Source,
+			Namespace:   u.Namespace,
+			CommitID:    u.CommitID,
+			RuntimePath: u.RuntimePath,
+			Count:       u.Count,
+			FirstUsed:   u.FirstUsed.Format(time.RFC3339),
+			LastUsed:    u.LastUsed.Format(time.RFC3339),
+			Running:     u.Running,
+		})
+	}
+	return result, nil
+}
+
+func (r *Resolver) IdleRuntimes(ctx context.Context, days int) ([]*graph.IdleRuntime, error) {
+	defer func() { panics.LogAndPanic(recover(), debug.Stack()) }()
+	logging.Debug("Idle runtimes resolver")
+
+	since := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	idle := r.rtwatch.IdleRuntimes(projectfile.GetProjectMapping(r.cfg), since)
+	result := make([]*graph.IdleRuntime, 0, len(idle))
+	for _, rt := range idle {
+		lastUsed := ""
+		if !rt.LastUsed.IsZero() {
+			lastUsed = rt.LastUsed.Format(time.RFC3339)
+		}
+		result = append(result, &graph.IdleRuntime{
+			Namespace:   rt.Namespace,
+			ProjectPath: rt.ProjectPath,
+			RuntimePath: rt.RuntimePath,
+			LastUsed:    lastUsed,
+		})
+	}
+	return result, nil
+}
+
 func (r *Resolver) GetJwt(ctx context.Context) (*graph.Jwt, error) {
 	defer func() { panics.LogAndPanic(recover(), debug.Stack()) }()
 
@@ -0,0 +1,228 @@
+package rtwatcher
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	anaConst "github.com/ActiveState/cli/internal/analytics/constants"
+	"github.com/ActiveState/cli/internal/analytics/dimensions"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/multilog"
+	"github.com/ActiveState/cli/internal/rtutils/ptr"
+	"github.com/ActiveState/cli/pkg/runtime"
+	"github.com/ActiveState/cli/pkg/runtime_helpers"
+)
+
+// UsageCfgKey is the config key under which the usage of runtime executables is stored
+const UsageCfgKey = "runtime-usage"
+
+// Usage records how often and when an executable of a runtime was used
+type Usage struct {
+	Exec        string    `json:"exec"`
+	Source      string    `json:"source"`
+	Namespace   string    `json:"namespace"`
+	CommitID    string    `json:"commitID"`
+	RuntimePath string    `json:"runtimePath"` // empty if the executable is not an executor, eg. the State Tool
+	Count       int       `json:"count"`
+	FirstUsed   time.Time `json:"firstUsed"`
+	LastUsed    time.Time `json:"lastUsed"`
+	Running     bool      `json:"-"`
+}
+
+// IdleRuntime is the runtime of a checkout that has not been used for a while
+type IdleRuntime struct {
+	Namespace   string
+	ProjectPath string
+	RuntimePath string
+	LastUsed    time.Time // when the runtime was last used or set up
+}
+
+func (w *Watcher) loadUsage() {
+	w.usage = map[string]*Usage{}
+	usageJson := w.cfg.GetString(UsageCfgKey)
+	if usageJson == "" {
+		return
+	}
+
+	usage := []*Usage{}
+	if err := json.Unmarshal([]byte(usageJson), &usage); err != nil {
+		multilog.Error("Could not unmarshal runtime usage: %s", errs.JoinMessage(err))
+		return
+	}
+	for _, u := range usage {
+		w.usage[u.Exec] = u
+	}
+	w.pruneUsage()
+}
+
+// pruneUsage drops the usage of runtimes that were removed, as it is of no use to anyone and would otherwise
+// accumulate forever. Executables that are still running are kept, as their runtime may just be being updated.
+func (w *Watcher) pruneUsage() {
+	running := map[string]bool{}
+	for _, e := range w.watched() {
+		running[e.Exec] = true
+	}
+
+	w.usageMutex.Lock()
+	defer w.usageMutex.Unlock()
+
+	for exec, u := range w.usage {
+		if running[exec] || exists(u) {
+			continue
+		}
+		logging.Debug("Runtime of %s no longer exists, dropping its usage", exec)
+		delete(w.usage, exec)
+		w.usageChanged = true
+	}
+}
+
+// exists returns whether the runtime of the given usage still exists, or for the State Tool its executable
+func exists(u *Usage) bool {
+	if u.RuntimePath != "" {
+		return fileutils.DirExists(u.RuntimePath)
+	}
+	return fileutils.TargetExists(u.Exec)
+}
+
+func (w *Watcher) saveUsage() error {
+	w.usageMutex.Lock()
+	defer w.usageMutex.Unlock()
+
+	if !w.usageChanged {
+		return nil
+	}
+
+	usage := make([]*Usage, 0, len(w.usage))
+	for _, u := range w.usage {
+		usage = append(usage, u)
+	}
+	usageJson, err := json.Marshal(usage)
+	if err != nil {
+		return errs.Wrap(err, "Could not marshal runtime usage")
+	}
+	if err := w.cfg.Set(UsageCfgKey, string(usageJson)); err != nil {
+		return errs.Wrap(err, "Could not save runtime usage")
+	}
+
+	w.usageChanged = false
+	return nil
+}
+
+// recordLaunch records that the given executable was launched
+func (w *Watcher) recordLaunch(exec, source string, dims *dimensions.Values) {
+	w.usageMutex.Lock()
+	defer w.usageMutex.Unlock()
+
+	now := time.Now()
+	u, ok := w.usage[exec]
+	if !ok {
+		u = &Usage{Exec: exec, FirstUsed: now}
+		w.usage[exec] = u
+	}
+	u.Source = source
+	u.RuntimePath = runtimePathFromExec(exec, source)
+	if dims != nil {
+		// The State Tool includes the commit in the namespace, which we already record separately
+		u.Namespace, _, _ = strings.Cut(ptr.From(dims.ProjectNameSpace, u.Namespace), "#")
+		u.CommitID = ptr.From(dims.CommitID, u.CommitID)
+	}
+	u.Count++
+	u.LastUsed = now
+	w.usageChanged = true
+}
+
+// recordRunning records that the given executable is still in use
+func (w *Watcher) recordRunning(exec string) {
+	w.usageMutex.Lock()
+	defer w.usageMutex.Unlock()
+
+	if u, ok := w.usage[exec]; ok {
+		u.LastUsed = time.Now()
+		w.usageChanged = true
+	}
+}
+
+// Usage returns the usage of all executables that were used, most recently used first
+func (w *Watcher) Usage() []Usage {
+	running := map[string]bool{}
+	for _, e := range w.watched() {
+		running[e.Exec] = true
+	}
+
+	w.usageMutex.Lock()
+	defer w.usageMutex.Unlock()
+
+	result := make([]Usage, 0, len(w.usage))
+	for _, u := range w.usage {
+		usage := *u // copy
+		usage.Running = running[u.Exec]
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].LastUsed.After(result[j].LastUsed) })
+	return result
+}
+
+// IdleRuntimes returns the runtimes of the given checkouts that have not been used or set up since the given time,
+// least recently used first. Checkouts are keyed by project namespace, like the local project mapping.
+func (w *Watcher) IdleRuntimes(checkouts map[string][]string, since time.Time) []IdleRuntime {
+	usage := w.Usage()
+
+	result := []IdleRuntime{}
+	for namespace, projectPaths := range checkouts {
+		for _, projectPath := range projectPaths {
+			runtimePath, err := runtime_helpers.TargetDirFromProjectDir(projectPath)
+			if err != nil {
+				logging.Debug("Could not get runtime dir of %s: %v", projectPath, errs.JoinMessage(err))
+				continue
+			}
+			if !runtime.IsRuntimeDir(runtimePath) {
+				continue // nothing to clean up
+			}
+
+			var lastUsed time.Time
+			if stat, err := os.Stat(runtime.HashPath(runtimePath)); err == nil {
+				lastUsed = stat.ModTime()
+			}
+			for _, u := range usage {
+				if usedBy(u, namespace, runtimePath) && u.LastUsed.After(lastUsed) {
+					lastUsed = u.LastUsed
+				}
+			}
+
+			if lastUsed.Before(since) {
+				result = append(result, IdleRuntime{namespace, projectPath, runtimePath, lastUsed})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].LastUsed.Before(result[j].LastUsed) })
+	return result
+}
+
+// usedBy returns whether the given usage is of the given runtime. Usage by the State Tool only records the project,
+// so it counts towards every checkout of the project.
+func usedBy(u Usage, namespace, runtimePath string) bool {
+	if u.RuntimePath != "" {
+		return filepath.Clean(u.RuntimePath) == filepath.Clean(runtimePath)
+	}
+	return strings.EqualFold(u.Namespace, namespace)
+}
+
+// runtimePathFromExec returns the runtime that the given executor belongs to
+func runtimePathFromExec(exec, source string) string {
+	if source != anaConst.SrcExecutor {
+		return ""
+	}
+	execDir := filepath.Dir(exec)
+	runtimePath := filepath.Dir(execDir)
+	if filepath.Clean(runtime.ExecutorsPath(runtimePath)) != filepath.Clean(execDir) {
+		return ""
+	}
+	return runtimePath
+}
@@ -0,0 +1,76 @@
+package rtwatcher
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	anaConst "github.com/ActiveState/cli/internal/analytics/constants"
+	"github.com/ActiveState/cli/internal/analytics/dimensions"
+	"github.com/ActiveState/cli/internal/rtutils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_recordUsage(t *testing.T) {
+	w := &Watcher{usage: map[string]*Usage{}}
+	exec := filepath.Join("runtime", "exec", "python3")
+
+	w.recordLaunch(exec, anaConst.SrcExecutor, &dimensions.Values{ProjectNameSpace: ptr.To("owner/project"), CommitID: ptr.To("commit")})
+	w.recordLaunch("state", anaConst.SrcStateTool, &dimensions.Values{ProjectNameSpace: ptr.To("owner/project#commit")})
+	w.watching = []entry{{PID: 1, Exec: exec}}
+	w.recordRunning(exec)
+	w.recordRunning("unknown")
+	w.usage["state"].LastUsed = w.usage["state"].LastUsed.Add(-time.Hour)
+
+	usage := w.Usage()
+	require.Len(t, usage, 2)
+	assert.Equal(t, exec, usage[0].Exec, "most recently used first")
+	assert.Equal(t, 1, usage[0].Count)
+	assert.Equal(t, "owner/project", usage[0].Namespace)
+	assert.Equal(t, "commit", usage[0].CommitID)
+	assert.Equal(t, "runtime", usage[0].RuntimePath)
+	assert.True(t, usage[0].Running)
+	assert.False(t, usage[0].LastUsed.Before(usage[0].FirstUsed))
+
+	assert.Equal(t, "owner/project", usage[1].Namespace, "the commit is stripped from the namespace")
+	assert.Empty(t, usage[1].RuntimePath, "the State Tool is not an executor")
+	assert.False(t, usage[1].Running)
+
+	w.recordLaunch(exec, anaConst.SrcExecutor, nil)
+	assert.Equal(t, 2, w.Usage()[0].Count)
+	assert.Equal(t, "owner/project", w.Usage()[0].Namespace, "launches without dimensions keep the namespace")
+}
+
+func TestWatcher_pruneUsage(t *testing.T) {
+	runtimePath := t.TempDir()
+	removed := filepath.Join(t.TempDir(), "removed")
+	w := &Watcher{usage: map[string]*Usage{
+		"exists":  {Exec: "exists", RuntimePath: runtimePath},
+		"removed": {Exec: "removed", RuntimePath: removed},
+		"running": {Exec: "running", RuntimePath: removed},
+		"state":   {Exec: filepath.Join(removed, "state")},
+	}}
+	w.watching = []entry{{PID: 1, Exec: "running"}}
+
+	w.pruneUsage()
+	assert.Len(t, w.usage, 2)
+	assert.Contains(t, w.usage, "exists")
+	assert.Contains(t, w.usage, "running", "running executables are kept")
+	assert.True(t, w.usageChanged)
+}
+
+func Test_runtimePathFromExec(t *testing.T) {
+	runtimePath := filepath.Join("cache", "runtime")
+	assert.Equal(t, runtimePath, runtimePathFromExec(filepath.Join(runtimePath, "exec", "python3"), anaConst.SrcExecutor))
+	assert.Empty(t, runtimePathFromExec(filepath.Join(runtimePath, "bin", "python3"), anaConst.SrcExecutor))
+	assert.Empty(t, runtimePathFromExec(filepath.Join(runtimePath, "exec", "state"), anaConst.SrcStateTool))
+}
+
+func Test_usedBy(t *testing.T) {
+	runtimePath := filepath.Join("cache", "runtime")
+	assert.True(t, usedBy(Usage{RuntimePath: runtimePath, Namespace: "owner/other"}, "owner/project", runtimePath))
+	assert.False(t, usedBy(Usage{RuntimePath: filepath.Join("cache", "other"), Namespace: "owner/project"}, "owner/project", runtimePath))
+	assert.True(t, usedBy(Usage{Namespace: "Owner/Project"}, "owner/project", runtimePath))
+	assert.False(t, usedBy(Usage{Namespace: "owner/other"}, "owner/project", runtimePath))
+}
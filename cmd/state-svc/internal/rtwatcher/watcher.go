@@ -7,6 +7,7 @@ import (
 	"runtime/debug"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	anaConst "github.com/ActiveState/cli/internal/analytics/constants"
@@ -24,11 +25,15 @@ const defaultInterval = 1 * time.Minute
 const CfgKey = "runtime-watchers"
 
 type Watcher struct {
-	an       analytics
-	cfg      *config.Instance
-	watching []entry
-	stop     chan struct{}
-	interval time.Duration
+	an            analytics
+	cfg           *config.Instance
+	watching      []entry
+	watchingMutex sync.Mutex
+	stop          chan struct{}
+	interval      time.Duration
+	usage         map[string]*Usage
+	usageMutex    sync.Mutex
+	usageChanged  bool
 }
 
 type analytics interface {
@@ -48,6 +53,8 @@ func New(cfg *config.Instance, an analytics) *Watcher {
 		}
 	}
 
+	w.loadUsage()
+
 	if v := os.Getenv(constants.HeartbeatIntervalEnvVarName); v != "" {
 		vv, err := strconv.Atoi(v)
 		if err != nil {
@@ -78,6 +85,8 @@ func (w *Watcher) ticker(cb func()) {
 }
 
 func (w *Watcher) check() {
+	w.watchingMutex.Lock()
+
 	watching := w.watching[:0]
 	for i := range w.watching {
 		e := w.watching[i] // Must use index, because we are deleting indexes further down
@@ -93,9 +102,16 @@ func (w *Watcher) check() {
 		}
 		watching = append(watching, e)
 
+		w.recordRunning(e.Exec)
 		go w.RecordUsage(e)
 	}
 	w.watching = watching
+	w.watchingMutex.Unlock()
+
+	w.pruneUsage()
+	if err := w.saveUsage(); err != nil {
+		multilog.Error("Could not save runtime usage: %s", errs.JoinMessage(err))
+	}
 }
 
 func (w *Watcher) RecordUsage(e entry) {
@@ -107,7 +123,7 @@ func (w *Watcher) GetProcessesInUse(execDir string) []entry {
 	inUse := make([]entry, 0)
 
 	execDir = strings.ToLower(execDir) // match case-insensitively
-	for _, proc := range w.watching {
+	for _, proc := range w.watched() {
 		if !strings.Contains(strings.ToLower(proc.Exec), execDir) {
 			continue
 		}
@@ -133,8 +149,12 @@ func (w *Watcher) Close() error {
 
 	close(w.stop)
 
-	if len(w.watching) > 0 {
-		watchingJson, err := json.Marshal(w.watching)
+	if err := w.saveUsage(); err != nil {
+		return errs.Wrap(err, "Could not save runtime usage")
+	}
+
+	if watching := w.watched(); len(watching) > 0 {
+		watchingJson, err := json.Marshal(watching)
 		if err != nil {
 			return errs.Wrap(err, "Could not marshal watchers")
 		}
@@ -148,6 +168,16 @@ func (w *Watcher) Watch(pid int, exec, source string, dims *dimensions.Values) {
 	logging.Debug("Watching %s (%d)", exec, pid)
 	dims.Sequence = ptr.To(-1) // sequence is meaningless for heartbeat events
 	e := entry{pid, exec, source, dims}
+	w.watchingMutex.Lock()
 	w.watching = append(w.watching, e)
+	w.watchingMutex.Unlock()
+	w.recordLaunch(exec, source, dims)
 	go w.RecordUsage(e) // initial event
 }
+
+// watched returns a copy of the entries being watched, which check() rewrites from the ticker
+func (w *Watcher) watched() []entry {
+	w.watchingMutex.Lock()
+	defer w.watchingMutex.Unlock()
+	return append([]entry{}, w.watching...)
+}
@@ -89,5 +89,6 @@ func newActivateCommand(prime *primer.Values) *captain.Command {
 	)
 	cmd.SetGroup(EnvironmentUsageGroup)
 	cmd.DeprioritizeInHelpListing()
+	cmd.SetPrompts(append(findProjectPrompts, secretPrompts...)...)
 	return cmd
 }
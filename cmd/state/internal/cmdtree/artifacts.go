@@ -43,8 +43,24 @@ func newArtifactsCommand(prime *primer.Values) *captain.Command {
 				Description: "List artifacts with their full identifier",
 				Value:       &params.Full,
 			},
+			{
+				Name:        "diff",
+				Description: locale.Tl("artifacts_flags_diff_description", "Compare the artifacts of two commits, including version, size, license and status changes"),
+				Value:       &params.Diff,
+			},
+		},
+		[]*captain.Argument{
+			{
+				Name:        "commitA",
+				Description: locale.Tl("artifacts_arg_commit_a", "The commit to compare from, when using --diff"),
+				Value:       &params.DiffFrom,
+			},
+			{
+				Name:        "commitB",
+				Description: locale.Tl("artifacts_arg_commit_b", "The commit to compare to, when using --diff. Defaults to the project's current commit"),
+				Value:       &params.DiffTo,
+			},
 		},
-		[]*captain.Argument{},
 		func(_ *captain.Command, _ []string) error {
 			return runner.Run(params)
 		},
@@ -102,3 +118,54 @@ func newArtifactsDownloadCommand(prime *primer.Values) *captain.Command {
 	cmd.SetSupportsStructuredOutput()
 	return cmd
 }
+
+func newArtifactsLogsCommand(prime *primer.Values) *captain.Command {
+	runner := artifacts.NewLogs(prime)
+	params := &artifacts.LogsParams{Namespace: &project.Namespaced{}}
+
+	cmd := captain.NewCommand(
+		"logs",
+		locale.Tl("artifacts_logs_title", "Artifact build logs"),
+		locale.Tl("artifacts_logs_description", "Show build logs of artifacts, from the local build log archive where possible"),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "namespace",
+				Description: locale.Tl("artifacts_logs_flags_namespace_description", "The namespace of the project to show build logs for"),
+				Value:       params.Namespace,
+			},
+			{
+				Name:        "commit",
+				Description: locale.Tl("artifacts_logs_flags_commit_description", "The commit ID to show build logs for"),
+				Value:       &params.CommitID,
+			},
+			{
+				Name:        "target",
+				Description: locale.Tl("artifacts_logs_flags_target_description", "The target to show build logs for"),
+				Value:       &params.Target,
+			},
+			{
+				Name:        "grep",
+				Description: locale.Tl("artifacts_logs_flags_grep_description", "Only show log lines matching the given regular expression"),
+				Value:       &params.Grep,
+			},
+			{
+				Name:        "failed",
+				Description: locale.Tl("artifacts_logs_flags_failed_description", "Only show build logs of failed artifacts"),
+				Value:       &params.Failed,
+			},
+		},
+		[]*captain.Argument{
+			{
+				Name:        "name",
+				Description: locale.Tl("artifacts_logs_arg_name", "The name or ID of the artifact to show build logs for"),
+				Value:       &params.Name,
+			},
+		},
+		func(_ *captain.Command, _ []string) error {
+			return runner.Run(params)
+		},
+	)
+	cmd.SetSupportsStructuredOutput()
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/auth"
 )
 
@@ -54,7 +55,14 @@ func newAuthCommand(prime *primer.Values, globals *globalOptions) *captain.Comma
 			params.NonInteractive = globals.NonInteractive
 			return authRunner.Run(&params)
 		},
-	).SetGroup(PlatformGroup).SetSupportsStructuredOutput()
+	).SetGroup(PlatformGroup).SetSupportsStructuredOutput().SetPrompts(
+		prompt.IDAuthUsername,
+		prompt.IDAuthPassword,
+		prompt.IDAuthTOTP,
+		prompt.IDAuthBrowserContinue,
+		prompt.IDAuthPreviousPassword,
+		prompt.IDAuthGenerateKeypair,
+	)
 }
 
 func newSignupCommand(prime *primer.Values) *captain.Command {
@@ -70,7 +78,7 @@ func newSignupCommand(prime *primer.Values) *captain.Command {
 		func(ccmd *captain.Command, args []string) error {
 			return signupRunner.Run(&params)
 		},
-	)
+	).SetPrompts(prompt.IDAuthBrowserContinue)
 }
 
 func newLogoutCommand(prime *primer.Values) *captain.Command {
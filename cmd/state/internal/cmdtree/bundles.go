@@ -71,7 +71,7 @@ func newBundleInstallCommand(prime *primer.Values) *captain.Command {
 			}
 			return runner.Run(params)
 		},
-	).SetSupportsStructuredOutput()
+	).SetSupportsStructuredOutput().SetPrompts(installPrompts...)
 }
 
 func newBundleUninstallCommand(prime *primer.Values) *captain.Command {
@@ -101,7 +101,7 @@ func newBundleUninstallCommand(prime *primer.Values) *captain.Command {
 			}
 			return runner.Run(params)
 		},
-	).SetSupportsStructuredOutput()
+	).SetSupportsStructuredOutput().SetPrompts(requirementPrompts...)
 }
 
 func newBundlesSearchCommand(prime *primer.Values) *captain.Command {
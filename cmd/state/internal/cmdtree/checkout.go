@@ -62,5 +62,6 @@ func newCheckoutCommand(prime *primer.Values) *captain.Command {
 	)
 	cmd.SetGroup(EnvironmentSetupGroup)
 	cmd.SetSupportsStructuredOutput()
+	cmd.SetPrompts(requirementPrompts...)
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/clean"
 )
 
@@ -56,7 +57,7 @@ func newCleanUninstallCommand(prime *primer.Values, globals *globalOptions) *cap
 			params.Force = globals.Force
 			return runner.Run(&params)
 		},
-	)
+	).SetPrompts(prompt.IDCleanUninstallSelect, prompt.IDCleanUninstall)
 }
 
 func newCleanCacheCommand(prime *primer.Values) *captain.Command {
@@ -79,7 +80,7 @@ func newCleanCacheCommand(prime *primer.Values) *captain.Command {
 		func(ccmd *captain.Command, _ []string) error {
 			return runner.Run(&params)
 		},
-	)
+	).SetPrompts(prompt.IDCleanCache, prompt.IDCleanCacheArtifact)
 }
 
 func newCleanConfigCommand(prime *primer.Values, globals *globalOptions) *captain.Command {
@@ -96,5 +97,5 @@ func newCleanConfigCommand(prime *primer.Values, globals *globalOptions) *captai
 			params.Force = globals.Force
 			return runner.Run(&params)
 		},
-	)
+	).SetPrompts(prompt.IDCleanConfig)
 }
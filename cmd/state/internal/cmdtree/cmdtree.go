@@ -35,6 +35,8 @@ func New(prime *primer.Values, args ...string) *CmdTree {
 	cveCmd.AddChildren(
 		newReportCommand(prime),
 		newOpenCommand(prime),
+		newCveSyncCommand(prime),
+		newCveFixCommand(prime),
 	)
 
 	deptree := newExportDepTreeCommand(prime)
@@ -50,11 +52,15 @@ func New(prime *primer.Values, args ...string) *CmdTree {
 		newAPIKeyCommand(prime),
 		newExportConfigCommand(prime),
 		newExportGithubActionCommand(prime),
+		newExportContainerCommand(prime),
 		newExportDocsCommand(prime),
+		newExportMCPToolsCommand(prime),
 		newExportEnvCommand(prime),
 		newExportLogCommand(prime),
 		newExportRuntimeCommand(prime),
+		newExportRuntimeReportCommand(prime),
 		newExportBuildPlanCommand(prime),
+		newExportSBOMCommand(prime),
 		deptree,
 	)
 
@@ -153,6 +159,12 @@ func New(prime *primer.Values, args ...string) *CmdTree {
 	configCmd := newConfigCommand(prime)
 	configCmd.AddChildren(newConfigGetCommand(prime), newConfigSetCommand(prime))
 
+	notificationsCmd := newNotificationsCommand(prime)
+	notificationsCmd.AddChildren(newNotificationsSnoozeCommand(prime), newNotificationsAcknowledgeCommand(prime))
+
+	usageCmd := newUsageCommand(prime)
+	usageCmd.AddChildren(newUsageIdleCommand(prime))
+
 	checkoutCmd := newCheckoutCommand(prime)
 
 	useCmd := newUseCommand(prime)
@@ -163,11 +175,22 @@ func New(prime *primer.Values, args ...string) *CmdTree {
 
 	shellCmd := newShellCommand(prime)
 
+	showCmd := newShowCommand(prime)
+	showCmd.AddChildren(newShowConstantsCommand(prime))
+
+	hookCmd := newHookCommand(prime)
+	hookCmd.AddChildren(
+		newHookInstallCommand(prime),
+		newHookUninstallCommand(prime),
+		newHookEnvCommand(prime),
+	)
+
 	refreshCmd := newRefreshCommand(prime)
 
 	artifactsCmd := newArtifactsCommand(prime)
 	artifactsCmd.AddChildren(
 		newArtifactsDownloadCommand(prime),
+		newArtifactsLogsCommand(prime),
 	)
 
 	stateCmd := newStateCommand(globals, prime)
@@ -182,7 +205,7 @@ func New(prime *primer.Values, args ...string) *CmdTree {
 		exportCmd,
 		newOrganizationsCommand(prime),
 		newRunCommand(prime),
-		newShowCommand(prime),
+		showCmd,
 		installCmd,
 		uninstallCmd,
 		importCmd,
@@ -210,9 +233,12 @@ func New(prime *primer.Values, args ...string) *CmdTree {
 		branchCmd,
 		newLearnCommand(prime),
 		configCmd,
+		notificationsCmd,
+		usageCmd,
 		checkoutCmd,
 		useCmd,
 		shellCmd,
+		hookCmd,
 		refreshCmd,
 		newSwitchCommand(prime),
 		newTestCommand(prime),
@@ -235,6 +261,7 @@ type globalOptions struct {
 	Monochrome     bool
 	NonInteractive bool
 	Force          bool
+	DumpPrompts    bool
 }
 
 // Group instances are used to group command help output.
@@ -259,7 +286,8 @@ func newStateCommand(globals *globalOptions, prime *primer.Values) *captain.Comm
 	var help bool
 
 	runner := state.New(opts, prime)
-	cmd := captain.NewCommand(
+	var cmd *captain.Command
+	cmd = captain.NewCommand(
 		"state",
 		"",
 		locale.T("state_description"),
@@ -318,6 +346,31 @@ func newStateCommand(globals *globalOptions, prime *primer.Values) *captain.Comm
 				OnUse:       func() { prime.Prompt().SetForce(true) },
 				Value:       &globals.Force,
 			},
+			{
+				Name:        "answers",
+				Description: locale.Tl("flag_state_answers_description", "Answer prompts from the given YAML file, which maps prompt IDs to answers. Can also be set with the ACTIVESTATE_ANSWERS environment variable"),
+				Persist:     true,
+				Value:       &answersFile{prime: prime},
+			},
+			{
+				Name:        "dump-prompts",
+				Description: locale.Tl("flag_state_dump_prompts_description", "List the prompts the command may ask, and the IDs to answer them by, instead of running it"),
+				Persist:     true,
+				OnUse:       func() { cmd.SetDumpPrompts(true) },
+				Value:       &globals.DumpPrompts,
+			},
+			{
+				Name:        "config",
+				Description: locale.Tl("flag_state_config_description", "Override a config value for this invocation only, in key=value format. Can be repeated"),
+				Persist:     true,
+				Value:       &configOverrides{prime: prime},
+			},
+			{
+				Name:        "progress",
+				Description: locale.Tl("flag_state_progress_description", "Write machine readable runtime progress events as NDJSON. Use 'ndjson' to write them to stderr, or 'ndjson:fd:<number>', 'ndjson:unix:<socket path>' or 'ndjson:file:<path>' to write them elsewhere"),
+				Persist:     true,
+				Value:       &progressTarget{prime: prime},
+			},
 			{
 				Name:        "version",
 				Description: locale.T("flag_state_version_description"),
@@ -394,6 +447,7 @@ func (a *addCmdAs) deprecatedAlias(aliased *captain.Command, name string) {
 
 	cmd.SetHidden(true)
 	cmd.SetHasVariableArguments()
+	cmd.SetPrompts(aliased.Prompts()...)
 
 	a.parent.AddChildren(cmd)
 }
@@ -38,6 +38,7 @@ func newCommitCommand(prime *primer.Values) *captain.Command {
 
 	cmd.SetGroup(EnvironmentSetupGroup)
 	cmd.SetSupportsStructuredOutput()
+	cmd.SetPrompts(requirementPrompts...)
 
 	return cmd
 }
@@ -1,26 +1,38 @@
 package cmdtree
 
 import (
+	"strings"
+
 	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runbits/runtime/progress"
 	"github.com/ActiveState/cli/internal/runners/config"
 )
 
 func newConfigCommand(prime *primer.Values) *captain.Command {
+	params := config.ListParams{}
 	return captain.NewCommand(
 		"config",
 		locale.Tl("config_title", "Listing Configuration Keys and Values"),
 		locale.Tl("config_description", "Manage the State Tool configuration"),
 		prime,
-		[]*captain.Flag{},
+		[]*captain.Flag{
+			{
+				Name:        "show-origin",
+				Description: locale.Tl("flag_config_show_origin", "Show the file or environment variable each value comes from"),
+				Value:       &params.ShowOrigin,
+			},
+		},
 		[]*captain.Argument{},
 		func(ccmd *captain.Command, _ []string) error {
 			runner, err := config.NewList(prime)
 			if err != nil {
 				return err
 			}
-			return runner.Run()
+			return runner.Run(params)
 		}).SetGroup(UtilsGroup).SetSupportsStructuredOutput()
 }
 
@@ -31,7 +43,13 @@ func newConfigGetCommand(prime *primer.Values) *captain.Command {
 		locale.Tl("config_get_title", "Get config value"),
 		locale.Tl("config_get_description", "Print config values to the terminal"),
 		prime,
-		[]*captain.Flag{},
+		[]*captain.Flag{
+			{
+				Name:        "show-origin",
+				Description: locale.Tl("flag_config_show_origin", "Show the file or environment variable each value comes from"),
+				Value:       &params.ShowOrigin,
+			},
+		},
 		[]*captain.Argument{
 			{
 				Name:        "key",
@@ -76,3 +94,56 @@ func newConfigSetCommand(prime *primer.Values) *captain.Command {
 	cmd.SetSupportsStructuredOutput()
 	return cmd
 }
+
+// configOverrides implements the global --config flag, which overrides config values for a single
+// invocation.
+type configOverrides struct {
+	prime     *primer.Values
+	overrides []string
+}
+
+func (c *configOverrides) Set(v string) error {
+	key, value, ok := strings.Cut(v, "=")
+	if !ok || key == "" {
+		return locale.NewInputError("err_config_override_format", "Config overrides must be in '[ACTIONABLE]key=value[/RESET]' format, got: {{.V0}}", v)
+	}
+	if err := c.prime.Config().SetOverride(key, value); err != nil {
+		return locale.WrapInputError(err, "err_config_override", "Could not override config value for key: {{.V0}}", key)
+	}
+	c.overrides = append(c.overrides, v)
+	return nil
+}
+
+func (c *configOverrides) String() string {
+	return strings.Join(c.overrides, ",")
+}
+
+func (c *configOverrides) Type() string {
+	return "key=value"
+}
+
+// progressTarget implements the global --progress flag, which writes machine readable runtime progress to the given
+// target for a single invocation.
+type progressTarget struct {
+	prime  *primer.Values
+	target string
+}
+
+func (p *progressTarget) Set(v string) error {
+	if _, err := progress.ParseTarget(v); err != nil {
+		return err
+	}
+	if err := p.prime.Config().SetOverride(constants.RuntimeProgressConfig, v); err != nil {
+		return errs.Wrap(err, "Could not override progress config")
+	}
+	p.target = v
+	return nil
+}
+
+func (p *progressTarget) String() string {
+	return p.target
+}
+
+func (p *progressTarget) Type() string {
+	return "target"
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/cve"
 	"github.com/ActiveState/cli/pkg/project"
 )
@@ -17,7 +18,13 @@ func newCveCommand(prime *primer.Values) *captain.Command {
 		locale.T("cve_title"),
 		locale.T("cve_description"),
 		prime,
-		[]*captain.Flag{},
+		[]*captain.Flag{
+			{
+				Name:        "offline",
+				Description: locale.Tl("cve_offline_flag_description", "Check the installed runtime against the local vulnerability database instead of the platform"),
+				Value:       &params.Offline,
+			},
+		},
 		[]*captain.Argument{
 			{
 				Name:        locale.T("cve_namespace_arg"),
@@ -83,3 +90,59 @@ func newOpenCommand(prime *primer.Values) *captain.Command {
 		},
 	)
 }
+
+func newCveSyncCommand(prime *primer.Values) *captain.Command {
+	runner := cve.NewSync(prime)
+	params := cve.SyncParams{}
+
+	cmd := captain.NewCommand(
+		"sync",
+		locale.Tl("cve_sync_title", "Syncing Vulnerability Database"),
+		locale.Tl("cve_sync_description", "Download a vulnerability snapshot for offline vulnerability checks"),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "source",
+				Description: locale.Tl("cve_sync_source_flag_description", "URL or path of an OSV vulnerability snapshot (JSON or zip) to sync from, can be repeated. Defaults to the OSV exports of all supported ecosystems"),
+				Value:       &params.Sources,
+			},
+		},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return runner.Run(&params)
+		},
+	)
+	cmd.SetSupportsStructuredOutput()
+	return cmd
+}
+
+func newCveFixCommand(prime *primer.Values) *captain.Command {
+	runner := cve.NewFix(prime)
+	params := cve.FixParams{}
+
+	cmd := captain.NewCommand(
+		"fix",
+		locale.Tl("cve_fix_title", "Fixing Vulnerabilities"),
+		locale.Tl("cve_fix_description", "Upgrade vulnerable packages to the lowest versions without known vulnerabilities"),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "severity",
+				Description: locale.Tl("cve_fix_severity_flag_description", "Only fix vulnerabilities of this severity or higher (critical, high, medium or low)"),
+				Value:       &params.Severity,
+			},
+			{
+				Name:        "dry-run",
+				Description: locale.Tl("cve_fix_dry_run_flag_description", "Show the changes that would be made without applying them"),
+				Value:       &params.DryRun,
+			},
+		},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return runner.Run(&params)
+		},
+	)
+	cmd.SetSupportsStructuredOutput()
+	cmd.SetPrompts(prompt.IDCVEFix)
+	return cmd
+}
@@ -19,7 +19,7 @@ func newEventsCommand(prime *primer.Values) *captain.Command {
 		[]*captain.Argument{},
 		func(cmd *captain.Command, args []string) error {
 			return runner.Run()
-		}).SetGroup(AutomationGroup).SetSupportsStructuredOutput().SetUnstable(true)
+		}).SetGroup(AutomationGroup).SetSupportsStructuredOutput().SetUnstable(true).SetPrompts(secretPrompts...)
 }
 
 func newEventsLogCommand(prime *primer.Values) *captain.Command {
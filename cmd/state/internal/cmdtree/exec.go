@@ -23,6 +23,11 @@ func newExecCommand(prime *primer.Values, args ...string) *captain.Command {
 				Description: locale.Tl("flag_state_exec_path_description", "Path to the project you are using"),
 				Value:       &params.Path,
 			},
+			{
+				Name:        "project",
+				Description: locale.Tl("flag_state_exec_project_description", "Name or path of the workspace member project to use"),
+				Value:       &params.Project,
+			},
 		},
 		[]*captain.Argument{},
 		func(ccmd *captain.Command, args []string) error {
@@ -45,6 +50,7 @@ func newExecCommand(prime *primer.Values, args ...string) *captain.Command {
 
 	cmd.SetGroup(EnvironmentUsageGroup)
 	cmd.SetHasVariableArguments()
+	cmd.SetPrompts(secretPrompts...)
 
 	return cmd
 }
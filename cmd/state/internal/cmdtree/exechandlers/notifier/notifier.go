@@ -13,19 +13,22 @@ import (
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/rtutils/ptr"
 	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/ActiveState/cli/pkg/project"
 	"golang.org/x/net/context"
 )
 
 type Notifier struct {
 	out           output.Outputer
 	svcModel      *model.SvcModel
+	project       *project.Project
 	notifications []*graph.NotificationInfo
 }
 
-func New(out output.Outputer, svcModel *model.SvcModel) *Notifier {
+func New(out output.Outputer, svcModel *model.SvcModel, pj *project.Project) *Notifier {
 	return &Notifier{
 		out:      out,
 		svcModel: svcModel,
+		project:  pj,
 	}
 }
 
@@ -42,7 +45,12 @@ func (m *Notifier) OnExecStart(cmd *captain.Command, _ []string) error {
 	cmds := cmd.JoinedCommandNames()
 	flags := cmd.ActiveFlagNames()
 
-	notifications, err := m.svcModel.CheckNotifications(context.Background(), cmds, flags)
+	projectPath := ""
+	if m.project != nil {
+		projectPath = m.project.Dir()
+	}
+
+	notifications, err := m.svcModel.CheckNotifications(context.Background(), cmds, flags, projectPath)
 	if err != nil {
 		multilog.Error("Could not report notifications as CheckNotifications return an error: %s", errs.JoinMessage(err))
 	}
@@ -6,11 +6,14 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runbits/envexport"
 	"github.com/ActiveState/cli/internal/runners/export"
 	"github.com/ActiveState/cli/internal/runners/export/config"
+	"github.com/ActiveState/cli/internal/runners/export/container"
 	"github.com/ActiveState/cli/internal/runners/export/deptree"
 	"github.com/ActiveState/cli/internal/runners/export/docs"
 	"github.com/ActiveState/cli/internal/runners/export/ghactions"
+	"github.com/ActiveState/cli/internal/runners/export/mcptools"
 	"github.com/ActiveState/cli/pkg/project"
 )
 
@@ -125,7 +128,7 @@ func newExportGithubActionCommand(prime *primer.Values) *captain.Command {
 		[]*captain.Argument{},
 		func(ccmd *captain.Command, _ []string) error {
 			return runner.Run(&params)
-		}).SetUnstable(true)
+		}).SetUnstable(true).SetPrompts(secretPrompts...)
 }
 
 func newExportDocsCommand(prime *primer.Values) *captain.Command {
@@ -148,18 +151,52 @@ func newExportDocsCommand(prime *primer.Values) *captain.Command {
 	return cmd
 }
 
+func newExportMCPToolsCommand(prime *primer.Values) *captain.Command {
+	runner := mcptools.New(prime)
+	params := mcptools.Params{}
+
+	cmd := captain.NewCommand(
+		"_mcp-tools",
+		locale.Tl("export_mcp_tools_title", "Export the commands the MCP server exposes as tools"),
+		locale.Tl("export_mcp_tools_description", "Lists the commands that support structured output as tools, with JSON schemas derived from their flags and arguments. Which commands are listed is configured with the [ACTIONABLE]mcp.tools.allow[/RESET] and [ACTIONABLE]mcp.tools.deny[/RESET] config options."),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{},
+		func(ccmd *captain.Command, _ []string) error {
+			return runner.Run(&params, ccmd)
+		})
+
+	cmd.SetHidden(true)
+	cmd.SetSupportsStructuredOutput()
+
+	return cmd
+}
+
 func newExportEnvCommand(prime *primer.Values) *captain.Command {
 	runner := export.NewEnv(prime)
+	params := &export.EnvParams{}
 
 	cmd := captain.NewCommand(
 		"env",
 		locale.Tl("env_docs_title", "Exporting environment"),
 		locale.Tl("env_docs_description", "Export the environment variables associated with your runtime."),
 		prime,
-		[]*captain.Flag{},
+		[]*captain.Flag{
+			{
+				Name: "format",
+				Description: locale.Tl("export_env_flags_format_description", "Print the environment as a script that can be loaded by other tools, one of: {{.V0}}",
+					strings.Join(envexport.Formats, ", ")),
+				Value: &params.Format,
+			},
+			{
+				Name:        "inherit",
+				Description: locale.Tl("export_env_flags_inherit_description", "Include the variables of the current environment"),
+				Value:       &params.Inherit,
+			},
+		},
 		[]*captain.Argument{},
 		func(ccmd *captain.Command, _ []string) error {
-			return runner.Run()
+			return runner.Run(params)
 		})
 
 	cmd.SetSupportsStructuredOutput()
@@ -230,6 +267,33 @@ func newExportRuntimeCommand(prime *primer.Values) *captain.Command {
 	return cmd
 }
 
+func newExportRuntimeReportCommand(prime *primer.Values) *captain.Command {
+	runner := export.NewRuntimeReport(prime)
+	params := &export.RuntimeReportParams{}
+
+	cmd := captain.NewCommand(
+		"runtime-report",
+		locale.Tl("export_runtime_report_cmd_title", "Reporting on runtime setup"),
+		locale.Tl("export_runtime_report_cmd_description", "Report how long each phase of the last runtime setup took for each artifact, how many bytes were moved and how busy the workers were."),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "trace",
+				Description: locale.Tl("export_runtime_report_flags_trace_description", "Also write the runtime setup to the given file in the Chrome trace event format"),
+				Value:       &params.Trace,
+			},
+		},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return runner.Run(params)
+		})
+
+	cmd.SetSupportsStructuredOutput()
+	cmd.SetUnstable(true)
+
+	return cmd
+}
+
 func newExportBuildPlanCommand(prime *primer.Values) *captain.Command {
 	runner := export.NewBuildPlan(prime)
 	params := &export.BuildPlanParams{Namespace: &project.Namespaced{}}
@@ -370,3 +434,83 @@ func newExportDepTreeIngredientsCommand(prime *primer.Values) *captain.Command {
 	cmd.SetUnstable(true)
 	return cmd
 }
+
+func newExportSBOMCommand(prime *primer.Values) *captain.Command {
+	runner := export.NewSBOM(prime)
+	params := &export.SBOMParams{Namespace: &project.Namespaced{}}
+
+	cmd := captain.NewCommand(
+		"sbom",
+		locale.Tl("export_sbom_title", "Exporting Software Bill of Materials"),
+		locale.Tl("export_sbom_description", "Export a software bill of materials (SBOM) for the runtime of your project"),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "namespace",
+				Description: locale.Tl("export_sbom_flags_namespace_description", "The namespace of the project to export the SBOM for"),
+				Value:       params.Namespace,
+			},
+			{
+				Name:        "commit",
+				Description: locale.Tl("export_sbom_flags_commit_description", "The commit ID to export the SBOM for"),
+				Value:       &params.CommitID,
+			},
+			{
+				Name:        "target",
+				Description: locale.Tl("export_sbom_flags_target_description", "The target to export the SBOM for"),
+				Value:       &params.Target,
+			},
+			{
+				Name:        "platform",
+				Description: locale.Tl("export_sbom_flags_platform_description", "The ID or name of the platform to export the SBOM for (defaults to host platform)"),
+				Value:       &params.Platform,
+			},
+			{
+				Name: "format",
+				Description: locale.Tl("export_sbom_flags_format_description", "The SBOM format, one of: {{.V0}} (default: {{.V1}})",
+					strings.Join(export.SBOMFormats(), ", "), export.SBOMFormats()[0]),
+				Value: &params.Format,
+			},
+			{
+				Name:        "vulnerabilities",
+				Description: locale.Tl("export_sbom_flags_vulnerabilities_description", "Include known vulnerabilities of the components (requires authentication)"),
+				Value:       &params.Vulnerabilities,
+			},
+		},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return runner.Run(params)
+		},
+	)
+
+	cmd.SetSupportsStructuredOutput()
+
+	return cmd
+}
+
+func newExportContainerCommand(prime *primer.Values) *captain.Command {
+	runner := container.New(prime)
+	params := &container.Params{}
+
+	cmd := captain.NewCommand(
+		"container",
+		locale.Tl("export_container_title", "Exporting Container"),
+		locale.Tl("export_container_description", "Generate a Dockerfile that builds your runtime into a container image, a devcontainer.json that develops inside it, or a VS Code tasks.json that runs your scripts there. Store the first two in the .devcontainer directory of your project, and tasks.json in its .vscode directory."),
+		prime,
+		[]*captain.Flag{
+			{
+				Name: "format",
+				Description: locale.Tl("export_container_flags_format_description", "The file to generate, one of: {{.V0}} (default: {{.V1}})",
+					strings.Join(container.Formats, ", "), container.Formats[0]),
+				Value: &params.Format,
+			},
+		},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return runner.Run(params)
+		})
+
+	cmd.SetUnstable(true)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/fork"
 )
 
@@ -43,5 +44,5 @@ func newForkCommand(prime *primer.Values) *captain.Command {
 		},
 		func(cmd *captain.Command, args []string) error {
 			return runner.Run(params)
-		}).SetGroup(VCSGroup).SetSupportsStructuredOutput()
+		}).SetGroup(VCSGroup).SetSupportsStructuredOutput().SetPrompts(prompt.IDForkOwner)
 }
@@ -0,0 +1,79 @@
+package cmdtree
+
+import (
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runners/hook"
+)
+
+func newHookCommand(prime *primer.Values) *captain.Command {
+	return captain.NewCommand(
+		"hook",
+		locale.Tl("hook_title", "Shell Hook"),
+		locale.Tl("hook_description", "Manage the shell hook that activates the runtime of the nearest project whenever you change directory"),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{},
+		func(ccmd *captain.Command, _ []string) error {
+			prime.Output().Print(ccmd.Help())
+			return nil
+		},
+	).SetGroup(EnvironmentUsageGroup).SetUnstable(true)
+}
+
+func newHookInstallCommand(prime *primer.Values) *captain.Command {
+	return captain.NewCommand(
+		"install",
+		locale.Tl("hook_install_title", "Installing Shell Hook"),
+		locale.Tl("hook_install_description", "Install the shell hook in the rc file of your shell"),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return hook.NewInstall(prime).Run()
+		},
+	)
+}
+
+func newHookUninstallCommand(prime *primer.Values) *captain.Command {
+	return captain.NewCommand(
+		"uninstall",
+		locale.Tl("hook_uninstall_title", "Uninstalling Shell Hook"),
+		locale.Tl("hook_uninstall_description", "Remove the shell hook from the rc file of your shell"),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return hook.NewUninstall(prime).Run()
+		},
+	)
+}
+
+func newHookEnvCommand(prime *primer.Values) *captain.Command {
+	params := &hook.EnvParams{}
+
+	cmd := captain.NewCommand(
+		"env",
+		locale.Tl("hook_env_title", "Shell Hook Environment"),
+		locale.Tl("hook_env_description", "Print the script the shell hook evaluates to activate or deactivate the runtime of the nearest project"),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "shell",
+				Description: locale.Tl("flag_hook_env_shell", "The shell to print the script for, one of: bash, zsh, fish (default: the current shell)"),
+				Value:       &params.Shell,
+			},
+		},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return hook.NewEnv(prime).Run(params)
+		},
+	)
+	cmd.SetHidden(true)
+	// The hook runs on every prompt and its output is evaluated by the shell, so it must not check for
+	// updates or print anything other than the script.
+	cmd.SetSkipChecks(true)
+
+	return cmd
+}
@@ -30,6 +30,16 @@ func newInitCommand(prime *primer.Values) *captain.Command {
 				Description: locale.T("flag_state_init_private_flag_description"),
 				Value:       &params.Private,
 			},
+			{
+				Name:        "template",
+				Description: locale.Tl("flag_state_init_template_description", "Initialize the project from a template: the name of a template in your template directories, the path to a template directory, or a git repository URL"),
+				Value:       &params.Template,
+			},
+			{
+				Name:        "var",
+				Description: locale.Tl("flag_state_init_var_description", "Set a template variable, in name=value format. Can be repeated"),
+				Value:       &params.Vars,
+			},
 		},
 		[]*captain.Argument{
 			{
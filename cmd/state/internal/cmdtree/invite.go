@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/invite"
 )
 
@@ -44,6 +45,7 @@ func newInviteCommand(prime *primer.Values) *captain.Command {
 	cmd.SetGroup(PlatformGroup)
 	cmd.SetUnstable(true)
 	cmd.SetHasVariableArguments()
+	cmd.SetPrompts(prompt.IDInviteRole)
 
 	return cmd
 }
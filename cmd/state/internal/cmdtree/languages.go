@@ -53,7 +53,7 @@ func newLanguageInstallCommand(prime *primer.Values) *captain.Command {
 			}
 			return runner.Run(params)
 		},
-	).SetSupportsStructuredOutput()
+	).SetSupportsStructuredOutput().SetPrompts(installPrompts...)
 }
 
 func newLanguageSearchCommand(prime *primer.Values) *captain.Command {
@@ -0,0 +1,71 @@
+package cmdtree
+
+import (
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runners/notifications"
+)
+
+func newNotificationsCommand(prime *primer.Values) *captain.Command {
+	return captain.NewCommand(
+		"notifications",
+		locale.Tl("notifications_title", "Listing Notifications"),
+		locale.Tl("notifications_description", "List the notifications that have been shown to you"),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return notifications.NewList(prime).Run()
+		}).SetGroup(UtilsGroup).SetSupportsStructuredOutput()
+}
+
+func newNotificationsSnoozeCommand(prime *primer.Values) *captain.Command {
+	params := notifications.SnoozeParams{Days: 1}
+	return captain.NewCommand(
+		"snooze",
+		locale.Tl("notifications_snooze_title", "Snoozing Notification"),
+		locale.Tl("notifications_snooze_description", "Stop showing a notification for a number of days"),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "days",
+				Description: locale.Tl("flag_notifications_snooze_days", "The number of days to snooze the notification for"),
+				Value:       &params.Days,
+			},
+		},
+		[]*captain.Argument{
+			{
+				Name:        "id",
+				Description: locale.Tl("arg_notifications_id", "The ID of the notification, as listed by '[ACTIONABLE]state notifications[/RESET]'"),
+				Required:    true,
+				Value:       &params.ID,
+			},
+		},
+		func(_ *captain.Command, _ []string) error {
+			return notifications.NewSnooze(prime).Run(&params)
+		}).SetSupportsStructuredOutput()
+}
+
+func newNotificationsAcknowledgeCommand(prime *primer.Values) *captain.Command {
+	params := notifications.AcknowledgeParams{}
+	cmd := captain.NewCommand(
+		"acknowledge",
+		locale.Tl("notifications_acknowledge_title", "Acknowledging Notification"),
+		locale.Tl("notifications_acknowledge_description", "Stop showing a notification"),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{
+			{
+				Name:        "id",
+				Description: locale.Tl("arg_notifications_id", "The ID of the notification, as listed by '[ACTIONABLE]state notifications[/RESET]'"),
+				Required:    true,
+				Value:       &params.ID,
+			},
+		},
+		func(_ *captain.Command, _ []string) error {
+			return notifications.NewAcknowledge(prime).Run(&params)
+		}).SetSupportsStructuredOutput()
+	cmd.SetAliases("ack")
+	return cmd
+}
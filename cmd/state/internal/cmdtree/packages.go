@@ -67,6 +67,11 @@ func newInstallCommand(prime *primer.Values) *captain.Command {
 				Description: locale.T("package_flag_ts_description"),
 				Value:       &params.Timestamp,
 			},
+			{
+				Name:        "dry-run",
+				Description: locale.Tl("package_install_flag_dry_run_description", "Show the changes that would be made to the runtime without applying them. The changes are solved as a staged commit that is not added to the project"),
+				Value:       &params.DryRun,
+			},
 		},
 		[]*captain.Argument{
 			{
@@ -89,6 +94,7 @@ func newInstallCommand(prime *primer.Values) *captain.Command {
 	cmd.SetGroup(PackagesGroup)
 	cmd.SetSupportsStructuredOutput()
 	cmd.SetHasVariableArguments()
+	cmd.SetPrompts(installPrompts...)
 
 	return cmd
 }
@@ -104,7 +110,13 @@ func newUninstallCommand(prime *primer.Values) *captain.Command {
 		locale.Tl("package_uninstall_title", "Uninstalling Package"),
 		locale.T("package_uninstall_cmd_description"),
 		prime,
-		[]*captain.Flag{},
+		[]*captain.Flag{
+			{
+				Name:        "dry-run",
+				Description: locale.Tl("package_uninstall_flag_dry_run_description", "Show the changes that would be made to the runtime without applying them. The changes are solved as a staged commit that is not added to the project"),
+				Value:       &params.DryRun,
+			},
+		},
 		[]*captain.Argument{
 			{
 				Name:        locale.T("package_arg_name"),
@@ -126,6 +138,7 @@ func newUninstallCommand(prime *primer.Values) *captain.Command {
 	cmd.SetGroup(PackagesGroup)
 	cmd.SetSupportsStructuredOutput()
 	cmd.SetHasVariableArguments()
+	cmd.SetPrompts(requirementPrompts...)
 
 	return cmd
 }
@@ -169,7 +182,7 @@ func newImportCommand(prime *primer.Values, globals *globalOptions) *captain.Com
 			params.NonInteractive = globals.NonInteractive
 			return runner.Run(params)
 		},
-	).SetGroup(PackagesGroup).SetSupportsStructuredOutput()
+	).SetGroup(PackagesGroup).SetSupportsStructuredOutput().SetPrompts(requirementPrompts...)
 }
 
 func newSearchCommand(prime *primer.Values) *captain.Command {
@@ -67,7 +67,7 @@ func newPlatformsAddCommand(prime *primer.Values) *captain.Command {
 		func(_ *captain.Command, _ []string) error {
 			return runner.Run(params)
 		},
-	).SetSupportsStructuredOutput()
+	).SetSupportsStructuredOutput().SetPrompts(requirementPrompts...)
 }
 
 func newPlatformsRemoveCommand(prime *primer.Values) *captain.Command {
@@ -98,5 +98,5 @@ func newPlatformsRemoveCommand(prime *primer.Values) *captain.Command {
 		func(_ *captain.Command, _ []string) error {
 			return runner.Run(params)
 		},
-	).SetSupportsStructuredOutput()
+	).SetSupportsStructuredOutput().SetPrompts(requirementPrompts...)
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/projects"
 	"github.com/ActiveState/cli/pkg/project"
 )
@@ -85,6 +86,7 @@ func newProjectsEditCommand(prime *primer.Values) *captain.Command {
 
 	cmd.SetGroup(ProjectUsageGroup)
 	cmd.SetUnstable(true)
+	cmd.SetPrompts(prompt.IDProjectsEdit)
 
 	return cmd
 }
@@ -113,6 +115,7 @@ func newDeleteProjectsCommand(prime *primer.Values) *captain.Command {
 	)
 	cmd.SetGroup(ProjectUsageGroup)
 	cmd.SetUnstable(true)
+	cmd.SetPrompts(prompt.IDProjectsDelete)
 
 	return cmd
 }
@@ -147,6 +150,7 @@ func newMoveProjectsCommand(prime *primer.Values) *captain.Command {
 	)
 	cmd.SetGroup(ProjectUsageGroup)
 	cmd.SetUnstable(true)
+	cmd.SetPrompts(prompt.IDProjectsMove)
 
 	return cmd
 }
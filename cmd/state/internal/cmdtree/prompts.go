@@ -0,0 +1,43 @@
+package cmdtree
+
+import (
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
+)
+
+// Prompts shared by several commands.
+var (
+	// requirementPrompts are asked by commands that change the requirements of a project.
+	requirementPrompts = []string{prompt.IDCVEsContinue, prompt.IDLicensesContinue}
+	// findProjectPrompts are asked by commands that find a local checkout of a project by its name.
+	findProjectPrompts = []string{prompt.IDProjectSelectNamespace, prompt.IDProjectSelectPath}
+	// secretPrompts are asked by commands that expand the secrets of a project, e.g. through its scripts or constants.
+	secretPrompts = []string{prompt.IDSecretValue, prompt.IDSecretExecTrust}
+	// installPrompts are asked by commands that install packages, languages or bundles.
+	installPrompts = append([]string{prompt.IDInstallSelectIngredient}, requirementPrompts...)
+)
+
+// answersFile implements the global --answers flag, which answers prompts from the given file for a single invocation.
+type answersFile struct {
+	prime *primer.Values
+	path  string
+}
+
+func (a *answersFile) Set(v string) error {
+	answers, err := prompt.LoadAnswers(v)
+	if err != nil {
+		return errs.Wrap(err, "Could not load answers")
+	}
+	a.prime.Prompt().SetAnswers(answers)
+	a.path = v
+	return nil
+}
+
+func (a *answersFile) String() string {
+	return a.path
+}
+
+func (a *answersFile) Type() string {
+	return "path"
+}
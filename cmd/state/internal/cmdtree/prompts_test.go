@@ -0,0 +1,213 @@
+package cmdtree
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/environment"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
+)
+
+// promptCallers is the audit of which commands can ask which prompts. It lists every function that asks a prompt, by
+// its package and name, along with the commands that can end up calling it. TestPromptsDeclared fails if a function
+// asks a prompt without being listed here, or if a listed command does not declare the prompts the function asks.
+var promptCallers = map[string][]string{
+	"cmd/state-installer.Installer.Install":                        nil, // not a State Tool command
+	"cmd/state-remote-installer.execute":                           nil, // not a State Tool command
+	"internal/runbits/auth.RequireAuthentication":                  nil, // not used by any command
+	"internal/runbits/auth.ensureCredentials":                      {"auth"},
+	"internal/runbits/auth.promptToken":                            {"auth"},
+	"internal/runbits/auth.authenticateWithBrowser":                {"auth", "auth signup"},
+	"internal/runbits/auth.promptForPreviousPassphrase":            {"auth"},
+	"internal/runbits/auth.promptUserToRegenerateKeypair":          {"auth"},
+	"internal/runbits/cves.CveReport.Report":                       requirementCommands,
+	"internal/runbits/licenses.LicenseReport.Report":               append([]string{"refresh"}, requirementCommands...),
+	"internal/runbits/findproject.FromNamespaceLocal":              {"activate", "refresh", "shell", "use"},
+	"internal/runners/clean.Cache.removeCache":                     {"clean cache"},
+	"internal/runners/clean.Cache.removeProjectCache":              {"clean cache"},
+	"internal/runners/clean.Config.Run":                            {"clean config"},
+	"internal/runners/clean.Uninstall.Run":                         {"clean uninstall"},
+	"internal/runners/cve.Fix.confirm":                             {"security fix"},
+	"internal/runners/fork.determineOwner":                         {"fork"},
+	"internal/runners/install.Install.promptForMatchingIngredient": {"install", "languages install", "bundles install", "packages add", "packages update"},
+	"internal/runners/invite.invite.promptForRole":                 {"invite"},
+	"internal/runners/projects.Delete.Run":                         {"projects delete"},
+	"internal/runners/projects.Edit.Run":                           {"projects edit"},
+	"internal/runners/projects.Move.Run":                           {"projects move"},
+	"internal/runners/publish.Runner.OpenInEditor":                 {"publish"},
+	"internal/runners/publish.Runner.Run":                          {"publish"},
+	"internal/runners/push.Push.Run":                               {"push"},
+	"internal/runners/push.Push.promptNamespace":                   {"push"},
+	"internal/runners/reset.Reset.Run":                             {"reset"},
+	"internal/runners/revert.Revert.Run":                           {"revert"},
+	"internal/runners/scripts.startInteractive":                    {"scripts edit"},
+	"internal/runners/update.confirmLock":                          {"update lock"},
+	"internal/runners/update.confirmUnlock":                        {"update unlock"},
+	"internal/runners/upgrade.Upgrade.renderUserFacing":            {"upgrade"},
+	"internal/runners/use.Reset.Run":                               {"use reset"},
+	// Secrets are expanded with prompts by every command that evaluates scripts, events or constants of the project.
+	"internal/secrets/providers.execProvider.trust": secretCommands,
+	"pkg/project.SecretExpander.ExpandWithPrompt":   secretCommands,
+}
+
+// requirementCommands change the requirements of a project, which is reported on before the change is applied.
+var requirementCommands = []string{
+	"install", "uninstall", "import", "commit", "checkout", "platforms add", "platforms remove", "languages install",
+	"bundles install", "bundles uninstall", "packages add", "packages update", "packages remove", "packages import",
+}
+
+// secretCommands evaluate the scripts, events or constants of a project, any of which can reference its secrets.
+var secretCommands = []string{
+	"activate", "events", "exec", "export github-actions", "run", "scripts edit", "shell", "show constants",
+}
+
+// promptCallSites returns the IDs of the prompts asked by every function outside of the prompt package and the command
+// tree, keyed like promptCallers.
+func promptCallSites(t *testing.T, root string) map[string][]string {
+	sites := map[string][]string{}
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel := filepath.ToSlash(must(filepath.Rel(root, path)))
+		if d.IsDir() {
+			switch rel {
+			case "vendor", "test", "internal/prompt", "cmd/state/internal/cmdtree", ".git":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			name := fn.Name.Name
+			if fn.Recv != nil && len(fn.Recv.List) > 0 {
+				recv := fn.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				if ident, ok := recv.(*ast.Ident); ok {
+					name = ident.Name + "." + name
+				}
+			}
+			key := filepath.ToSlash(filepath.Dir(rel)) + "." + name
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "prompt" && strings.HasPrefix(sel.Sel.Name, "ID") {
+					sites[key] = append(sites[key], sel.Sel.Name)
+				}
+				return true
+			})
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return sites
+}
+
+// must returns rel, panicking on err.
+func must(rel string, err error) string {
+	if err != nil {
+		panic(err)
+	}
+	return rel
+}
+
+// commandsByPath adds the subcommands of cmd to result, keyed by their path such as "packages install".
+func commandsByPath(cmd *captain.Command, result map[string]*captain.Command) {
+	for _, child := range cmd.Children() {
+		result[child.JoinedSubCommandNames()] = child
+		commandsByPath(child, result)
+	}
+}
+
+func TestPromptsDeclared(t *testing.T) {
+	root, err := environment.GetRootPath()
+	require.NoError(t, err)
+	sites := promptCallSites(t, root)
+
+	commands := map[string]*captain.Command{}
+	commandsByPath(New(primer.New()).Command(), commands)
+
+	for site, ids := range sites {
+		callers, ok := promptCallers[site]
+		if !assert.True(t, ok, "%s asks prompts %v, add the commands that can call it to promptCallers", site, ids) {
+			continue
+		}
+		for _, path := range callers {
+			cmd, ok := commands[path]
+			if !assert.True(t, ok, "command %q of %s does not exist", path, site) {
+				continue
+			}
+			for _, id := range ids {
+				assert.Contains(t, cmd.Prompts(), promptID(t, id), "command %q can ask %s through %s, but does not declare it", path, id, site)
+			}
+		}
+	}
+
+	for site := range promptCallers {
+		_, ok := sites[site]
+		assert.True(t, ok, "%s no longer asks prompts, remove it from promptCallers", site)
+	}
+
+	for path, cmd := range commands {
+		for _, id := range cmd.Prompts() {
+			_, ok := prompt.Lookup(id)
+			assert.True(t, ok, "command %q declares unknown prompt %s", path, id)
+		}
+	}
+}
+
+// promptIDs maps the names of the prompt ID constants to their values.
+var promptIDs = map[string]string{}
+
+// promptID returns the value of the prompt ID constant with the given name.
+func promptID(t *testing.T, name string) string {
+	if len(promptIDs) == 0 {
+		root, err := environment.GetRootPath()
+		require.NoError(t, err)
+		f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(root, "internal", "prompt", "ids.go"), nil, 0)
+		require.NoError(t, err)
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				v := spec.(*ast.ValueSpec)
+				for i, n := range v.Names {
+					if lit, ok := v.Values[i].(*ast.BasicLit); ok {
+						promptIDs[n.Name] = strings.Trim(lit.Value, `"`)
+					}
+				}
+			}
+		}
+	}
+	id, ok := promptIDs[name]
+	require.True(t, ok, "unknown prompt ID constant %s", name)
+	return id
+}
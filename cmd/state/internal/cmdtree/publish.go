@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/publish"
 )
 
@@ -128,5 +129,6 @@ func newPublish(prime *primer.Values) *captain.Command {
 			return runner.Run(&params)
 		})
 	c.SetGroup(AuthorGroup)
+	c.SetPrompts(prompt.IDPublishConfirm, prompt.IDPublishEditDone)
 	return c
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/push"
 	"github.com/ActiveState/cli/pkg/project"
 )
@@ -32,5 +33,5 @@ func newPushCommand(prime *primer.Values) *captain.Command {
 		func(ccmd *captain.Command, args []string) error {
 			return pushRunner.Run(params)
 		},
-	).SetGroup(VCSGroup).SetSupportsStructuredOutput()
+	).SetGroup(VCSGroup).SetSupportsStructuredOutput().SetPrompts(prompt.IDPushCreateCopy, prompt.IDPushCreateProject, prompt.IDPushOwner, prompt.IDPushName)
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/refresh"
 	"github.com/ActiveState/cli/pkg/project"
 )
@@ -20,7 +21,13 @@ func newRefreshCommand(prime *primer.Values) *captain.Command {
 		"",
 		locale.Tl("refresh_description", "Updates the given project runtime based on its current configuration"),
 		prime,
-		[]*captain.Flag{},
+		[]*captain.Flag{
+			{
+				Name:        "all",
+				Description: locale.Tl("flag_state_refresh_all_description", "Update the runtimes of all projects in the current workspace"),
+				Value:       &params.All,
+			},
+		},
 		[]*captain.Argument{
 			{
 				Name:        locale.T("arg_state_activate_namespace"),
@@ -34,5 +41,6 @@ func newRefreshCommand(prime *primer.Values) *captain.Command {
 	)
 	cmd.SetGroup(EnvironmentUsageGroup)
 	cmd.SetSupportsStructuredOutput()
+	cmd.SetPrompts(append(findProjectPrompts, prompt.IDLicensesContinue)...)
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/reset"
 )
 
@@ -27,5 +28,5 @@ func newResetCommand(prime *primer.Values) *captain.Command {
 		func(ccmd *captain.Command, args []string) error {
 			return runner.Run(params)
 		},
-	).SetGroup(VCSGroup).SetSupportsStructuredOutput()
+	).SetGroup(VCSGroup).SetSupportsStructuredOutput().SetPrompts(prompt.IDResetConfirm)
 }
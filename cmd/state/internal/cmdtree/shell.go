@@ -29,6 +29,11 @@ func newShellCommand(prime *primer.Values) *captain.Command {
 				Description: locale.Tl("flag_state_shell_cd_description", "Change to the project directory after starting virtual environment shell/prompt"),
 				Value:       &params.ChangeDirectory,
 			},
+			{
+				Name:        "workspace",
+				Description: locale.Tl("flag_state_shell_workspace_description", "Start a shell with the combined runtimes of all projects in the current workspace"),
+				Value:       &params.Workspace,
+			},
 		},
 		[]*captain.Argument{
 			{
@@ -43,5 +48,6 @@ func newShellCommand(prime *primer.Values) *captain.Command {
 	)
 	cmd.SetGroup(EnvironmentUsageGroup)
 	cmd.SetAliases("prompt")
+	cmd.SetPrompts(append(findProjectPrompts, secretPrompts...)...)
 	return cmd
 }
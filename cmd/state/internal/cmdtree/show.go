@@ -30,3 +30,17 @@ func newShowCommand(prime *primer.Values) *captain.Command {
 		},
 	).SetGroup(ProjectUsageGroup).SetSupportsStructuredOutput().SetUnstable(true)
 }
+
+func newShowConstantsCommand(prime *primer.Values) *captain.Command {
+	return captain.NewCommand(
+		"constants",
+		locale.Tl("show_constants_title", "Showing Constants"),
+		locale.Tl("show_constants_description", "Show the constants of your project, their resolved values and where those came from. Constants can be overridden by setting ACTIVESTATE_CONSTANT_<name> in your environment."),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return show.NewConstants(prime).Run()
+		},
+	).SetSupportsStructuredOutput().SetUnstable(true).SetPrompts(secretPrompts...)
+}
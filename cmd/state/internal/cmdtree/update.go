@@ -6,6 +6,7 @@ import (
 
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/prompt"
 )
 
 func newUpdateCommand(prime *primer.Values) *captain.Command {
@@ -57,6 +58,7 @@ func newUpdateLockCommand(prime *primer.Values) *captain.Command {
 	)
 	cmd.SetSkipChecks(true)
 	cmd.SetSupportsStructuredOutput()
+	cmd.SetPrompts(prompt.IDUpdateLock)
 	return cmd
 }
 
@@ -76,5 +78,6 @@ func newUpdateUnlockCommand(prime *primer.Values) *captain.Command {
 		},
 	)
 	cmd.SetSkipChecks(true)
+	cmd.SetPrompts(prompt.IDUpdateUnlock)
 	return cmd
 }
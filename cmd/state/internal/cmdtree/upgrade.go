@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/upgrade"
 )
 
@@ -38,6 +39,7 @@ func newUpgradeCommand(prime *primer.Values) *captain.Command {
 	cmd.SetGroup(PackagesGroup)
 	cmd.SetSupportsStructuredOutput()
 	cmd.SetUnstable(true)
+	cmd.SetPrompts(prompt.IDUpgradeConfirm)
 
 	return cmd
 }
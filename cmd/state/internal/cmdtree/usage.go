@@ -0,0 +1,41 @@
+package cmdtree
+
+import (
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runners/usage"
+)
+
+func newUsageCommand(prime *primer.Values) *captain.Command {
+	return captain.NewCommand(
+		"usage",
+		locale.Tl("usage_title", "Listing Runtime Usage"),
+		locale.Tl("usage_description", "List which runtime executables were used, how often and when"),
+		prime,
+		[]*captain.Flag{},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return usage.New(prime).Run()
+		}).SetGroup(EnvironmentUsageGroup).SetSupportsStructuredOutput()
+}
+
+func newUsageIdleCommand(prime *primer.Values) *captain.Command {
+	params := usage.IdleParams{Days: 30}
+	return captain.NewCommand(
+		"idle",
+		locale.Tl("usage_idle_title", "Listing Idle Runtimes"),
+		locale.Tl("usage_idle_description", "List the runtimes of your checkouts that have not been used for a number of days"),
+		prime,
+		[]*captain.Flag{
+			{
+				Name:        "days",
+				Description: locale.Tl("flag_usage_idle_days", "The number of days a runtime must not have been used for"),
+				Value:       &params.Days,
+			},
+		},
+		[]*captain.Argument{},
+		func(_ *captain.Command, _ []string) error {
+			return usage.NewIdle(prime).Run(&params)
+		}).SetSupportsStructuredOutput()
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ActiveState/cli/internal/captain"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runners/use"
 	"github.com/ActiveState/cli/pkg/project"
 )
@@ -30,6 +31,7 @@ func newUseCommand(prime *primer.Values) *captain.Command {
 			return use.NewUse(prime).Run(params)
 		},
 	).SetGroup(EnvironmentUsageGroup).SetSupportsStructuredOutput()
+	cmd.SetPrompts(findProjectPrompts...)
 	return cmd
 }
 
@@ -46,7 +48,7 @@ func newUseResetCommand(prime *primer.Values) *captain.Command {
 		func(_ *captain.Command, _ []string) error {
 			return use.NewReset(prime).Run(params)
 		},
-	)
+	).SetPrompts(prompt.IDUseReset)
 }
 
 func newUseShowCommand(prime *primer.Values) *captain.Command {
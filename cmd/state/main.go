@@ -97,7 +97,9 @@ func main() {
 	// Configuration options
 	// This should only be used if the config option is not exclusive to one package.
 	configMediator.RegisterOption(constants.OptinBuildscriptsConfig, configMediator.Bool, false)
+	configMediator.AllowInProject(constants.OptinBuildscriptsConfig)
 	configMediator.RegisterOption(constants.NotificationsURLConfig, configMediator.String, "", constants.NotificationsOverrideEnvVarName)
+	configMediator.RegisterOption(constants.NotificationsDirConfig, configMediator.String, "")
 
 	// Set up our output formatter/writer
 	outFlags := parseOutputFlags(os.Args)
@@ -211,6 +213,9 @@ func run(args []string, cfg *config.Instance, out output.Outputer) (rerr error)
 		if err != nil {
 			return err
 		}
+		if ignored := cfg.SetProjectConfig(pj.Path(), pj.Config()); len(ignored) > 0 {
+			logging.Warning("Ignoring config keys that projects cannot set, or with invalid values, in %s: %s", pj.Path(), strings.Join(ignored, ", "))
+		}
 	}
 
 	pjNamespace := ""
@@ -227,6 +232,13 @@ func run(args []string, cfg *config.Instance, out output.Outputer) (rerr error)
 
 	// Set up prompter
 	prompter := prompt.New(out, an)
+	if path := os.Getenv(constants.AnswersEnvVarName); path != "" {
+		answers, err := prompt.LoadAnswers(path)
+		if err != nil {
+			return errs.Wrap(err, "Could not load answers")
+		}
+		prompter.SetAnswers(answers)
+	}
 
 	// Set up conditional, which accesses a lot of primer data
 	sshell := subshell.New(cfg)
@@ -249,7 +261,7 @@ func run(args []string, cfg *config.Instance, out output.Outputer) (rerr error)
 		logging.Debug("Could not find child command, error: %v", err)
 	}
 
-	notifier := notifier.New(out, svcmodel)
+	notifier := notifier.New(out, svcmodel, pj)
 	cmds.OnExecStart(notifier.OnExecStart)
 	cmds.OnExecStop(notifier.OnExecStop)
 
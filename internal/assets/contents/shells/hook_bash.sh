@@ -0,0 +1,21 @@
+_activestate_hook_read_hash() {
+  _ACTIVESTATE_HOOK_HASH=""
+  if [ -n "${ACTIVESTATE_HOOK_WATCH:-}" ] && [ -r "$ACTIVESTATE_HOOK_WATCH" ]; then
+    read -r _ACTIVESTATE_HOOK_HASH < "$ACTIVESTATE_HOOK_WATCH"
+  fi
+}
+_activestate_hook() {
+  local previous_exit_status=$?
+  local seen_hash="${_ACTIVESTATE_HOOK_HASH:-}"
+  _activestate_hook_read_hash
+  if [ "$PWD" != "${_ACTIVESTATE_HOOK_PWD:-}" ] || [ "${ACTIVESTATE_HOOK_STATE:-}" != "${_ACTIVESTATE_HOOK_SEEN_STATE:-}" ] || [ "$_ACTIVESTATE_HOOK_HASH" != "$seen_hash" ]; then
+    _ACTIVESTATE_HOOK_PWD="$PWD"
+    eval "$({{.Exec}} hook env --shell bash --non-interactive)"
+    _ACTIVESTATE_HOOK_SEEN_STATE="${ACTIVESTATE_HOOK_STATE:-}"
+    _activestate_hook_read_hash
+  fi
+  return $previous_exit_status
+}
+if [[ ";${PROMPT_COMMAND[*]:-};" != *";_activestate_hook;"* ]]; then
+  PROMPT_COMMAND="_activestate_hook${PROMPT_COMMAND:+;$PROMPT_COMMAND}"
+fi
@@ -0,0 +1,18 @@
+function _activestate_hook_read_hash
+    set -g _ACTIVESTATE_HOOK_HASH ""
+    if set -q ACTIVESTATE_HOOK_WATCH; and test -r "$ACTIVESTATE_HOOK_WATCH"
+        read -l hash <"$ACTIVESTATE_HOOK_WATCH"
+        set -g _ACTIVESTATE_HOOK_HASH "$hash"
+    end
+end
+function _activestate_hook --on-variable PWD --on-event fish_prompt
+    set -l seen_hash "$_ACTIVESTATE_HOOK_HASH"
+    _activestate_hook_read_hash
+    if test "$PWD" != "$_ACTIVESTATE_HOOK_PWD"; or test "$ACTIVESTATE_HOOK_STATE" != "$_ACTIVESTATE_HOOK_SEEN_STATE"; or test "$_ACTIVESTATE_HOOK_HASH" != "$seen_hash"
+        set -g _ACTIVESTATE_HOOK_PWD $PWD
+        {{.Exec}} hook env --shell fish --non-interactive | source
+        set -g _ACTIVESTATE_HOOK_SEEN_STATE "$ACTIVESTATE_HOOK_STATE"
+        _activestate_hook_read_hash
+    end
+end
+_activestate_hook
@@ -0,0 +1,20 @@
+_activestate_hook_read_hash() {
+  _ACTIVESTATE_HOOK_HASH=""
+  if [ -n "${ACTIVESTATE_HOOK_WATCH:-}" ] && [ -r "$ACTIVESTATE_HOOK_WATCH" ]; then
+    read -r _ACTIVESTATE_HOOK_HASH < "$ACTIVESTATE_HOOK_WATCH"
+  fi
+}
+_activestate_hook() {
+  local seen_hash="${_ACTIVESTATE_HOOK_HASH:-}"
+  _activestate_hook_read_hash
+  if [ "$PWD" != "${_ACTIVESTATE_HOOK_PWD:-}" ] || [ "${ACTIVESTATE_HOOK_STATE:-}" != "${_ACTIVESTATE_HOOK_SEEN_STATE:-}" ] || [ "$_ACTIVESTATE_HOOK_HASH" != "$seen_hash" ]; then
+    _ACTIVESTATE_HOOK_PWD="$PWD"
+    eval "$({{.Exec}} hook env --shell zsh --non-interactive)"
+    _ACTIVESTATE_HOOK_SEEN_STATE="${ACTIVESTATE_HOOK_STATE:-}"
+    _activestate_hook_read_hash
+  fi
+}
+autoload -Uz add-zsh-hook
+add-zsh-hook chpwd _activestate_hook
+add-zsh-hook precmd _activestate_hook
+_activestate_hook
@@ -106,6 +106,9 @@ type Command struct {
 	unstable         bool
 	structuredOutput bool
 
+	prompts     []string
+	dumpPrompts bool
+
 	examples []string
 
 	out       output.Outputer
@@ -404,6 +407,10 @@ func (c *Command) SetSupportsStructuredOutput() *Command {
 	return c
 }
 
+func (c *Command) SupportsStructuredOutput() bool {
+	return c.structuredOutput
+}
+
 func (c *Command) SkipChecks() bool {
 	return c.skipChecks
 }
@@ -538,6 +545,34 @@ func (c *Command) GenZshCompletion() (string, error) {
 	return buf.String(), nil
 }
 
+// GenNushellCompletion returns a nushell module defining completions for the top level command.
+func (c *Command) GenNushellCompletion() (string, error) {
+	return c.genCompletionFromAsset("shells/nushell_completions.nu")
+}
+
+// GenElvishCompletion returns an elvish script registering completions for the top level command.
+func (c *Command) GenElvishCompletion() (string, error) {
+	return c.genCompletionFromAsset("shells/elvish_completions.elv")
+}
+
+// genCompletionFromAsset renders a completion script for shells that cobra does not support, which complete by
+// calling into cobra's hidden completion command.
+func (c *Command) genCompletionFromAsset(name string) (string, error) {
+	contents, err := assets.ReadFileBytes(name)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not read completion template")
+	}
+	var out bytes.Buffer
+	tpl, err := template.New(name).Parse(string(contents))
+	if err != nil {
+		return "", errs.Wrap(err, "Could not parse completion template")
+	}
+	if err := tpl.Execute(&out, map[string]string{"Name": c.topLevelCobra().Name()}); err != nil {
+		return "", errs.Wrap(err, "Could not render completion template")
+	}
+	return out.String(), nil
+}
+
 func (c *Command) flagByName(name string, persistOnly bool) *Flag {
 	for _, flag := range c.flags {
 		if flag.Name == name && (!persistOnly || flag.Persist) {
@@ -612,6 +647,10 @@ func (c *Command) cobraExecHandler(cobraCmd *cobra.Command, args []string) (rerr
 		}
 	}
 
+	if c.TopParent().dumpPrompts {
+		return c.outputPrompts()
+	}
+
 	if c.shouldWarnUnstable() && !condition.OptInUnstable(c.cfg) {
 		c.out.Notice(locale.Tr("unstable_command_warning"))
 		return nil
@@ -0,0 +1,45 @@
+package captain
+
+import (
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/prompt"
+)
+
+// SetPrompts sets the IDs of the prompts the command may ask, so that users know what to put in an answers file.
+func (c *Command) SetPrompts(ids ...string) *Command {
+	c.prompts = ids
+	return c
+}
+
+func (c *Command) Prompts() []string {
+	return c.prompts
+}
+
+// SetDumpPrompts has commands list the prompts they may ask instead of running. It is set on the root command.
+func (c *Command) SetDumpPrompts(dump bool) {
+	c.dumpPrompts = dump
+}
+
+// outputPrompts lists the prompts the command may ask. The root command lists all prompts.
+func (c *Command) outputPrompts() error {
+	definitions := []prompt.Definition{}
+	if c.parent == nil {
+		definitions = prompt.Definitions()
+	}
+	for _, id := range c.prompts {
+		d, ok := prompt.Lookup(id)
+		if !ok {
+			return errs.New("Command %s has unknown prompt: %s", c.Name(), id) // programmer error
+		}
+		definitions = append(definitions, d)
+	}
+
+	if len(definitions) == 0 && !c.out.Type().IsStructured() {
+		c.out.Notice(locale.Tl("prompts_none", "This command does not ask any prompts."))
+		return nil
+	}
+	c.out.Print(output.Prepare(definitions, definitions))
+	return nil
+}
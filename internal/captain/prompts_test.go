@@ -0,0 +1,58 @@
+package captain
+
+import (
+	"testing"
+
+	"github.com/ActiveState/cli/internal/analytics"
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/prompt"
+	"github.com/ActiveState/cli/internal/testhelpers/outputhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type outputPrimer struct {
+	out output.Outputer
+}
+
+func (p outputPrimer) Output() output.Outputer         { return p.out }
+func (p outputPrimer) Analytics() analytics.Dispatcher { return nil }
+func (p outputPrimer) Config() *config.Instance        { return nil }
+
+func TestDumpPrompts(t *testing.T) {
+	ran := false
+	newCommand := func(name string, out output.Outputer) *Command {
+		return NewCommand(name, "", "", outputPrimer{out}, nil, []*Argument{{Name: "arg", Required: true}}, func(*Command, []string) error {
+			ran = true
+			return nil
+		})
+	}
+
+	out := outputhelper.NewCatcher()
+	root := newCommand("state", out)
+	install := newCommand("install", out).SetPrompts(prompt.IDCVEsContinue, prompt.IDLicensesContinue)
+	plain := newCommand("plain", out)
+	root.AddChildren(install, plain)
+	root.SetDumpPrompts(true)
+
+	require.NoError(t, root.Execute([]string{"install"}))
+	assert.False(t, ran, "commands are not run when dumping prompts, nor are their arguments required")
+	assert.Contains(t, out.Output(), prompt.IDCVEsContinue)
+	assert.Contains(t, out.Output(), prompt.IDLicensesContinue)
+	assert.NotContains(t, out.Output(), prompt.IDPushName)
+
+	out = outputhelper.NewCatcher()
+	root = newCommand("state", out)
+	plain = newCommand("plain", out)
+	root.AddChildren(plain)
+	root.SetDumpPrompts(true)
+
+	require.NoError(t, root.Execute([]string{"plain"}))
+	assert.Empty(t, out.Output())
+	assert.Contains(t, out.ErrorOutput(), "does not ask any prompts")
+
+	require.NoError(t, root.Execute([]string{}))
+	assert.Contains(t, out.Output(), prompt.IDPushName, "the root command lists all prompts")
+	assert.False(t, ran)
+}
@@ -0,0 +1,207 @@
+package captain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/colorize"
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+// JSON schema types of tool inputs.
+const (
+	schemaString  = "string"
+	schemaInteger = "integer"
+	schemaBoolean = "boolean"
+	schemaArray   = "array"
+)
+
+// ToolSpec describes a command that supports structured output as a tool, which can be called with JSON input rather
+// than command line arguments. The input schema is derived from the flags and arguments of the command.
+type ToolSpec struct {
+	Name        string                 `json:"name"`
+	Command     []string               `json:"command"`
+	Description string                 `json:"description"`
+	InputSchema map[string]interface{} `json:"input_schema"`
+	// Flags maps input properties to the flags they are passed as.
+	Flags map[string]string `json:"flags"`
+	// Arguments lists the input properties that are passed as arguments, in order.
+	Arguments []string `json:"arguments"`
+}
+
+// ToolSpecs returns the tool specs of the commands below the given one that support structured output. Hidden
+// commands are left out, as are unstable ones unless asked for.
+func ToolSpecs(cmd *Command, unstable bool) []*ToolSpec {
+	specs := []*ToolSpec{}
+	for _, child := range cmd.Children() {
+		if child.Hidden() || (child.Unstable() && !unstable) {
+			continue
+		}
+		if child.SupportsStructuredOutput() {
+			specs = append(specs, NewToolSpec(child))
+		}
+		specs = append(specs, ToolSpecs(child, unstable)...)
+	}
+	return specs
+}
+
+// NewToolSpec returns the tool spec of the given command.
+func NewToolSpec(cmd *Command) *ToolSpec {
+	names := cmd.commandNames(false)
+	spec := &ToolSpec{
+		Name:        strings.Join(names, "_"),
+		Command:     names,
+		Description: colorize.StripColorCodes(cmd.Description()),
+		Flags:       map[string]string{},
+		Arguments:   []string{},
+	}
+
+	properties := map[string]interface{}{}
+	required := []string{}
+	for _, flag := range cmd.Flags() {
+		if flag.Hidden {
+			continue
+		}
+		property := toolProperty(flag.Name)
+		properties[property] = schemaProperty(flag.Value, flag.Description)
+		spec.Flags[property] = flag.Name
+	}
+	for _, arg := range cmd.Arguments() {
+		property := toolProperty(arg.Name)
+		if _, exists := properties[property]; exists {
+			property += "_argument"
+		}
+		properties[property] = schemaProperty(arg.Value, arg.Description)
+		spec.Arguments = append(spec.Arguments, property)
+		if arg.Required {
+			required = append(required, property)
+		}
+	}
+
+	spec.InputSchema = map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		spec.InputSchema["required"] = required
+	}
+	return spec
+}
+
+// toolProperty turns flag and argument names, such as "set-default" or "org/project", into input property names.
+func toolProperty(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, strings.ToLower(name))
+}
+
+func schemaProperty(value interface{}, description string) map[string]interface{} {
+	property := map[string]interface{}{
+		"type":        schemaType(value),
+		"description": colorize.StripColorCodes(description),
+	}
+	if property["type"] == schemaArray {
+		property["items"] = map[string]interface{}{"type": schemaString}
+	}
+	return property
+}
+
+func schemaType(value interface{}) string {
+	switch value.(type) {
+	case *bool, *NullBool:
+		return schemaBoolean
+	case *int, *NullInt, *IntValue:
+		return schemaInteger
+	case *[]string, *PackagesValue, *PackagesValueNoVersion, *UsersValue:
+		return schemaArray
+	default:
+		return schemaString
+	}
+}
+
+// CommandLine returns the command line arguments that call the command with the given input. The given global flags
+// (eg. "--output", "json") are passed after the flags of the input, and before any arguments.
+func (t *ToolSpec) CommandLine(input map[string]interface{}, globalFlags ...string) ([]string, error) {
+	args := append([]string{}, t.Command...)
+
+	properties := make([]string, 0, len(input))
+	for property := range input {
+		properties = append(properties, property)
+	}
+	sort.Strings(properties)
+
+	for _, property := range properties {
+		flag, ok := t.Flags[property]
+		if !ok {
+			if !t.isArgument(property) {
+				return nil, errs.New("Unknown input: %s", property)
+			}
+			continue
+		}
+		values, err := inputValues(input[property])
+		if err != nil {
+			return nil, errs.Wrap(err, "Invalid input: %s", property)
+		}
+		for _, v := range values {
+			args = append(args, fmt.Sprintf("--%s=%s", flag, v))
+		}
+	}
+
+	args = append(args, globalFlags...)
+
+	positional := []string{}
+	for _, property := range t.Arguments {
+		if _, ok := input[property]; !ok {
+			continue
+		}
+		values, err := inputValues(input[property])
+		if err != nil {
+			return nil, errs.Wrap(err, "Invalid input: %s", property)
+		}
+		positional = append(positional, values...)
+	}
+	if len(positional) > 0 {
+		// Arguments could start with a dash, which must not be mistaken for flags
+		args = append(args, "--")
+		args = append(args, positional...)
+	}
+
+	return args, nil
+}
+
+func (t *ToolSpec) isArgument(property string) bool {
+	for _, arg := range t.Arguments {
+		if arg == property {
+			return true
+		}
+	}
+	return false
+}
+
+// inputValues converts a JSON input value to the values it is passed on the command line as.
+func inputValues(v interface{}) ([]string, error) {
+	switch vv := v.(type) {
+	case string:
+		return []string{vv}, nil
+	case bool:
+		return []string{strconv.FormatBool(vv)}, nil
+	case float64:
+		return []string{strconv.FormatFloat(vv, 'f', -1, 64)}, nil
+	case []interface{}:
+		values := []string{}
+		for _, item := range vv {
+			itemValues, err := inputValues(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, itemValues...)
+		}
+		return values, nil
+	}
+	return nil, errs.New("Unsupported value: %v", v)
+}
@@ -0,0 +1,83 @@
+package captain
+
+import (
+	"testing"
+
+	"github.com/ActiveState/cli/internal/analytics"
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPrimer struct{}
+
+func (testPrimer) Output() output.Outputer         { return nil }
+func (testPrimer) Analytics() analytics.Dispatcher { return nil }
+func (testPrimer) Config() *config.Instance        { return nil }
+
+func newTestCommand(name string, flags []*Flag, args []*Argument) *Command {
+	return NewCommand(name, name, "The [ACTIONABLE]"+name+"[/RESET] command", testPrimer{}, flags, args, func(*Command, []string) error { return nil })
+}
+
+func TestToolSpecs(t *testing.T) {
+	var (
+		expand   bool
+		limit    int
+		ts       TimeValue
+		ns       string
+		packages PackagesValue
+	)
+	root := newTestCommand("state", nil, nil)
+	pkgs := newTestCommand("packages", []*Flag{
+		{Name: "expand", Description: "Expand", Value: &expand},
+		{Name: "limit", Description: "Limit", Value: &limit},
+		{Name: "ts", Description: "Timestamp", Value: &ts, Hidden: true},
+	}, nil).SetSupportsStructuredOutput()
+	install := newTestCommand("install", []*Flag{
+		{Name: "namespace", Description: "Namespace", Value: &ns},
+	}, []*Argument{
+		{Name: "packages", Description: "Packages", Required: true, Value: &packages},
+	}).SetSupportsStructuredOutput()
+	hidden := newTestCommand("hidden", nil, nil).SetSupportsStructuredOutput()
+	hidden.SetHidden(true)
+	plain := newTestCommand("plain", nil, nil)
+
+	pkgs.AddChildren(install)
+	root.AddChildren(pkgs, hidden, plain)
+
+	specs := ToolSpecs(root, false)
+	require.Len(t, specs, 2)
+
+	assert.Equal(t, "packages", specs[0].Name)
+	assert.Equal(t, "The packages command", specs[0].Description)
+	properties := specs[0].InputSchema["properties"].(map[string]interface{})
+	assert.Equal(t, schemaBoolean, properties["expand"].(map[string]interface{})["type"])
+	assert.Equal(t, schemaInteger, properties["limit"].(map[string]interface{})["type"])
+	assert.NotContains(t, properties, "ts", "hidden flags are not exposed")
+
+	assert.Equal(t, "packages_install", specs[1].Name)
+	assert.Equal(t, []string{"packages", "install"}, specs[1].Command)
+	assert.Equal(t, []string{"packages"}, specs[1].InputSchema["required"])
+	properties = specs[1].InputSchema["properties"].(map[string]interface{})
+	assert.Equal(t, schemaArray, properties["packages"].(map[string]interface{})["type"])
+
+	args, err := specs[1].CommandLine(map[string]interface{}{
+		"namespace": "language/python",
+		"packages":  []interface{}{"requests", "-weird"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"packages", "install", "--namespace=language/python", "--", "requests", "-weird"}, args)
+
+	args, err = specs[1].CommandLine(map[string]interface{}{"packages": []interface{}{"requests"}}, "--output", "json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"packages", "install", "--output", "json", "--", "requests"}, args,
+		"global flags must not be passed as arguments")
+
+	args, err = specs[0].CommandLine(map[string]interface{}{"expand": true, "limit": float64(10)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"packages", "--expand=true", "--limit=10"}, args)
+
+	_, err = specs[0].CommandLine(map[string]interface{}{"unknown": "value"})
+	assert.Error(t, err)
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/installation/storage"
 	"github.com/ActiveState/cli/internal/logging"
-	mediator "github.com/ActiveState/cli/internal/mediators/config"
 	"github.com/ActiveState/cli/internal/multilog"
 	"github.com/ActiveState/cli/internal/profile"
 	"github.com/ActiveState/cli/internal/rtutils/singlethread"
@@ -29,6 +28,7 @@ type Instance struct {
 	closeThread bool
 	db          *sql.DB
 	closed      bool
+	layers      layers
 }
 
 func New() (*Instance, error) {
@@ -73,6 +73,10 @@ func NewCustom(localPath string, thread *singlethread.Thread, closeThread bool)
 	}
 	profile.Measure("config.createTable", t)
 
+	if err := i.loadSystemConfig(storage.SystemConfigPaths()...); err != nil {
+		return nil, errs.Wrap(err, "Could not load system config")
+	}
+
 	return i, nil
 }
 
@@ -177,24 +181,10 @@ func (i *Instance) rawGet(key string) interface{} {
 	return result
 }
 
+// Get returns the effective value of the given key, taking all config layers into account.
 func (i *Instance) Get(key string) interface{} {
-	opt := mediator.GetOption(key)
-
-	// An environment variable override takes precedence over any stored or default value.
-	if mediator.KnownOption(opt) {
-		if value, _, ok := mediator.EnvOverride(opt); ok {
-			return value
-		}
-	}
-
-	result := i.rawGet(key)
-	if result != nil {
-		return result
-	}
-	if mediator.KnownOption(opt) {
-		return mediator.GetDefault(opt)
-	}
-	return nil
+	value, _ := i.Lookup(key)
+	return value
 }
 
 // GetString retrieves a string for a given key
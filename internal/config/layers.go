@@ -0,0 +1,211 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	C "github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	mediator "github.com/ActiveState/cli/internal/mediators/config"
+	"gopkg.in/yaml.v2"
+)
+
+// Layer identifies where a config value comes from.
+type Layer string
+
+// Config layers, from highest to lowest precedence. Values locked by the system config take precedence
+// over all of them.
+const (
+	LayerOverride    Layer = "override"    // overridden for a single invocation
+	LayerEnvironment Layer = "environment" // overridden by an environment variable
+	LayerProject     Layer = "project"     // set in the config section of the project's activestate.yaml
+	LayerLocal       Layer = "local"       // set locally by the user (stored in config.db)
+	LayerSystem      Layer = "system"      // set by an administrator in the system-wide config file
+	LayerDefault     Layer = "default"     // not set anywhere; using the built-in default
+)
+
+// Origin describes where the effective value of a config key comes from.
+type Origin struct {
+	Layer Layer
+	// Location is the file or environment variable the value is read from, if any.
+	Location string
+	// Locked reports whether the key is locked by the system config, in which case no other layer
+	// can override it.
+	Locked bool
+}
+
+// SystemConfig is the format of the system-wide config file, e.g.
+//
+//	config:
+//	  security.prompt.enabled: true
+//	locked:
+//	  - security.prompt.enabled
+type SystemConfig struct {
+	Config map[string]interface{} `yaml:"config"`
+	Locked []string               `yaml:"locked"`
+}
+
+type layers struct {
+	mutex       sync.RWMutex
+	systemPaths map[string]string // the file each system value is read from
+	system      map[string]interface{}
+	locked      map[string]bool
+	projectPath string
+	project     map[string]interface{}
+	overrides   map[string]interface{}
+}
+
+// ReadSystemConfig parses the system-wide config file at the given path. A missing file is not an error.
+func ReadSystemConfig(path string) (*SystemConfig, error) {
+	result := &SystemConfig{}
+	if path == "" {
+		return result, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, nil
+		}
+		return nil, errs.Wrap(err, "Could not read system config file")
+	}
+	if err := yaml.Unmarshal(b, result); err != nil {
+		return nil, errs.Wrap(err, "Could not parse system config file at %s", path)
+	}
+	return result, nil
+}
+
+// loadSystemConfig loads the given system config files, in increasing order of precedence. Keys locked by any of them
+// are locked, to the value of the file that locks them (or the default), so that files of lower precedence cannot
+// change the value of a locked key.
+func (i *Instance) loadSystemConfig(paths ...string) error {
+	values := map[string]interface{}{}
+	locations := map[string]string{}
+	locked := map[string]bool{}
+	for _, path := range paths {
+		system, err := ReadSystemConfig(path)
+		if err != nil {
+			return errs.Wrap(err, "Could not load system config")
+		}
+		for _, key := range system.Locked {
+			delete(values, key)
+			delete(locations, key)
+		}
+		for key, value := range system.Config {
+			values[key] = value
+			locations[key] = path
+		}
+		for _, key := range system.Locked {
+			locked[key] = true
+		}
+	}
+
+	i.layers.mutex.Lock()
+	defer i.layers.mutex.Unlock()
+	i.layers.systemPaths = locations
+	i.layers.system = values
+	i.layers.locked = locked
+	return nil
+}
+
+// SetProjectConfig sets the values configured in the config section of the active project's
+// activestate.yaml, which is located at the given path. Projects are not trusted, so only options
+// allowed in projects are honoured, and only if their value is valid for the option's type. The keys
+// that are ignored are returned.
+func (i *Instance) SetProjectConfig(path string, values map[string]interface{}) []string {
+	project := map[string]interface{}{}
+	var ignored []string
+	for key, raw := range values {
+		opt := mediator.GetOption(key)
+		if !mediator.AllowedInProject(opt) {
+			ignored = append(ignored, key)
+			continue
+		}
+		value, valid := mediator.CoerceValue(opt, fmt.Sprint(raw))
+		if !valid {
+			ignored = append(ignored, key)
+			continue
+		}
+		project[key] = value
+	}
+	sort.Strings(ignored)
+
+	i.layers.mutex.Lock()
+	defer i.layers.mutex.Unlock()
+	i.layers.projectPath = path
+	i.layers.project = project
+	return ignored
+}
+
+// SetOverride overrides the value of a registered config key for the lifetime of this instance only.
+func (i *Instance) SetOverride(key, value string) error {
+	opt := mediator.GetOption(key)
+	if !mediator.KnownOption(opt) {
+		return errs.New("Unknown config key: %s", key)
+	}
+	v, valid := mediator.CoerceValue(opt, value)
+	if !valid {
+		return errs.New("Invalid value '%s' for config key: %s", value, key)
+	}
+
+	i.layers.mutex.Lock()
+	defer i.layers.mutex.Unlock()
+	if i.layers.overrides == nil {
+		i.layers.overrides = map[string]interface{}{}
+	}
+	i.layers.overrides[key] = v
+	return nil
+}
+
+// IsLocked returns whether the given key is locked by the system config.
+func (i *Instance) IsLocked(key string) bool {
+	i.layers.mutex.RLock()
+	defer i.layers.mutex.RUnlock()
+	return i.layers.locked[key]
+}
+
+// Lookup returns the effective value of the given key along with the layer it comes from.
+func (i *Instance) Lookup(key string) (interface{}, Origin) {
+	opt := mediator.GetOption(key)
+	known := mediator.KnownOption(opt)
+
+	i.layers.mutex.RLock()
+	defer i.layers.mutex.RUnlock()
+
+	if i.layers.locked[key] {
+		if value, ok := i.layers.system[key]; ok {
+			return value, Origin{Layer: LayerSystem, Location: i.layers.systemPaths[key], Locked: true}
+		}
+		return mediator.GetDefault(opt), Origin{Layer: LayerDefault, Locked: true}
+	}
+
+	if value, ok := i.layers.overrides[key]; ok {
+		return value, Origin{Layer: LayerOverride}
+	}
+
+	// An environment variable override takes precedence over any configured or default value.
+	if known {
+		if value, envVar, ok := mediator.EnvOverride(opt); ok {
+			return value, Origin{Layer: LayerEnvironment, Location: envVar}
+		}
+	}
+
+	if value, ok := i.layers.project[key]; ok {
+		return value, Origin{Layer: LayerProject, Location: i.layers.projectPath}
+	}
+
+	if value := i.rawGet(key); value != nil {
+		return value, Origin{Layer: LayerLocal, Location: filepath.Join(i.appDataDir, C.InternalConfigFileName)}
+	}
+
+	if value, ok := i.layers.system[key]; ok {
+		return value, Origin{Layer: LayerSystem, Location: i.layers.systemPaths[key]}
+	}
+
+	if known {
+		return mediator.GetDefault(opt), Origin{Layer: LayerDefault}
+	}
+	return nil, Origin{Layer: LayerDefault}
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/constants"
+	mediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/internal/rtutils/singlethread"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSystemConfig_Precedence(t *testing.T) {
+	mediator.RegisterOption("test.layers.admin", mediator.Bool, true)
+	mediator.RegisterOption("test.layers.admindefault", mediator.Bool, true)
+	mediator.RegisterOption("test.layers.env", mediator.Bool, false)
+
+	dir := t.TempDir()
+	envPath := filepath.Join(dir, "env.yaml")
+	require.NoError(t, os.WriteFile(envPath, []byte(`
+config:
+  test.layers.admin: false
+  test.layers.admindefault: false
+  test.layers.env: true
+`), 0644))
+	adminPath := filepath.Join(dir, "admin.yaml")
+	require.NoError(t, os.WriteFile(adminPath, []byte(`
+config:
+  test.layers.admin: true
+locked:
+  - test.layers.admin
+  - test.layers.admindefault
+`), 0644))
+
+	t.Setenv(constants.SystemConfigEnvVarName, "")
+	cfg, err := NewCustom(filepath.Join(dir, "appdata"), singlethread.New(), true)
+	require.NoError(t, err)
+	defer cfg.Close()
+	require.NoError(t, cfg.loadSystemConfig(envPath, adminPath))
+
+	value, origin := cfg.Lookup("test.layers.admin")
+	assert.Equal(t, true, value, "the administrator's file takes precedence")
+	assert.Equal(t, adminPath, origin.Location)
+	assert.True(t, origin.Locked)
+
+	value, origin = cfg.Lookup("test.layers.admindefault")
+	assert.Equal(t, true, value, "keys locked at their default cannot be changed by files of lower precedence")
+	assert.Equal(t, LayerDefault, origin.Layer)
+
+	value, origin = cfg.Lookup("test.layers.env")
+	assert.Equal(t, true, value)
+	assert.Equal(t, envPath, origin.Location)
+	assert.False(t, origin.Locked)
+}
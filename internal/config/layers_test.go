@@ -0,0 +1,139 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/constants"
+	mediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/internal/rtutils/singlethread"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLayeredConfig(t *testing.T, system string) *config.Instance {
+	dir := t.TempDir()
+	systemPath := filepath.Join(dir, constants.SystemConfigFileName)
+	require.NoError(t, os.WriteFile(systemPath, []byte(system), 0644))
+	t.Setenv(constants.SystemConfigEnvVarName, systemPath)
+
+	cfg, err := config.NewCustom(filepath.Join(dir, "appdata"), singlethread.New(), true)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, cfg.Close()) })
+	return cfg
+}
+
+func TestLayerPrecedence(t *testing.T) {
+	mediator.RegisterOption("test.layers.precedence", mediator.String, "default")
+	mediator.AllowInProject("test.layers.precedence")
+	cfg := newLayeredConfig(t, `
+config:
+  test.layers.precedence: system
+`)
+	key := "test.layers.precedence"
+
+	value, origin := cfg.Lookup(key)
+	assert.Equal(t, "system", value)
+	assert.Equal(t, config.LayerSystem, origin.Layer)
+	assert.False(t, origin.Locked)
+
+	require.NoError(t, cfg.Set(key, "local"))
+	value, origin = cfg.Lookup(key)
+	assert.Equal(t, "local", value)
+	assert.Equal(t, config.LayerLocal, origin.Layer)
+
+	cfg.SetProjectConfig("/project/activestate.yaml", map[string]interface{}{key: "project"})
+	value, origin = cfg.Lookup(key)
+	assert.Equal(t, "project", value)
+	assert.Equal(t, config.LayerProject, origin.Layer)
+	assert.Equal(t, "/project/activestate.yaml", origin.Location)
+
+	t.Setenv(mediator.CanonicalEnvVarName(key), "environment")
+	value, origin = cfg.Lookup(key)
+	assert.Equal(t, "environment", value)
+	assert.Equal(t, config.LayerEnvironment, origin.Layer)
+
+	require.NoError(t, cfg.SetOverride(key, "override"))
+	assert.Equal(t, "override", cfg.GetString(key))
+	_, origin = cfg.Lookup(key)
+	assert.Equal(t, config.LayerOverride, origin.Layer)
+}
+
+func TestLockedKeys(t *testing.T) {
+	mediator.RegisterOption("test.layers.locked", mediator.Bool, false)
+	mediator.RegisterOption("test.layers.lockeddefault", mediator.Bool, false)
+	mediator.AllowInProject("test.layers.locked")
+	cfg := newLayeredConfig(t, `
+config:
+  test.layers.locked: true
+locked:
+  - test.layers.locked
+  - test.layers.lockeddefault
+`)
+
+	require.NoError(t, cfg.Set("test.layers.locked", false))
+	cfg.SetProjectConfig("activestate.yaml", map[string]interface{}{"test.layers.locked": false})
+	require.NoError(t, cfg.SetOverride("test.layers.locked", "false"))
+	t.Setenv(mediator.CanonicalEnvVarName("test.layers.locked"), "false")
+
+	assert.True(t, cfg.IsLocked("test.layers.locked"))
+	assert.True(t, cfg.GetBool("test.layers.locked"))
+	_, origin := cfg.Lookup("test.layers.locked")
+	assert.Equal(t, config.LayerSystem, origin.Layer)
+	assert.True(t, origin.Locked)
+
+	// Locking a key without setting it locks its default value.
+	require.NoError(t, cfg.Set("test.layers.lockeddefault", true))
+	assert.False(t, cfg.GetBool("test.layers.lockeddefault"))
+	_, origin = cfg.Lookup("test.layers.lockeddefault")
+	assert.Equal(t, config.LayerDefault, origin.Layer)
+	assert.True(t, origin.Locked)
+}
+
+func TestProjectConfig(t *testing.T) {
+	mediator.RegisterOption("test.layers.project.int", mediator.Int, 1)
+	mediator.AllowInProject("test.layers.project.int")
+	mediator.RegisterOption("test.layers.project.bool", mediator.Bool, false)
+	mediator.AllowInProject("test.layers.project.bool")
+	mediator.RegisterOption("test.layers.project.unsafe", mediator.String, "default")
+	cfg := newLayeredConfig(t, "")
+
+	ignored := cfg.SetProjectConfig("activestate.yaml", map[string]interface{}{
+		"test.layers.project.int":     2,
+		"test.layers.project.bool":    "one",
+		"test.layers.project.unsafe":  "https://example.com",
+		"test.layers.project.unknown": true,
+	})
+	assert.Equal(t, []string{"test.layers.project.bool", "test.layers.project.unknown", "test.layers.project.unsafe"}, ignored)
+
+	assert.Equal(t, 2, cfg.Get("test.layers.project.int"))
+	_, origin := cfg.Lookup("test.layers.project.int")
+	assert.Equal(t, config.LayerProject, origin.Layer)
+
+	assert.False(t, cfg.GetBool("test.layers.project.bool"), "invalid values are ignored")
+	assert.Equal(t, "default", cfg.GetString("test.layers.project.unsafe"), "keys that are not allowed in projects are ignored")
+	_, origin = cfg.Lookup("test.layers.project.unsafe")
+	assert.Equal(t, config.LayerDefault, origin.Layer)
+}
+
+func TestSetOverride(t *testing.T) {
+	mediator.RegisterOption("test.layers.override", mediator.Int, 1)
+	cfg := newLayeredConfig(t, "")
+
+	assert.Error(t, cfg.SetOverride("test.layers.unknown", "1"))
+	assert.Error(t, cfg.SetOverride("test.layers.override", "one"))
+	require.NoError(t, cfg.SetOverride("test.layers.override", "2"))
+	assert.Equal(t, 2, cfg.Get("test.layers.override"))
+}
+
+func TestInvalidSystemConfig(t *testing.T) {
+	dir := t.TempDir()
+	systemPath := filepath.Join(dir, constants.SystemConfigFileName)
+	require.NoError(t, os.WriteFile(systemPath, []byte("config: [invalid"), 0644))
+	t.Setenv(constants.SystemConfigEnvVarName, systemPath)
+
+	_, err := config.NewCustom(filepath.Join(dir, "appdata"), singlethread.New(), true)
+	assert.Error(t, err)
+}
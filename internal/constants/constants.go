@@ -25,6 +25,9 @@ const ServiceCommandName = "state-svc"
 // ConfigFileName holds the name of the file that the user uses to configure their project, not to be confused with InternalConfigFileNameLegacy
 const ConfigFileName = "activestate.yaml"
 
+// WorkspaceFileName holds the name of the file that lists the member projects of a workspace
+const WorkspaceFileName = "activestate.workspace.yaml"
+
 // BuildScriptFileName holds the name of the file that represents the build script used to generate the runtime
 const BuildScriptFileName = "buildscript.as"
 
@@ -37,6 +40,12 @@ const HomeEnvVarName = "ACTIVESTATE_HOME"
 // ConfigEnvVarName is the env var used to override the config dir that the State Tool uses
 const ConfigEnvVarName = "ACTIVESTATE_CLI_CONFIGDIR"
 
+// SystemConfigEnvVarName is the env var used to add a system-wide config file, which cannot override the one owned by administrators
+const SystemConfigEnvVarName = "ACTIVESTATE_CLI_SYSTEM_CONFIG"
+
+// SystemConfigFileName is the name of the system-wide config file, through which administrators can set and lock config values
+const SystemConfigFileName = "config.yaml"
+
 // CacheEnvVarName is the env var used to override the cache dir that the State Tool uses
 const CacheEnvVarName = "ACTIVESTATE_CLI_CACHEDIR"
 
@@ -76,6 +85,17 @@ const AutoUpdateTimeoutEnvVarName = "ACTIVESTATE_CLI_UPDATE_TIMEOUT"
 // EnvironmentEnvVarName is the name of the environment variable that specifies the current environment (dev, qa, prod, etc.)
 const EnvironmentEnvVarName = "ACTIVESTATE_ENVIRONMENT"
 
+// AnswersEnvVarName is the env var used to give the answers file that prompts are answered from
+const AnswersEnvVarName = "ACTIVESTATE_ANSWERS"
+
+// HookStateEnvVarName is the name of the environment variable in which the shell hook records the project it
+// activated, along with the values the activation replaced
+const HookStateEnvVarName = "ACTIVESTATE_HOOK_STATE"
+
+// HookWatchEnvVarName is the name of the environment variable in which the shell hook records the runtime hash file of
+// the project it is in, so that the shell can run the hook again when the runtime changes, e.g. after a refresh
+const HookWatchEnvVarName = "ACTIVESTATE_HOOK_WATCH"
+
 // ActivatedStateEnvVarName is the name of the environment variable that is set when in an activated state, its value will be the path of the project
 const ActivatedStateEnvVarName = "ACTIVESTATE_ACTIVATED"
 
@@ -213,6 +233,10 @@ const KeypairLocalFileName = "private"
 // generating new Keypairs.
 const DefaultRSABitLength int = 4096
 
+// ConstantEnvVarPrefix is the prefix of the environment variables that override the values of project constants,
+// eg. ACTIVESTATE_CONSTANT_PORT overrides the PORT constant
+const ConstantEnvVarPrefix = "ACTIVESTATE_CONSTANT_"
+
 // ExpanderMaxDepth defines the maximum depth to fully expand a given value.
 const ExpanderMaxDepth = int(10)
 
@@ -382,6 +406,18 @@ const RCAppendAutostartStartLine = "## START ACTIVESTATE AUTOSTART"
 // RCAppendAutostartStartLine is the end line used to denote our autostart executables in RC files
 const RCAppendAutostartStopLine = "## STOP ACTIVESTATE AUTOSTART"
 
+// RCAppendCompletionsStartLine is the start line used to denote our completions in RC files, for shells that only load completions from there
+const RCAppendCompletionsStartLine = "## START ACTIVESTATE COMPLETIONS"
+
+// RCAppendCompletionsStopLine is the end line used to denote our completions in RC files
+const RCAppendCompletionsStopLine = "## STOP ACTIVESTATE COMPLETIONS"
+
+// RCAppendHookStartLine is the start line used to denote our directory change hook in RC files
+const RCAppendHookStartLine = "## START ACTIVESTATE HOOK"
+
+// RCAppendHookStopLine is the end line used to denote our directory change hook in RC files
+const RCAppendHookStopLine = "## STOP ACTIVESTATE HOOK"
+
 // ForumsURL is the URL to the state tool forums
 const ForumsURL = "https://community.activestate.com/c/state-tool/"
 
@@ -412,6 +448,9 @@ const ReportAnalyticsConfig = "report.analytics"
 // PreferredGlibcVersionConfig is the config key used to determine the preferred glibc version
 const PreferredGlibcVersionConfig = "runtime.preferred.glibc"
 
+// RuntimeProgressConfig is the config key for where machine readable runtime progress events are written to, if anywhere
+const RuntimeProgressConfig = "runtime.progress"
+
 // SecurityReportingConfig is the config key used to determine if we will report security information (ie. CVEs)
 const SecurityReportingConfig = "security.reporting"
 
@@ -421,6 +460,33 @@ const SecurityPromptConfig = "security.prompt.enabled"
 // SecurityPromptLevelConfig is the config key used to determine the level of security prompts
 const SecurityPromptLevelConfig = "security.prompt.level"
 
+// SecurityOfflineConfig is the config key used to determine if vulnerabilities are checked against the local vulnerability database
+const SecurityOfflineConfig = "security.offline.enabled"
+
+// SecurityOfflineDBConfig is the config key for the directory of the local vulnerability database
+const SecurityOfflineDBConfig = "security.offline.path"
+
+// LicensePolicyFileConfig is the config key for the path of an organization-wide license policy file
+const LicensePolicyFileConfig = "licenses.policy.file"
+
+// InitTemplatesPathConfig is the config key for the directories `state init --template` looks up templates in, separated by the OS path list separator
+const InitTemplatesPathConfig = "init.templates.path"
+
+// SecretsAgeIdentityConfig is the config key for the age identity file used to decrypt secrets provided by .age files
+const SecretsAgeIdentityConfig = "secrets.age.identity"
+
+// SecretsVaultAddressConfig is the config key for the address of the Vault server secrets with the vault provider are read from
+const SecretsVaultAddressConfig = "secrets.vault.address"
+
+// SecretsExecTrustedConfig is the config key holding the comma separated hashes of the exec secret commands the user trusts
+const SecretsExecTrustedConfig = "secrets.exec.trusted"
+
+// MCPToolsAllowConfig is the config key for the comma separated command tools the MCP server exposes, all if "*"
+const MCPToolsAllowConfig = "mcp.tools.allow"
+
+// MCPToolsDenyConfig is the config key for the comma separated command tools the MCP server does not expose
+const MCPToolsDenyConfig = "mcp.tools.deny"
+
 // AnalyticsPixelOverrideConfig is the config key used to override the analytics pixel url
 const AnalyticsPixelOverrideConfig = "report.analytics.endpoint"
 
@@ -433,6 +499,15 @@ const UpdateInfoEndpointConfig = "update.info.endpoint"
 // NotificationsURLConfig is the config key used to determine the notifications url to use
 const NotificationsURLConfig = "notifications.endpoint"
 
+// NotificationsDirConfig is the config key used to determine the directory that local notification files are read from
+const NotificationsDirConfig = "notifications.dir"
+
+// NotificationsSnoozedConfig is the config key holding the notifications the user snoozed, and until when
+const NotificationsSnoozedConfig = "notifications.snoozed"
+
+// NotificationsAcknowledgedConfig is the config key holding the notifications the user acknowledged
+const NotificationsAcknowledgedConfig = "notifications.acknowledged"
+
 // APIHostConfig is the config key used to determine the api host
 const APIHostConfig = "api.host"
 
@@ -530,6 +605,10 @@ const BuildProgressUrlPathName = "distributions"
 // RuntimeCacheSizeConfigKey is the config key for the runtime cache size.
 const RuntimeCacheSizeConfigKey = "runtime.cache.size"
 
+// BuildLogArchiveConfig is the config key used to determine whether build logs are archived locally
+// for artifacts built during runtime setup.
+const BuildLogArchiveConfig = "runtime.buildlogs.archive"
+
 // PrivateIngredientKeyServiceURLConfig is the config key holding the URL of the
 // customer-hosted org key service (the GET .../v1/org-key endpoint).
 const PrivateIngredientKeyServiceURLConfig = "privateingredient.key_service_url"
@@ -35,6 +35,13 @@ type GlobResult struct {
 	Hash  string            `json:"hash"`
 }
 
+type IdleRuntime struct {
+	Namespace   string `json:"namespace"`
+	ProjectPath string `json:"projectPath"`
+	RuntimePath string `json:"runtimePath"`
+	LastUsed    string `json:"lastUsed"`
+}
+
 type Jwt struct {
 	Token string `json:"token"`
 	User  *User  `json:"user"`
@@ -76,6 +83,18 @@ type ReportRuntimeUsageResponse struct {
 	Received bool `json:"received"`
 }
 
+type RuntimeUsage struct {
+	Exec        string `json:"exec"`
+	Source      string `json:"source"`
+	Namespace   string `json:"namespace"`
+	CommitID    string `json:"commitID"`
+	RuntimePath string `json:"runtimePath"`
+	Count       int    `json:"count"`
+	FirstUsed   string `json:"firstUsed"`
+	LastUsed    string `json:"lastUsed"`
+	Running     bool   `json:"running"`
+}
+
 type StateVersion struct {
 	License  string `json:"license"`
 	Version  string `json:"version"`
@@ -114,6 +114,20 @@ func CachePath() string {
 	return cachePath
 }
 
+// SystemConfigPaths returns the paths of the system-wide config files, in increasing order of precedence. The file set
+// through the environment, which is meant for tests, can only add to the file owned by administrators, so that it
+// cannot be used to lift their locks. The latter is not consulted in unit tests.
+func SystemConfigPaths() []string {
+	var paths []string
+	if path := os.Getenv(constants.SystemConfigEnvVarName); path != "" {
+		paths = append(paths, path)
+	}
+	if !condition.InUnitTest() {
+		paths = append(paths, filepath.Join(BaseSystemConfigPath(), constants.InternalConfigNamespace, constants.SystemConfigFileName))
+	}
+	return paths
+}
+
 func GlobalBinDir() string {
 	return filepath.Join(CachePath(), "bin")
 }
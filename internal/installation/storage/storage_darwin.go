@@ -11,3 +11,7 @@ func BaseAppDataPath() string {
 func BaseCachePath() string {
 	return filepath.Join(homeDir, "Library", "Caches")
 }
+
+func BaseSystemConfigPath() string {
+	return filepath.Join("/Library", "Application Support")
+}
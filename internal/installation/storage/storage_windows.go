@@ -20,3 +20,11 @@ func BaseCachePath() string {
 
 	return filepath.Join(homeDir, "AppData", "Local")
 }
+
+func BaseSystemConfigPath() string {
+	if programData := os.Getenv("ProgramData"); programData != "" {
+		return programData
+	}
+
+	return filepath.Join(os.Getenv("SystemDrive")+`\`, "ProgramData")
+}
@@ -23,3 +23,7 @@ func BaseCachePath() string {
 
 	return filepath.Join(homeDir, ".cache")
 }
+
+func BaseSystemConfigPath() string {
+	return "/etc"
+}
@@ -54,6 +54,9 @@ type Option struct {
 	SetEvent     Event
 	isRegistered bool
 	isHidden     bool
+	// isProjectSafe marks options that a project may set in the config section of its activestate.yaml.
+	// Projects are not trusted, so this is limited to options that cannot be used against the user.
+	isProjectSafe bool
 }
 
 type Registry map[string]Option
@@ -74,7 +77,7 @@ func NewEnum(options []string, default_ string) *Enums {
 func GetOption(key string) Option {
 	rule, ok := registry[key]
 	if !ok {
-		return Option{key, String, "", nil, EmptyEvent, EmptyEvent, false, false}
+		return Option{key, String, "", nil, EmptyEvent, EmptyEvent, false, false, false}
 	}
 	return rule
 }
@@ -98,7 +101,22 @@ func RegisterOptionWithEvents(key string, t Type, defaultValue interface{}, get,
 }
 
 func registerOption(key string, t Type, defaultValue interface{}, envAliases []string, get, set Event, hidden bool) {
-	registry[key] = Option{key, t, defaultValue, envAliases, get, set, true, hidden}
+	registry[key] = Option{key, t, defaultValue, envAliases, get, set, true, hidden, false}
+}
+
+// AllowInProject allows a registered option to be set by the config section of a project's
+// activestate.yaml. Only allow options that cannot be used against the user by an untrusted project,
+// e.g. nothing that changes where the State Tool connects to, what it runs, or what it asks about.
+func AllowInProject(key string) {
+	if opt, ok := registry[key]; ok {
+		opt.isProjectSafe = true
+		registry[key] = opt
+	}
+}
+
+// AllowedInProject returns whether the option may be set by a project.
+func AllowedInProject(opt Option) bool {
+	return opt.isRegistered && opt.isProjectSafe
 }
 
 // EnvVarNames returns every environment variable that can override this option: its canonical
@@ -123,17 +141,18 @@ func EnvOverride(opt Option) (interface{}, string, bool) {
 		if !ok || raw == "" {
 			continue
 		}
-		if value, valid := coerceToType(opt, raw); valid {
+		if value, valid := CoerceValue(opt, raw); valid {
 			return value, name, true
 		}
 	}
 	return nil, "", false
 }
 
-// coerceToType converts a raw environment-variable string to the option's configured type so that
-// callers receive the same Go type they would get from a stored value. The bool result reports
-// whether the raw value is valid for the option's type; invalid values must not be applied.
-func coerceToType(opt Option, raw string) (interface{}, bool) {
+// CoerceValue converts a raw string (e.g. an environment variable or command line override) to the
+// option's configured type so that callers receive the same Go type they would get from a stored
+// value. The bool result reports whether the raw value is valid for the option's type; invalid values
+// must not be applied.
+func CoerceValue(opt Option, raw string) (interface{}, bool) {
 	switch opt.Type {
 	case Bool:
 		v, err := cast.ToBoolE(raw)
@@ -39,6 +39,16 @@ func NewCmdEscaper() *ShellEscape {
 	}
 }
 
+// NewBackslashEscaper creates a new instance of ShellEscape that's configured for escaping arguments of shells whose
+// double quoted strings only give special meaning to backslashes and double quotes, like nushell and elvish
+func NewBackslashEscaper() *ShellEscape {
+	return &ShellEscape{
+		regexp.MustCompile(`^[\w]+$`),
+		regexp.MustCompile(`(\\|")`),
+		`\$1`,
+	}
+}
+
 // EscapeLineEnd will escape any line end characters that require escaping for the purpose of quoting
 func (s *ShellEscape) EscapeLineEnd(value string) string {
 	value = strings.Replace(value, "\n", `\n`, -1)
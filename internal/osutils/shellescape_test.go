@@ -32,6 +32,16 @@ func (suite *ShellEscaperTestSuite) TestBatchEscaper() {
 	suite.Equal(`"quote""quote"`, escaper.Quote(`quote"quote`))
 }
 
+func (suite *ShellEscaperTestSuite) TestBackslashEscaper() {
+	escaper := osutils.NewBackslashEscaper()
+	suite.Equal(`quoted`, escaper.Quote(`quoted`))
+	suite.Equal(`"\"quoted\""`, escaper.Quote(`"quoted"`))
+	suite.Equal(`"'quoted'"`, escaper.Quote(`'quoted'`))
+	suite.Equal(`"quoted\nquote"`, escaper.Quote("quoted\nquote"))
+	suite.Equal(`"quote\\"`, escaper.Quote(`quote\`))
+	suite.Equal(`"$FOO"`, escaper.Quote(`$FOO`))
+}
+
 func (suite *ShellEscaperTestSuite) TestCmdEscaper() {
 	escaper := osutils.NewCmdEscaper()
 	suite.Equal(`quoted`, escaper.Quote(`quoted`))
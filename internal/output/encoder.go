@@ -0,0 +1,309 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/ActiveState/cli/internal/colorize"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/multilog"
+	"gopkg.in/yaml.v3"
+)
+
+// Encoder is the outputer for the structured formats other than JSON. Values are marshalled to JSON first, so that
+// every structured format has the same fields, in the same order, as the JSON output.
+type Encoder struct {
+	cfg         *Config
+	format      Format
+	marshal     func(value *yaml.Node) ([]byte, error)
+	wroteOutput bool
+	history     *OutputHistory
+}
+
+// NewYAML constructs an outputer that prints values as YAML
+func NewYAML(config *Config) (Encoder, error) {
+	return Encoder{cfg: config, format: YAMLFormatName, marshal: marshalYAML, history: &OutputHistory{}}, nil
+}
+
+// NewCSV constructs an outputer that prints lists as CSV, with a row per item. Nested values are written as JSON. Values
+// that are not lists are refused, as a single row would hide their lists in JSON cells.
+func NewCSV(config *Config) (Encoder, error) {
+	return Encoder{cfg: config, format: CSVFormatName, marshal: marshalCSV, history: &OutputHistory{}}, nil
+}
+
+// NewTemplate constructs an outputer that prints values by executing the given Go template over them. Fields are
+// accessed by their JSON names, e.g. '{{range .}}{{.name}}{{"\n"}}{{end}}'.
+func NewTemplate(config *Config, text string) (Encoder, error) {
+	tpl, err := template.New("output").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+		"yaml": func(v interface{}) (string, error) {
+			b, err := yaml.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return Encoder{}, locale.WrapInputError(err, "err_output_template", "Invalid output template: {{.V0}}", err.Error())
+	}
+
+	marshal := func(value *yaml.Node) ([]byte, error) {
+		var v interface{}
+		if err := value.Decode(&v); err != nil {
+			return nil, errs.Wrap(err, "Could not decode value")
+		}
+		b := &bytes.Buffer{}
+		if err := tpl.Execute(b, v); err != nil {
+			return nil, errs.Wrap(err, "Could not execute template")
+		}
+		if b.Len() > 0 && !bytes.HasSuffix(b.Bytes(), []byte("\n")) {
+			b.WriteString("\n")
+		}
+		return b.Bytes(), nil
+	}
+	return Encoder{cfg: config, format: TemplateFormatName, marshal: marshal, history: &OutputHistory{}}, nil
+}
+
+// Type tells callers what type of outputer we are
+func (f *Encoder) Type() Format {
+	return f.format
+}
+
+// Print will marshal and print the given value to the output writer
+func (f *Encoder) Print(v interface{}) {
+	if err, isStructuredError := v.(StructuredError); isStructuredError {
+		multilog.Error("Attempted to write unstructured output as %s: %v", f.format, err)
+		return
+	}
+
+	w := NewWriteProxy(f.cfg.OutWriter, func(p []byte) {
+		f.history.Print = append(f.history.Print, string(p))
+	})
+
+	f.Fprint(w, v)
+}
+
+// Fprint allows printing to a specific writer, using all the conveniences of the output package
+func (f *Encoder) Fprint(writer io.Writer, value interface{}) {
+	if f.wroteOutput {
+		multilog.Error("Already wrote %s output; skipping.", f.format)
+		return
+	}
+	f.wroteOutput = true
+
+	b, err := f.marshalValue(value)
+	if err != nil && locale.IsInputError(err) {
+		f.writeError(locale.JoinedErrorMessage(err))
+		return
+	}
+	if err != nil {
+		multilog.Error("Could not marshal value, error: %v", err)
+		f.writeError(locale.T("err_could_not_marshal_print"))
+		return
+	}
+	f.write(writer, b)
+}
+
+// Error will marshal and print the given value to the output writer, like JSON does. Templates are written for the
+// values of commands, not for errors, so with a template the error message is written to the error writer instead.
+func (f *Encoder) Error(value interface{}) {
+	if f.wroteOutput {
+		multilog.Error("Already wrote %s output; skipping.", f.format)
+		return
+	}
+	f.wroteOutput = true
+
+	var message string
+	var blob []byte
+	var err error
+	if v, isBlob := value.([]byte); isBlob {
+		message, blob = string(v), v
+	} else {
+		structuredErr := toStructuredError(value)
+		message = structuredErr.Message
+		blob, err = json.Marshal(structuredErr) // StructuredError is an error, so it must skip prepareJSONValue
+	}
+	if f.format == TemplateFormatName {
+		f.writeError(message)
+		return
+	}
+
+	if err == nil && f.format == CSVFormatName {
+		blob = append(append([]byte("["), blob...), ']') // CSV only takes lists, of which the error is the single row
+	}
+
+	var b []byte
+	if err == nil {
+		b, err = f.marshalValue(blob)
+	}
+	if err != nil {
+		multilog.Error("Could not marshal error, error: %v", err)
+		f.writeError(message)
+		return
+	}
+
+	w := NewWriteProxy(f.cfg.OutWriter, func(p []byte) {
+		f.history.Error = append(f.history.Error, string(p))
+	})
+	f.write(w, b)
+}
+
+// Notice is ignored by structured formats, like it is by JSON
+func (f *Encoder) Notice(value interface{}) {
+	logging.Warning("%s outputer truncated the following notice: %v", f.format, value)
+}
+
+// Config returns the Config struct for the active instance
+func (f *Encoder) Config() *Config {
+	return f.cfg
+}
+
+func (f *Encoder) History() *OutputHistory {
+	return f.history
+}
+
+func (f *Encoder) marshalValue(value interface{}) ([]byte, error) {
+	var b []byte
+	if v, isBlob := value.([]byte); isBlob {
+		b = v
+	} else {
+		var err error
+		b, err = json.Marshal(prepareJSONValue(value))
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not marshal value as JSON")
+		}
+	}
+	b = []byte(colorize.StripColorCodes(string(b)))
+
+	// JSON is YAML, and parsing it as a node keeps the order of fields
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(b, doc); err != nil {
+		return nil, errs.Wrap(err, "Could not parse JSON")
+	}
+	if len(doc.Content) == 0 {
+		return nil, errs.New("Empty value")
+	}
+	return f.marshal(doc.Content[0])
+}
+
+func (f *Encoder) write(writer io.Writer, b []byte) {
+	if _, err := writer.Write(b); err != nil {
+		if isPipeClosedError(err) {
+			logging.Error("Could not write %s output, error: %v", f.format, err) // do not log to rollbar
+		} else {
+			multilog.Error("Could not write %s output, error: %v", f.format, err)
+		}
+	}
+}
+
+func (f *Encoder) writeError(message string) {
+	w := NewWriteProxy(f.cfg.ErrWriter, func(p []byte) {
+		f.history.Error = append(f.history.Error, string(p))
+	})
+	f.write(w, []byte(colorize.StripColorCodes(message)+"\n"))
+}
+
+func marshalYAML(value *yaml.Node) ([]byte, error) {
+	blockStyle(value)
+	b, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not marshal YAML")
+	}
+	return b, nil
+}
+
+// blockStyle clears the JSON styles of the parsed node, so that it is written as regular YAML.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
+
+func marshalCSV(value *yaml.Node) ([]byte, error) {
+	if value.Kind != yaml.SequenceNode {
+		return nil, locale.NewInputError("err_output_csv_not_list", "This command does not output a list, so it cannot be written as CSV. Use '[ACTIONABLE]--output=json[/RESET]', '[ACTIONABLE]--output=yaml[/RESET]' or a template instead.")
+	}
+	rows := value.Content
+
+	columns := []string{}
+	seen := map[string]bool{}
+	for _, row := range rows {
+		if row.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i < len(row.Content); i += 2 {
+			if key := row.Content[i].Value; !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+		}
+	}
+
+	b := &bytes.Buffer{}
+	w := csv.NewWriter(b)
+	if len(columns) > 0 {
+		if err := w.Write(columns); err != nil {
+			return nil, errs.Wrap(err, "Could not write CSV header")
+		}
+	}
+	for _, row := range rows {
+		record := []string{}
+		if row.Kind == yaml.MappingNode {
+			fields := map[string]*yaml.Node{}
+			for i := 0; i < len(row.Content); i += 2 {
+				fields[row.Content[i].Value] = row.Content[i+1]
+			}
+			for _, column := range columns {
+				cell, err := csvCell(fields[column])
+				if err != nil {
+					return nil, errs.Wrap(err, "Could not write CSV column %s", column)
+				}
+				record = append(record, cell)
+			}
+		} else {
+			cell, err := csvCell(row)
+			if err != nil {
+				return nil, errs.Wrap(err, "Could not write CSV row")
+			}
+			record = append(record, cell)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, errs.Wrap(err, "Could not write CSV row")
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, errs.Wrap(err, "Could not write CSV")
+	}
+	return b.Bytes(), nil
+}
+
+// csvCell returns the given value as a CSV cell, which is its JSON for lists and objects.
+func csvCell(n *yaml.Node) (string, error) {
+	switch {
+	case n == nil:
+		return "", nil
+	case n.Kind == yaml.ScalarNode && n.Tag == "!!null":
+		return "", nil
+	case n.Kind == yaml.ScalarNode:
+		return n.Value, nil
+	}
+
+	var v interface{}
+	if err := n.Decode(&v); err != nil {
+		return "", errs.Wrap(err, "Could not decode value")
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not marshal value")
+	}
+	return strings.TrimSpace(string(b)), nil
+}
@@ -0,0 +1,151 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type encoderTestPackage struct {
+	Name     string   `json:"name"`
+	Version  string   `json:"version,omitempty"`
+	Licenses []string `json:"licenses"`
+}
+
+var encoderTestPackages = []encoderTestPackage{
+	{"requests", "2.31.0", []string{"Apache-2.0"}},
+	{"urllib3", "", []string{"MIT", "BSD"}},
+}
+
+func newTestEncoder(t *testing.T, format string) (*Encoder, *bytes.Buffer, *bytes.Buffer) {
+	outWriter, errWriter := &bytes.Buffer{}, &bytes.Buffer{}
+	config := &Config{OutWriter: outWriter, ErrWriter: errWriter}
+	var f Encoder
+	var err error
+	switch format {
+	case "yaml":
+		f, err = NewYAML(config)
+	case "csv":
+		f, err = NewCSV(config)
+	default:
+		f, err = NewTemplate(config, format)
+	}
+	require.NoError(t, err)
+	return &f, outWriter, errWriter
+}
+
+func TestEncoder_Print(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		value       interface{}
+		expectedOut string
+	}{
+		{
+			"yaml list",
+			"yaml",
+			encoderTestPackages,
+			"- name: requests\n  version: 2.31.0\n  licenses:\n    - Apache-2.0\n- name: urllib3\n  licenses:\n    - MIT\n    - BSD\n",
+		},
+		{
+			"yaml keeps strings that look like other types",
+			"yaml",
+			map[string]interface{}{"value": "true", "number": 1},
+			"number: 1\nvalue: \"true\"\n",
+		},
+		{
+			"csv list",
+			"csv",
+			encoderTestPackages,
+			"name,version,licenses\nrequests,2.31.0,\"[\"\"Apache-2.0\"\"]\"\nurllib3,,\"[\"\"MIT\"\",\"\"BSD\"\"]\"\n",
+		},
+		{
+			"csv scalars",
+			"csv",
+			[]string{"a", "b"},
+			"a\nb\n",
+		},
+		{
+			"template",
+			`{{range .}}{{.name}} {{json .licenses}}{{"\n"}}{{end}}`,
+			encoderTestPackages,
+			"requests [\"Apache-2.0\"]\nurllib3 [\"MIT\",\"BSD\"]\n",
+		},
+		{
+			"template adds trailing newline",
+			`{{len .}}`,
+			encoderTestPackages,
+			"2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, outWriter, errWriter := newTestEncoder(t, tt.format)
+			f.Print(tt.value)
+			assert.Equal(t, tt.expectedOut, outWriter.String(), "Output did not match")
+			assert.Empty(t, errWriter.String(), "Errors did not match")
+		})
+	}
+}
+
+func TestEncoder_PrintCSVObject(t *testing.T) {
+	f, outWriter, errWriter := newTestEncoder(t, "csv")
+	f.Print(map[string]interface{}{"project": "org/project", "packages": encoderTestPackages})
+	assert.Empty(t, outWriter.String())
+	assert.Contains(t, errWriter.String(), "does not output a list", "objects are refused rather than written as a single row")
+}
+
+func TestEncoder_Error(t *testing.T) {
+	f, outWriter, errWriter := newTestEncoder(t, "yaml")
+	f.Error(locale.NewError("", "hello"))
+	assert.Equal(t, "error: hello\n", outWriter.String())
+	assert.Empty(t, errWriter.String())
+
+	f, outWriter, errWriter = newTestEncoder(t, "csv")
+	f.Error(StructuredError{Message: "hello"})
+	assert.Equal(t, "error\nhello\n", outWriter.String())
+	assert.Empty(t, errWriter.String())
+
+	f, outWriter, errWriter = newTestEncoder(t, "{{.name}}")
+	f.Error("hello")
+	assert.Empty(t, outWriter.String())
+	assert.Equal(t, "hello\n", errWriter.String(), "templates are not applied to errors")
+}
+
+func TestEncoder_Notice(t *testing.T) {
+	f, outWriter, errWriter := newTestEncoder(t, "yaml")
+	f.Notice("hello")
+	assert.Empty(t, outWriter.String())
+	assert.Empty(t, errWriter.String())
+}
+
+func TestNew_Formats(t *testing.T) {
+	for _, format := range []string{"yaml", "csv", "template={{.name}}"} {
+		out, err := New(format, &Config{OutWriter: &bytes.Buffer{}, ErrWriter: &bytes.Buffer{}, Interactive: true})
+		require.NoError(t, err, format)
+		assert.True(t, out.Type().IsStructured(), format)
+		assert.False(t, out.Config().Interactive, format)
+	}
+	assert.Equal(t, TemplateFormatName, mustNew(t, "template={{.name}}").Type())
+
+	_, err := New("template={{.name", &Config{})
+	assert.Error(t, err, "invalid templates are an error")
+
+	for _, format := range []string{"template", "template="} {
+		_, err = New(format, &Config{})
+		assert.True(t, locale.IsInputError(err), "%s requires a template", format)
+	}
+	for _, format := range []string{"json=anything", "csv=", "plain=x"} {
+		_, err = New(format, &Config{})
+		assert.True(t, locale.IsInputError(err), "%s does not take an argument", format)
+	}
+}
+
+func mustNew(t *testing.T, format string) Outputer {
+	out, err := New(format, &Config{OutWriter: &bytes.Buffer{}, ErrWriter: &bytes.Buffer{}})
+	require.NoError(t, err)
+	return out
+}
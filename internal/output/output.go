@@ -3,6 +3,7 @@ package output
 import (
 	"io"
 	"os"
+	"strings"
 
 	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/locale"
@@ -13,10 +14,13 @@ type Format string
 
 // FormatName constants are tokens representing supported output formats.
 const (
-	PlainFormatName  Format = "plain"  // human readable
-	SimpleFormatName Format = "simple" // human readable without notice level
-	JSONFormatName   Format = "json"   // plain json
-	EditorFormatName Format = "editor" // alias of "json"
+	PlainFormatName    Format = "plain"    // human readable
+	SimpleFormatName   Format = "simple"   // human readable without notice level
+	JSONFormatName     Format = "json"     // plain json
+	EditorFormatName   Format = "editor"   // alias of "json"
+	YAMLFormatName     Format = "yaml"     // the json structure as yaml
+	CSVFormatName      Format = "csv"      // the json structure as csv, mainly for lists
+	TemplateFormatName Format = "template" // the json structure rendered with a Go template, e.g. "template={{.name}}"
 )
 
 // Behavior defines control tokens that affect printing behavior.
@@ -59,7 +63,18 @@ func New(formatName string, config *Config) (Outputer, error) {
 func new(formatName string, config *Config) (Outputer, error) {
 	logging.Debug("Requested outputer for %s", formatName)
 
-	format := Format(formatName)
+	// Formats can take an argument, e.g. "template={{.name}}", which only the template format does (and requires)
+	name, arg, hasArg := strings.Cut(formatName, "=")
+	format := Format(name)
+	if format == TemplateFormatName && arg == "" {
+		return nil, locale.NewInputError("err_output_template_missing", "The template output format requires a template, e.g. '[ACTIONABLE]{{.V0}}[/RESET]'.", "--output=template={{.name}}")
+	}
+	if hasArg {
+		switch format {
+		case "", PlainFormatName, SimpleFormatName, JSONFormatName, EditorFormatName, YAMLFormatName, CSVFormatName:
+			return nil, locale.NewInputError("err_output_argument", "The '[ACTIONABLE]{{.V0}}[/RESET]' output format does not take an argument.", name)
+		}
+	}
 	switch format {
 	case "", PlainFormatName:
 		logging.Debug("Using Plain outputer")
@@ -79,13 +94,35 @@ func new(formatName string, config *Config) (Outputer, error) {
 		config.Interactive = false
 		editor, err := NewEditor(config)
 		return &Mediator{&editor, EditorFormatName}, err
+	case YAMLFormatName:
+		logging.Debug("Using YAML outputer")
+		config.Interactive = false
+		yaml, err := NewYAML(config)
+		return &Mediator{&yaml, YAMLFormatName}, err
+	case CSVFormatName:
+		logging.Debug("Using CSV outputer")
+		config.Interactive = false
+		csv, err := NewCSV(config)
+		return &Mediator{&csv, CSVFormatName}, err
+	case TemplateFormatName:
+		logging.Debug("Using Template outputer")
+		config.Interactive = false
+		tpl, err := NewTemplate(config, arg)
+		if err != nil {
+			return nil, err
+		}
+		return &Mediator{&tpl, TemplateFormatName}, nil
 	}
 
 	return nil, locale.WrapInputError(ErrNotRecognized, "err_unknown_format", string(formatName))
 }
 
 func (format Format) IsStructured() bool {
-	return format == JSONFormatName || format == EditorFormatName
+	switch format {
+	case JSONFormatName, EditorFormatName, YAMLFormatName, CSVFormatName, TemplateFormatName:
+		return true
+	}
+	return false
 }
 
 // Get is here for legacy use-cases, DO NOT USE IT FOR NEW CODE
@@ -0,0 +1,70 @@
+package prompt
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/locale"
+	"gopkg.in/yaml.v3"
+)
+
+// Answers holds the responses to prompts by prompt ID, so that prompts can be answered without a user present.
+// Confirmations are answered with a boolean, all other prompts with a string.
+type Answers map[string]interface{}
+
+// LoadAnswers reads the answers file at the given path. Every key must be the ID of a prompt.
+func LoadAnswers(path string) (Answers, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, locale.WrapExternalError(err, "err_answers_read", "Could not read answers file '[ACTIONABLE]{{.V0}}[/RESET]'.", path)
+	}
+
+	answers := Answers{}
+	if err := yaml.Unmarshal(b, &answers); err != nil {
+		return nil, locale.WrapInputError(err, "err_answers_parse", "Could not parse answers file '[ACTIONABLE]{{.V0}}[/RESET]': {{.V1}}", path, err.Error())
+	}
+
+	unknown := []string{}
+	for id := range answers {
+		if _, ok := Lookup(id); !ok {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, locale.NewInputError("err_answers_unknown", "The answers file '[ACTIONABLE]{{.V0}}[/RESET]' answers unknown prompts: {{.V1}}. Run '[ACTIONABLE]state --dump-prompts[/RESET]' to list the prompts that can be answered.", path, strings.Join(unknown, ", "))
+	}
+
+	return answers, nil
+}
+
+func (a Answers) string(id string) (string, error) {
+	switch v := a[id].(type) {
+	case string:
+		return v, nil
+	case int, float64:
+		return fmt.Sprint(v), nil
+	}
+	return "", locale.NewInputError("err_answer_string", "The answer to prompt '[ACTIONABLE]{{.V0}}[/RESET]' must be a string.", id)
+}
+
+func (a Answers) bool(id string) (bool, error) {
+	switch v := a[id].(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "yes", "y":
+			return true, nil
+		case "no", "n":
+			return false, nil
+		}
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b, nil
+		}
+	}
+	return false, locale.NewInputError("err_answer_bool", "The answer to prompt '[ACTIONABLE]{{.V0}}[/RESET]' must be true or false.", id)
+}
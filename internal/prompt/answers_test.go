@@ -0,0 +1,123 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/analytics/dimensions"
+	"github.com/ActiveState/cli/internal/rtutils/ptr"
+	"github.com/ActiveState/cli/internal/testhelpers/outputhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type noEvents struct{}
+
+func (noEvents) EventWithLabel(category, action string, label string, dim ...*dimensions.Values) {}
+
+func writeAnswers(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "answers.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+func newNonInteractive(answers Answers) *Prompt {
+	p := New(outputhelper.NewCatcher(), noEvents{}).(*Prompt)
+	p.SetInteractive(false)
+	p.SetAnswers(answers)
+	return p
+}
+
+func TestLoadAnswers(t *testing.T) {
+	answers, err := LoadAnswers(writeAnswers(t, "cves.continue: true\nproject.select_path: /tmp/project\n"))
+	require.NoError(t, err)
+	assert.Equal(t, Answers{IDCVEsContinue: true, IDProjectSelectPath: "/tmp/project"}, answers)
+
+	_, err = LoadAnswers(writeAnswers(t, "cves.continue: true\ncves.contnue: false\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cves.contnue")
+
+	_, err = LoadAnswers(writeAnswers(t, "- not a map"))
+	assert.Error(t, err)
+
+	_, err = LoadAnswers(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestAnswersBool(t *testing.T) {
+	for v, expected := range map[interface{}]bool{true: true, false: false, "yes": true, "No": false, "true": true} {
+		b, err := Answers{IDCVEsContinue: v}.bool(IDCVEsContinue)
+		require.NoError(t, err, "value: %v", v)
+		assert.Equal(t, expected, b, "value: %v", v)
+	}
+	_, err := Answers{IDCVEsContinue: "maybe"}.bool(IDCVEsContinue)
+	assert.Error(t, err)
+}
+
+func TestAnswersString(t *testing.T) {
+	s, err := Answers{IDPushName: 42}.string(IDPushName)
+	require.NoError(t, err)
+	assert.Equal(t, "42", s)
+	_, err = Answers{IDPushName: []interface{}{"a"}}.string(IDPushName)
+	assert.Error(t, err)
+}
+
+func TestConfirmAnswered(t *testing.T) {
+	p := newNonInteractive(Answers{IDCVEsContinue: false})
+	confirm, err := p.Confirm(IDCVEsContinue, "", "Continue?", ptr.To(true), nil)
+	require.NoError(t, err)
+	assert.False(t, confirm, "answer overrides the default")
+}
+
+func TestSelectAnswered(t *testing.T) {
+	p := newNonInteractive(Answers{IDProjectSelectPath: "/b"})
+	choice, err := p.Select(IDProjectSelectPath, "", "Which?", []string{"/a", "/b"}, ptr.To("/a"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/b", choice)
+
+	p = newNonInteractive(Answers{IDProjectSelectPath: "/c"})
+	_, err = p.Select(IDProjectSelectPath, "", "Which?", []string{"/a", "/b"}, ptr.To("/a"), nil)
+	assert.Error(t, err, "answer must be one of the choices")
+}
+
+func TestInputAnswered(t *testing.T) {
+	p := newNonInteractive(Answers{IDPushName: "project"})
+	name, err := p.Input(IDPushName, "", "Name?", ptr.To("default"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "project", name)
+
+	p = newNonInteractive(Answers{IDPushName: ""})
+	_, err = p.Input(IDPushName, "", "Name?", ptr.To("default"), nil, InputRequired)
+	assert.Error(t, err, "answer must pass validation")
+}
+
+func TestUnanswered(t *testing.T) {
+	p := newNonInteractive(Answers{IDPushName: "project"})
+	_, err := p.Confirm(IDCVEsContinue, "", "Continue?", ptr.To(true), nil)
+	require.Error(t, err, "prompts the answers file does not answer fail in non-interactive mode")
+	assert.Contains(t, err.Error(), IDCVEsContinue)
+
+	p = newNonInteractive(nil)
+	confirm, err := p.Confirm(IDCVEsContinue, "", "Continue?", ptr.To(true), nil)
+	require.NoError(t, err, "without an answers file the default is used")
+	assert.True(t, confirm)
+
+	p = newNonInteractive(Answers{IDPushName: "project"})
+	p.SetForce(true)
+	confirm, err = p.Confirm(IDCVEsContinue, "", "Continue?", ptr.To(false), ptr.To(true))
+	require.NoError(t, err, "forced prompts do not need an answer")
+	assert.True(t, confirm)
+}
+
+func TestDefinitions(t *testing.T) {
+	definitions := Definitions()
+	require.NotEmpty(t, definitions)
+	for i, d := range definitions {
+		assert.NotEmpty(t, d.Kind, d.ID)
+		assert.NotEmpty(t, d.Description, d.ID)
+		if i > 0 {
+			assert.Less(t, definitions[i-1].ID, d.ID)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package prompt
+
+import "sort"
+
+// Kinds of responses prompts ask for.
+const (
+	KindInput   = "input"
+	KindSecret  = "secret"
+	KindSelect  = "select"
+	KindConfirm = "confirm"
+)
+
+// Prompt IDs identify prompts across releases, so that they can be answered by an answers file. IDs must never be
+// changed or reused once released.
+const (
+	IDInstallerAdmin          = "installer.admin"
+	IDRemoteInstallerConfirm  = "remote_installer.confirm"
+	IDAuthLoginOrSignup       = "auth.login_or_signup"
+	IDAuthUsername            = "auth.username"
+	IDAuthPassword            = "auth.password"
+	IDAuthTOTP                = "auth.totp"
+	IDAuthBrowserContinue     = "auth.browser_continue"
+	IDAuthPreviousPassword    = "auth.previous_password"
+	IDAuthGenerateKeypair     = "auth.generate_keypair"
+	IDLicensesContinue        = "licenses.continue"
+	IDCVEsContinue            = "cves.continue"
+	IDProjectSelectNamespace  = "project.select_namespace"
+	IDProjectSelectPath       = "project.select_path"
+	IDForkOwner               = "fork.owner"
+	IDProjectsEdit            = "projects.edit"
+	IDProjectsDelete          = "projects.delete"
+	IDProjectsMove            = "projects.move"
+	IDPublishConfirm          = "publish.confirm"
+	IDPublishEditDone         = "publish.edit_done"
+	IDUpdateLock              = "update.lock"
+	IDUpdateUnlock            = "update.unlock"
+	IDInstallSelectIngredient = "install.select_ingredient"
+	IDCleanCache              = "clean.cache"
+	IDCleanCacheArtifact      = "clean.cache_artifact"
+	IDCleanConfig             = "clean.config"
+	IDCleanUninstallSelect    = "clean.uninstall_select"
+	IDCleanUninstall          = "clean.uninstall"
+	IDScriptsEditDone         = "scripts.edit_done"
+	IDCVEFix                  = "cve.fix"
+	IDRevertConfirm           = "revert.confirm"
+	IDPushCreateCopy          = "push.create_copy"
+	IDPushCreateProject       = "push.create_project"
+	IDPushOwner               = "push.owner"
+	IDPushName                = "push.name"
+	IDUpgradeConfirm          = "upgrade.confirm"
+	IDResetConfirm            = "reset.confirm"
+	IDInviteRole              = "invite.role"
+	IDUseReset                = "use.reset"
+	IDSecretValue             = "secrets.value"
+	IDSecretExecTrust         = "secrets.exec_trust"
+)
+
+// Definition describes a prompt, so that users know what to answer it with.
+type Definition struct {
+	ID          string `json:"id" locale:"id,[HEADING]ID[/RESET]"`
+	Kind        string `json:"kind" locale:"kind,[HEADING]Kind[/RESET]"`
+	Description string `json:"description" locale:"description,[HEADING]Description[/RESET]"`
+}
+
+var definitions = map[string]Definition{}
+
+func define(id, kind, description string) {
+	definitions[id] = Definition{id, kind, description}
+}
+
+func init() {
+	define(IDInstallerAdmin, KindConfirm, "Whether to install as administrator")
+	define(IDRemoteInstallerConfirm, KindConfirm, "Whether to continue installing the State Tool")
+	define(IDAuthLoginOrSignup, KindSelect, "Whether to log in or sign up")
+	define(IDAuthUsername, KindInput, "The username to log in with")
+	define(IDAuthPassword, KindSecret, "The password to log in with")
+	define(IDAuthTOTP, KindInput, "The code of the two-factor authentication to log in with")
+	define(IDAuthBrowserContinue, KindConfirm, "Whether authentication in the browser has completed")
+	define(IDAuthPreviousPassword, KindSecret, "The previous password, to restore the keypair of the account with")
+	define(IDAuthGenerateKeypair, KindConfirm, "Whether to generate a new keypair when the previous password is unknown")
+	define(IDLicensesContinue, KindConfirm, "Whether to continue despite licenses that the license policy asks about")
+	define(IDCVEsContinue, KindConfirm, "Whether to continue despite vulnerabilities in the packages")
+	define(IDProjectSelectNamespace, KindSelect, "The project to use, if more than one matches")
+	define(IDProjectSelectPath, KindSelect, "The checkout of the project to use, if there is more than one")
+	define(IDForkOwner, KindSelect, "The owner of the forked project")
+	define(IDProjectsEdit, KindConfirm, "Whether to edit the project")
+	define(IDProjectsDelete, KindConfirm, "Whether to delete the project")
+	define(IDProjectsMove, KindConfirm, "Whether to move the project")
+	define(IDPublishConfirm, KindConfirm, "Whether to publish the ingredient")
+	define(IDPublishEditDone, KindInput, "Confirms that editing the ingredient metadata is done")
+	define(IDUpdateLock, KindConfirm, "Whether to lock the State Tool version")
+	define(IDUpdateUnlock, KindConfirm, "Whether to unlock the State Tool version")
+	define(IDInstallSelectIngredient, KindSelect, "The package to install, if more than one matches")
+	define(IDCleanCache, KindConfirm, "Whether to clean the cache")
+	define(IDCleanCacheArtifact, KindConfirm, "Whether to clean the cached runtime of the project")
+	define(IDCleanConfig, KindConfirm, "Whether to clean the config")
+	define(IDCleanUninstallSelect, KindSelect, "What to uninstall")
+	define(IDCleanUninstall, KindConfirm, "Whether to uninstall the State Tool")
+	define(IDScriptsEditDone, KindConfirm, "Confirms that editing the script is done")
+	define(IDCVEFix, KindConfirm, "Whether to apply the fixes for vulnerabilities")
+	define(IDRevertConfirm, KindConfirm, "Whether to revert the commit")
+	define(IDPushCreateCopy, KindConfirm, "Whether to push to a copy of a project you are not authorized to push to")
+	define(IDPushCreateProject, KindConfirm, "Whether to create the project being pushed to")
+	define(IDPushOwner, KindInput, "The owner of the project being pushed to")
+	define(IDPushName, KindInput, "The name of the project being pushed to")
+	define(IDUpgradeConfirm, KindConfirm, "Whether to upgrade the packages")
+	define(IDResetConfirm, KindConfirm, "Whether to reset the project, losing any changes that were not pushed")
+	define(IDInviteRole, KindSelect, "The role to invite users with")
+	define(IDUseReset, KindConfirm, "Whether to stop using the default project")
+	define(IDSecretValue, KindSecret, "The value of a secret that is not set")
+	define(IDSecretExecTrust, KindConfirm, "Whether to trust the command a secret of the project is obtained from")
+}
+
+// Lookup returns the definition of the prompt with the given ID.
+func Lookup(id string) (Definition, bool) {
+	d, ok := definitions[id]
+	return d, ok
+}
+
+// Definitions returns the definitions of all prompts, sorted by ID.
+func Definitions() []Definition {
+	result := make([]Definition, 0, len(definitions))
+	for _, d := range definitions {
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
@@ -2,6 +2,7 @@ package prompt
 
 import (
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/ActiveState/cli/internal/analytics/dimensions"
@@ -20,15 +21,16 @@ type EventDispatcher interface {
 
 // Prompter is the interface used to run our prompt from
 type Prompter interface {
-	Input(title, message string, defaultResponse *string, forcedResponse *string, flags ...ValidatorFlag) (string, error)
-	InputAndValidate(title, message string, defaultResponse *string, forcedResponse *string, validator ValidatorFunc, flags ...ValidatorFlag) (string, error)
-	Select(title, message string, choices []string, defaultResponse *string, forcedResponse *string) (string, error)
-	Confirm(title, message string, defaultChoice *bool, forcedChoice *bool) (bool, error)
-	InputSecret(title, message string, flags ...ValidatorFlag) (string, error)
+	Input(id, title, message string, defaultResponse *string, forcedResponse *string, flags ...ValidatorFlag) (string, error)
+	InputAndValidate(id, title, message string, defaultResponse *string, forcedResponse *string, validator ValidatorFunc, flags ...ValidatorFlag) (string, error)
+	Select(id, title, message string, choices []string, defaultResponse *string, forcedResponse *string) (string, error)
+	Confirm(id, title, message string, defaultChoice *bool, forcedChoice *bool) (bool, error)
+	InputSecret(id, title, message string, flags ...ValidatorFlag) (string, error)
 	IsInteractive() bool
 	SetInteractive(bool)
 	SetForce(bool)
 	IsForced() bool
+	SetAnswers(Answers)
 }
 
 // ValidatorFunc is a function pass to the Prompter to perform validation
@@ -43,13 +45,14 @@ type Prompt struct {
 	analytics     EventDispatcher
 	isInteractive bool
 	isForced      bool
+	answers       Answers
 }
 
 var ErrNoForceOption = errs.New("No force option given for forced prompt")
 
 // New creates a new prompter
 func New(out output.Outputer, an EventDispatcher) Prompter {
-	return &Prompt{out, an, out.Config().Interactive, false}
+	return &Prompt{out, an, out.Config().Interactive, false, nil}
 }
 
 // IsInteractive checks if the prompts can be interactive or should just return default values
@@ -71,6 +74,29 @@ func (p *Prompt) IsForced() bool {
 	return p.isForced
 }
 
+// SetAnswers has prompts return the responses given by an answers file. Once set, prompts that the answers file does
+// not answer can no longer fall back on their default response in non-interactive mode.
+func (p *Prompt) SetAnswers(answers Answers) {
+	p.answers = answers
+}
+
+// answered returns whether the answers file answers the prompt with the given ID, or an error if it must but does not.
+func (p *Prompt) answered(id string) (bool, error) {
+	if _, ok := p.answers[id]; ok {
+		return true, nil
+	}
+	if p.answers != nil && !p.isInteractive && !p.isForced {
+		return false, locale.NewInputError("err_prompt_unanswered", "Prompt '[ACTIONABLE]{{.V0}}[/RESET]' is not answered by the answers file, and cannot be resolved in non-interactive mode.", id)
+	}
+	return false, nil
+}
+
+func (p *Prompt) noticeAnswer(id, response string) {
+	if !p.out.Type().IsStructured() {
+		p.out.Notice(locale.Tr("prompt_using_answer", response, id))
+	}
+}
+
 // ValidatorFlag represents flags for prompt functions to change their behavior on.
 type ValidatorFlag int
 
@@ -85,8 +111,8 @@ const (
 // Input prompts the user for input.  The user can specify available validation flags to trigger validation of responses
 // If the prompt is non-interactive, it returns defaultResponse.
 // If the prompt is forced, it returns forcedResponse if not nil, or defaultResponse.
-func (p *Prompt) Input(title, message string, defaultResponse *string, forcedResponse *string, flags ...ValidatorFlag) (string, error) {
-	return p.InputAndValidate(title, message, defaultResponse, forcedResponse, func(val interface{}) error {
+func (p *Prompt) Input(id, title, message string, defaultResponse *string, forcedResponse *string, flags ...ValidatorFlag) (string, error) {
+	return p.InputAndValidate(id, title, message, defaultResponse, forcedResponse, func(val interface{}) error {
 		return nil
 	}, flags...)
 }
@@ -94,18 +120,19 @@ func (p *Prompt) Input(title, message string, defaultResponse *string, forcedRes
 // interactiveInputError returns the proper input error for a non-interactive prompt.
 // If the terminal cannot show prompts (e.g. Git Bash on Windows), the error mentions this.
 // Otherwise, the error simply states the prompt cannot be resolved in non-interactive mode.
-// The "message" argument is the prompt's user-facing message.
-func interactiveInputError(message string) error {
+// The "message" argument is the prompt's user-facing message, and "id" the ID it can be answered by.
+func interactiveInputError(id, message string) error {
 	if runtime.GOOS == "windows" {
 		return locale.NewExternalError("err_non_interactive_mode")
 	}
-	return locale.NewExternalError("err_non_interactive_prompt", message)
+	return locale.NewExternalError("err_non_interactive_prompt", message, id)
 }
 
 // InputAndValidate prompts an input field and allows you to specfiy a custom validation function as well as the built in flags
 // If the prompt is non-interactive, it returns defaultResponse.
 // If the prompt is forced, it returns forcedResponse if not nil, or defaultResponse.
-func (p *Prompt) InputAndValidate(title, message string, defaultResponse *string, forcedResponse *string, validator ValidatorFunc, flags ...ValidatorFlag) (string, error) {
+// If the answers file answers the prompt, it returns that answer.
+func (p *Prompt) InputAndValidate(id, title, message string, defaultResponse *string, forcedResponse *string, validator ValidatorFunc, flags ...ValidatorFlag) (string, error) {
 	var response string
 	flagValidators, err := processValidators(flags)
 	if err != nil {
@@ -115,6 +142,20 @@ func (p *Prompt) InputAndValidate(title, message string, defaultResponse *string
 		validator = wrapValidators(append(flagValidators, validator))
 	}
 
+	if ok, err := p.answered(id); err != nil {
+		return "", err
+	} else if ok {
+		response, err := p.answers.string(id)
+		if err != nil {
+			return "", err
+		}
+		if err := validator(response); err != nil {
+			return "", locale.WrapInputError(err, "err_answer_invalid", "The answer to prompt '[ACTIONABLE]{{.V0}}[/RESET]' is invalid: {{.V1}}", id, err.Error())
+		}
+		p.noticeAnswer(id, response)
+		return response, nil
+	}
+
 	if title != "" {
 		p.out.Notice(output.Emphasize(title))
 	}
@@ -132,7 +173,7 @@ func (p *Prompt) InputAndValidate(title, message string, defaultResponse *string
 	} else if !p.isInteractive {
 		nonInteractiveResponse = defaultResponse
 		if nonInteractiveResponse == nil {
-			return "", interactiveInputError(message)
+			return "", interactiveInputError(id, message)
 		}
 	}
 
@@ -142,7 +183,7 @@ func (p *Prompt) InputAndValidate(title, message string, defaultResponse *string
 
 	// We handle defaults more clearly than the survey package can
 	if defaultResponse != nil && *defaultResponse != "" {
-		v, err := p.Select("", formatMessage(message, !p.out.Config().Colored), []string{*defaultResponse, locale.Tl("prompt_custom", "Other ..")}, defaultResponse, forcedResponse)
+		v, err := p.Select(id, "", formatMessage(message, !p.out.Config().Colored), []string{*defaultResponse, locale.Tl("prompt_custom", "Other ..")}, defaultResponse, forcedResponse)
 		if err != nil {
 			return "", err
 		}
@@ -172,7 +213,24 @@ func (p *Prompt) InputAndValidate(title, message string, defaultResponse *string
 // Select prompts the user to select one entry from multiple choices.
 // If the prompt is non-interactive, it returns defaultChoice.
 // If the prompt is forced, it returns forcedChoice if not nil, or defaultChoice.
-func (p *Prompt) Select(title, message string, choices []string, defaultChoice *string, forcedChoice *string) (string, error) {
+// If the answers file answers the prompt, it returns that answer, which must be one of the choices.
+func (p *Prompt) Select(id, title, message string, choices []string, defaultChoice *string, forcedChoice *string) (string, error) {
+	if ok, err := p.answered(id); err != nil {
+		return "", err
+	} else if ok {
+		response, err := p.answers.string(id)
+		if err != nil {
+			return "", err
+		}
+		for _, choice := range choices {
+			if choice == response {
+				p.noticeAnswer(id, response)
+				return response, nil
+			}
+		}
+		return "", locale.NewInputError("err_answer_choice", "The answer to prompt '[ACTIONABLE]{{.V0}}[/RESET]' must be one of: {{.V1}}", id, strings.Join(choices, ", "))
+	}
+
 	if title != "" {
 		p.out.Notice(output.Emphasize(title))
 	}
@@ -195,7 +253,7 @@ func (p *Prompt) Select(title, message string, choices []string, defaultChoice *
 	} else if !p.isInteractive {
 		nonInteractiveChoice = defaultChoice
 		if nonInteractiveChoice == nil {
-			return "", interactiveInputError(message)
+			return "", interactiveInputError(id, message)
 		}
 	}
 
@@ -227,9 +285,22 @@ func (p *Prompt) Select(title, message string, choices []string, defaultChoice *
 // Confirm prompts user for yes or no response.
 // If the prompt is non-interactive, it returns defaultChoice.
 // If the prompt is forced, it returns forcedChoice if not nil, or defaultChoice.
-func (p *Prompt) Confirm(title, message string, defaultChoice *bool, forcedChoice *bool) (bool, error) {
+// If the answers file answers the prompt, it returns that answer.
+func (p *Prompt) Confirm(id, title, message string, defaultChoice *bool, forcedChoice *bool) (bool, error) {
 	p.analytics.EventWithLabel(constants.CatPrompt, title, "present")
 
+	if ok, err := p.answered(id); err != nil {
+		return false, err
+	} else if ok {
+		resp, err := p.answers.bool(id)
+		if err != nil {
+			return false, err
+		}
+		p.analytics.EventWithLabel(constants.CatPrompt, title, translateConfirm(resp))
+		p.noticeAnswer(id, strconv.FormatBool(resp))
+		return resp, nil
+	}
+
 	if title != "" {
 		p.out.Notice(output.Emphasize(title))
 	}
@@ -252,7 +323,7 @@ func (p *Prompt) Confirm(title, message string, defaultChoice *bool, forcedChoic
 	} else if !p.isInteractive {
 		nonInteractiveChoice = defaultChoice
 		if nonInteractiveChoice == nil {
-			return false, interactiveInputError(message)
+			return false, interactiveInputError(id, message)
 		}
 	}
 
@@ -294,16 +365,34 @@ func translateConfirm(confirm bool) string {
 
 // InputSecret prompts the user for input and obfuscates the text in stdout.
 // Will fail if empty.
-func (p *Prompt) InputSecret(title, message string, flags ...ValidatorFlag) (string, error) {
-	if !p.isInteractive || p.isForced {
-		return "", interactiveInputError(message)
-	}
+// If the answers file answers the prompt, it returns that answer without showing it.
+func (p *Prompt) InputSecret(id, title, message string, flags ...ValidatorFlag) (string, error) {
 	var response string
 	validators, err := processValidators(flags)
 	if err != nil {
 		return "", err
 	}
 
+	if ok, err := p.answered(id); err != nil {
+		return "", err
+	} else if ok {
+		response, err := p.answers.string(id)
+		if err != nil {
+			return "", err
+		}
+		if err := wrapValidators(validators)(response); err != nil {
+			return "", locale.WrapInputError(err, "err_answer_invalid", "The answer to prompt '[ACTIONABLE]{{.V0}}[/RESET]' is invalid: {{.V1}}", id, err.Error())
+		}
+		if !p.out.Type().IsStructured() {
+			p.out.Notice(locale.Tr("prompt_using_secret_answer", id))
+		}
+		return response, nil
+	}
+
+	if !p.isInteractive || p.isForced {
+		return "", interactiveInputError(id, message)
+	}
+
 	if title != "" {
 		p.out.Notice(output.Emphasize(title))
 	}
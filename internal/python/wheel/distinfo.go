@@ -3,6 +3,7 @@ package wheel
 import (
 	"bytes"
 	"encoding/csv"
+	"sort"
 	"strconv"
 )
 
@@ -26,6 +27,22 @@ func buildMetadata(meta Metadata) []byte {
 	if meta.Summary != "" {
 		b.WriteString("Summary: " + meta.Summary + "\n")
 	}
+	for _, req := range meta.Dependencies {
+		b.WriteString("Requires-Dist: " + req.String() + "\n")
+	}
+
+	// Extras are written in sorted order so output stays byte-reproducible.
+	extras := make([]string, 0, len(meta.OptionalDependencies))
+	for extra := range meta.OptionalDependencies {
+		extras = append(extras, extra)
+	}
+	sort.Strings(extras)
+	for _, extra := range extras {
+		b.WriteString("Provides-Extra: " + extra + "\n")
+		for _, req := range meta.OptionalDependencies[extra] {
+			b.WriteString("Requires-Dist: " + req.withExtra(extra) + "\n")
+		}
+	}
 	return b.Bytes()
 }
 
@@ -20,9 +20,17 @@ func ResolveMetadata(srcDir string, override Metadata) (*Metadata, error) {
 	}
 
 	meta := Metadata{
-		Name:    firstNonEmpty(override.Name, proj.Name),
-		Version: firstNonEmpty(override.Version, proj.Version),
-		Summary: firstNonEmpty(override.Summary, proj.Description),
+		Name:                 firstNonEmpty(override.Name, proj.Name),
+		Version:              firstNonEmpty(override.Version, proj.Version),
+		Summary:              firstNonEmpty(override.Summary, proj.Description),
+		Dependencies:         proj.Dependencies,
+		OptionalDependencies: proj.OptionalDependencies,
+	}
+	if override.Dependencies != nil {
+		meta.Dependencies = override.Dependencies
+	}
+	if override.OptionalDependencies != nil {
+		meta.OptionalDependencies = override.OptionalDependencies
 	}
 	if meta.Name == "" || meta.Version == "" {
 		return nil, ErrMissingMetadata
@@ -31,9 +39,11 @@ func ResolveMetadata(srcDir string, override Metadata) (*Metadata, error) {
 }
 
 type pyProject struct {
-	Name        string
-	Version     string
-	Description string
+	Name                 string
+	Version              string
+	Description          string
+	Dependencies         []Requirement
+	OptionalDependencies map[string][]Requirement
 }
 
 // readPyProject reads the [project] table from a pyproject.toml. A missing file
@@ -49,18 +59,39 @@ func readPyProject(path string) (pyProject, error) {
 
 	var parsed struct {
 		Project struct {
-			Name        string `toml:"name"`
-			Version     string `toml:"version"`
-			Description string `toml:"description"`
+			Name                 string              `toml:"name"`
+			Version              string              `toml:"version"`
+			Description          string              `toml:"description"`
+			Dependencies         []string            `toml:"dependencies"`
+			OptionalDependencies map[string][]string `toml:"optional-dependencies"`
 		} `toml:"project"`
 	}
 	if err := toml.Unmarshal(data, &parsed); err != nil {
 		return pyProject{}, errs.Wrap(err, "could not parse pyproject.toml")
 	}
+
+	deps, err := parseRequirements(parsed.Project.Dependencies)
+	if err != nil {
+		return pyProject{}, errs.Wrap(err, "could not parse pyproject.toml dependencies")
+	}
+	var optional map[string][]Requirement
+	if len(parsed.Project.OptionalDependencies) > 0 {
+		optional = make(map[string][]Requirement, len(parsed.Project.OptionalDependencies))
+		for extra, reqs := range parsed.Project.OptionalDependencies {
+			parsedReqs, err := parseRequirements(reqs)
+			if err != nil {
+				return pyProject{}, errs.Wrap(err, "could not parse pyproject.toml optional dependencies for %s", extra)
+			}
+			optional[normalizeExtra(extra)] = parsedReqs
+		}
+	}
+
 	return pyProject{
-		Name:        parsed.Project.Name,
-		Version:     parsed.Project.Version,
-		Description: parsed.Project.Description,
+		Name:                 parsed.Project.Name,
+		Version:              parsed.Project.Version,
+		Description:          parsed.Project.Description,
+		Dependencies:         deps,
+		OptionalDependencies: optional,
 	}, nil
 }
 
@@ -84,6 +115,12 @@ func normalizeName(name string) string {
 	return strings.ToLower(nameRunRe.ReplaceAllString(name, "_"))
 }
 
+// normalizeExtra converts an extra name to its PEP 685 normalized form: runs of
+// [-_.] collapse to a single dash and the result is lowercased.
+func normalizeExtra(extra string) string {
+	return strings.ToLower(nameRunRe.ReplaceAllString(extra, "-"))
+}
+
 // escapeVersion replaces runs of characters not allowed in a wheel-filename
 // version component with a single underscore.
 func escapeVersion(version string) string {
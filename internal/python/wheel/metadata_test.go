@@ -39,6 +39,39 @@ func TestResolveMetadata(t *testing.T) {
 		}
 	})
 
+	t.Run("pyproject dependencies are parsed", func(t *testing.T) {
+		dir := t.TempDir()
+		writePyproject(t, dir, `[project]
+name = "proj"
+version = "3.1"
+dependencies = ["requests>=2.0,<3", "tomli; python_version < '3.11'"]
+
+[project.optional-dependencies]
+Dev_Tools = ["pytest"]
+`)
+		res, err := ResolveMetadata(dir, Metadata{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Dependencies) != 2 || res.Dependencies[0].Name != "requests" || res.Dependencies[0].Specifier != ">=2.0,<3" {
+			t.Errorf("dependencies = %+v", res.Dependencies)
+		}
+		if res.Dependencies[1].Marker != "python_version < '3.11'" {
+			t.Errorf("marker = %q", res.Dependencies[1].Marker)
+		}
+		if reqs := res.OptionalDependencies["dev-tools"]; len(reqs) != 1 || reqs[0].Name != "pytest" {
+			t.Errorf("optional dependencies = %+v", res.OptionalDependencies)
+		}
+	})
+
+	t.Run("invalid dependency errors", func(t *testing.T) {
+		dir := t.TempDir()
+		writePyproject(t, dir, "[project]\nname = \"proj\"\nversion = \"3.1\"\ndependencies = [\"requests >> 2\"]\n")
+		if _, err := ResolveMetadata(dir, Metadata{}); !errors.Is(err, ErrInvalidRequirement) {
+			t.Errorf("error = %v, want ErrInvalidRequirement", err)
+		}
+	})
+
 	t.Run("missing name and version errors", func(t *testing.T) {
 		dir := t.TempDir() // no pyproject.toml
 		if _, err := ResolveMetadata(dir, Metadata{Name: "only-name"}); !errors.Is(err, ErrMissingMetadata) {
@@ -73,3 +106,34 @@ func TestEscapeVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildMetadataDependencies(t *testing.T) {
+	mustParse := func(s string) Requirement {
+		req, err := ParseRequirement(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	meta := Metadata{
+		Name:         "proj",
+		Version:      "1.0",
+		Dependencies: []Requirement{mustParse("requests >= 2.0")},
+		OptionalDependencies: map[string][]Requirement{
+			"test": {mustParse("pytest")},
+			"docs": {mustParse("sphinx; python_version >= '3.8'")},
+		},
+	}
+	want := `Metadata-Version: 2.1
+Name: proj
+Version: 1.0
+Requires-Dist: requests>=2.0
+Provides-Extra: docs
+Requires-Dist: sphinx; (python_version >= '3.8') and extra == "docs"
+Provides-Extra: test
+Requires-Dist: pytest; extra == "test"
+`
+	if got := string(buildMetadata(meta)); got != want {
+		t.Errorf("METADATA =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package wheel
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+// ErrInvalidRequirement indicates a dependency string is not a valid PEP 508
+// requirement.
+var ErrInvalidRequirement = errs.New("invalid requirement")
+
+// Requirement is a parsed PEP 508 dependency specification, as listed in
+// pyproject.toml's dependencies and optional-dependencies.
+type Requirement struct {
+	Name      string
+	Extras    []string
+	Specifier string // eg. ">=1.0,<2"; empty means any version
+	URL       string // direct reference (name @ url), if any
+	Marker    string // environment marker, if any
+}
+
+// requirementRe splits a requirement into its name, extras, version/URL part and
+// environment marker. Validation of the individual parts happens afterwards.
+var requirementRe = regexp.MustCompile(`^\s*([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)\s*(?:\[([^\]]*)\])?\s*([^;]*?)\s*(?:;\s*(.*?))?\s*$`)
+
+var specifierRe = regexp.MustCompile(`^(~=|===|==|!=|<=|>=|<|>)\s*([A-Za-z0-9.*+!_-]+)$`)
+
+// ParseRequirement parses a single PEP 508 requirement string.
+func ParseRequirement(s string) (Requirement, error) {
+	m := requirementRe.FindStringSubmatch(s)
+	if m == nil {
+		return Requirement{}, errs.Wrap(ErrInvalidRequirement, "could not parse %q", s)
+	}
+
+	req := Requirement{Name: m[1], Marker: m[4]}
+	if m[2] != "" {
+		for _, extra := range strings.Split(m[2], ",") {
+			if extra = strings.TrimSpace(extra); extra != "" {
+				req.Extras = append(req.Extras, extra)
+			}
+		}
+	}
+
+	rest := m[3]
+	switch {
+	case strings.HasPrefix(rest, "@"):
+		req.URL = strings.TrimSpace(strings.TrimPrefix(rest, "@"))
+		if req.URL == "" {
+			return Requirement{}, errs.Wrap(ErrInvalidRequirement, "missing URL in %q", s)
+		}
+	case rest != "":
+		rest = strings.TrimSuffix(strings.TrimPrefix(rest, "("), ")") // legacy parenthesized form
+		var specs []string
+		for _, spec := range strings.Split(rest, ",") {
+			spec = strings.TrimSpace(spec)
+			sm := specifierRe.FindStringSubmatch(spec)
+			if sm == nil {
+				return Requirement{}, errs.Wrap(ErrInvalidRequirement, "invalid version specifier %q in %q", spec, s)
+			}
+			specs = append(specs, sm[1]+sm[2])
+		}
+		req.Specifier = strings.Join(specs, ",")
+	}
+
+	return req, nil
+}
+
+// String returns the requirement in its canonical PEP 508 form.
+func (r Requirement) String() string {
+	var b strings.Builder
+	b.WriteString(r.Name)
+	if len(r.Extras) > 0 {
+		b.WriteString("[" + strings.Join(r.Extras, ",") + "]")
+	}
+	if r.URL != "" {
+		b.WriteString(" @ " + r.URL)
+	} else {
+		b.WriteString(r.Specifier)
+	}
+	if r.Marker != "" {
+		b.WriteString("; " + r.Marker)
+	}
+	return b.String()
+}
+
+// withExtra returns the requirement string gated on the given extra, combining
+// it with any existing environment marker.
+func (r Requirement) withExtra(extra string) string {
+	cond := `extra == "` + extra + `"`
+	if r.Marker != "" {
+		cond = "(" + r.Marker + ") and " + cond
+	}
+	r.Marker = cond
+	return r.String()
+}
+
+// parseRequirements parses every entry of reqs, failing on the first invalid one.
+func parseRequirements(reqs []string) ([]Requirement, error) {
+	parsed := make([]Requirement, 0, len(reqs))
+	for _, s := range reqs {
+		req, err := ParseRequirement(s)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, req)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,44 @@
+package wheel
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseRequirement(t *testing.T) {
+	cases := map[string]Requirement{
+		"requests":                          {Name: "requests"},
+		"requests >= 2.0 , < 3":             {Name: "requests", Specifier: ">=2.0,<3"},
+		"Flask[async,dotenv]~=2.3":          {Name: "Flask", Extras: []string{"async", "dotenv"}, Specifier: "~=2.3"},
+		"tomli (>=1.1)":                     {Name: "tomli", Specifier: ">=1.1"},
+		"pywin32; sys_platform == 'win32'":  {Name: "pywin32", Marker: "sys_platform == 'win32'"},
+		"mylib @ https://example.com/x.whl": {Name: "mylib", URL: "https://example.com/x.whl"},
+	}
+	for in, want := range cases {
+		got, err := ParseRequirement(in)
+		if err != nil {
+			t.Errorf("ParseRequirement(%q): %v", in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseRequirement(%q) = %+v, want %+v", in, got, want)
+		}
+	}
+
+	for _, in := range []string{"", "-bad", "requests >> 2", "requests @"} {
+		if _, err := ParseRequirement(in); !errors.Is(err, ErrInvalidRequirement) {
+			t.Errorf("ParseRequirement(%q) error = %v, want ErrInvalidRequirement", in, err)
+		}
+	}
+}
+
+func TestRequirementString(t *testing.T) {
+	req, err := ParseRequirement("Flask [async] >=2.0,<3 ; python_version >= '3.8'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := req.String(), "Flask[async]>=2.0,<3; python_version >= '3.8'"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
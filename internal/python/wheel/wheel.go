@@ -40,6 +40,13 @@ type Metadata struct {
 	Name    string
 	Version string
 	Summary string
+
+	// Dependencies are recorded as Requires-Dist.
+	Dependencies []Requirement
+	// OptionalDependencies maps each extra to the requirements it pulls in. Each
+	// extra is recorded as Provides-Extra, its requirements as Requires-Dist gated
+	// on that extra.
+	OptionalDependencies map[string][]Requirement
 }
 
 // sourceFile is one file destined for the wheel, identified by its slash path
@@ -105,18 +105,18 @@ func recoverKeypairFromPreviousPassphrase(keypairRes *secretsModels.Keypair, pas
 	return err
 }
 
-func promptForPreviousPassphrase(prompt prompt.Prompter) (string, error) {
-	passphrase, err := prompt.InputSecret("", locale.T("previous_password_prompt"))
+func promptForPreviousPassphrase(prompter prompt.Prompter) (string, error) {
+	passphrase, err := prompter.InputSecret(prompt.IDAuthPreviousPassword, "", locale.T("previous_password_prompt"))
 	if err != nil {
 		return "", locale.WrapInputError(err, "auth_err_password_prompt")
 	}
 	return passphrase, nil
 }
 
-func promptUserToRegenerateKeypair(passphrase string, cfg keypairs.Configurable, out output.Outputer, prompt prompt.Prompter, auth *authentication.Auth) error {
+func promptUserToRegenerateKeypair(passphrase string, cfg keypairs.Configurable, out output.Outputer, prompter prompt.Prompter, auth *authentication.Auth) error {
 	// previous passphrase is invalid, inform user and ask if they want to generate a new keypair
 	out.Notice(locale.T("auth_generate_new_keypair_message"))
-	yes, err := prompt.Confirm("", locale.T("auth_confirm_generate_new_keypair_prompt"), ptr.To(false), nil)
+	yes, err := prompter.Confirm(prompt.IDAuthGenerateKeypair, "", locale.T("auth_confirm_generate_new_keypair_prompt"), ptr.To(false), nil)
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
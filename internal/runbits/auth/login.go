@@ -93,7 +93,7 @@ func AuthenticateWithToken(token string, auth *authentication.Auth) error {
 
 // RequireAuthentication will prompt the user for authentication if they are not already authenticated. If the authentication
 // is not successful it will return a failure
-func RequireAuthentication(message string, cfg keypairs.Configurable, out output.Outputer, prompt prompt.Prompter, auth *authentication.Auth) error {
+func RequireAuthentication(message string, cfg keypairs.Configurable, out output.Outputer, prompter prompt.Prompter, auth *authentication.Auth) error {
 	if auth.Authenticated() {
 		return nil
 	}
@@ -105,22 +105,22 @@ func RequireAuthentication(message string, cfg keypairs.Configurable, out output
 		locale.T("prompt_login_action"),
 		locale.T("prompt_signup_browser_action"),
 	}
-	choice, err := prompt.Select(locale.Tl("login_signup", "Login or Signup"), locale.T("prompt_login_or_signup"), choices, ptr.To(""), nil)
+	choice, err := prompter.Select(prompt.IDAuthLoginOrSignup, locale.Tl("login_signup", "Login or Signup"), locale.T("prompt_login_or_signup"), choices, ptr.To(""), nil)
 	if err != nil {
 		return errs.Wrap(err, "Prompt cancelled")
 	}
 
 	switch choice {
 	case locale.T("prompt_login_browser_action"):
-		if err := AuthenticateWithBrowser(out, auth, prompt, cfg); err != nil {
+		if err := AuthenticateWithBrowser(out, auth, prompter, cfg); err != nil {
 			return errs.Wrap(err, "Authenticate failed")
 		}
 	case locale.T("prompt_login_action"):
-		if err := Authenticate(cfg, out, prompt, auth); err != nil {
+		if err := Authenticate(cfg, out, prompter, auth); err != nil {
 			return errs.Wrap(err, "Authenticate failed")
 		}
 	case locale.T("prompt_signup_browser_action"):
-		if err := SignupWithBrowser(out, auth, prompt, cfg); err != nil {
+		if err := SignupWithBrowser(out, auth, prompter, cfg); err != nil {
 			return errs.Wrap(err, "Signup failed")
 		}
 	}
@@ -138,7 +138,7 @@ func ensureCredentials(credentials *mono_models.Credentials, prompter prompt.Pro
 		if !prompter.IsInteractive() || prompter.IsForced() {
 			return locale.NewInputError("err_auth_needinput")
 		}
-		credentials.Username, err = prompter.Input("", locale.T("username_prompt"), ptr.To(""), nil, prompt.InputRequired)
+		credentials.Username, err = prompter.Input(prompt.IDAuthUsername, "", locale.T("username_prompt"), ptr.To(""), nil, prompt.InputRequired)
 		if err != nil {
 			return errs.Wrap(err, "Input cancelled")
 		}
@@ -148,7 +148,7 @@ func ensureCredentials(credentials *mono_models.Credentials, prompter prompt.Pro
 		if !prompter.IsInteractive() || prompter.IsForced() {
 			return locale.NewInputError("err_auth_needinput")
 		}
-		credentials.Password, err = prompter.InputSecret("", locale.T("password_prompt"), prompt.InputRequired)
+		credentials.Password, err = prompter.InputSecret(prompt.IDAuthPassword, "", locale.T("password_prompt"), prompt.InputRequired)
 		if err != nil {
 			return errs.Wrap(err, "Secret input cancelled")
 		}
@@ -172,9 +172,9 @@ func AuthenticateWithCredentials(credentials *mono_models.Credentials, auth *aut
 	return nil
 }
 
-func promptToken(credentials *mono_models.Credentials, out output.Outputer, prompt prompt.Prompter, auth *authentication.Auth) error {
+func promptToken(credentials *mono_models.Credentials, out output.Outputer, prompter prompt.Prompter, auth *authentication.Auth) error {
 	var err error
-	credentials.Totp, err = prompt.Input("", locale.T("totp_prompt"), ptr.To(""), nil)
+	credentials.Totp, err = prompter.Input(prompt.IDAuthTOTP, "", locale.T("totp_prompt"), ptr.To(""), nil)
 	if err != nil {
 		return err
 	}
@@ -206,7 +206,7 @@ func AuthenticateWithBrowser(out output.Outputer, auth *authentication.Auth, pro
 }
 
 // authenticateWithBrowser authenticates after signup if applicable.
-func authenticateWithBrowser(out output.Outputer, auth *authentication.Auth, prompt prompt.Prompter, cfg keypairs.Configurable, signup bool) error {
+func authenticateWithBrowser(out output.Outputer, auth *authentication.Auth, prompter prompt.Prompter, cfg keypairs.Configurable, signup bool) error {
 	response, err := model.RequestDeviceAuthorization()
 	if err != nil {
 		return locale.WrapError(err, "err_auth_device")
@@ -265,7 +265,7 @@ func authenticateWithBrowser(out output.Outputer, auth *authentication.Auth, pro
 		var cont bool
 		var err error
 		for !cont {
-			cont, err = prompt.Confirm(locale.Tl("continue", "Continue?"), locale.T("auth_press_enter"), ptr.To(false), nil)
+			cont, err = prompter.Confirm(prompt.IDAuthBrowserContinue, locale.Tl("continue", "Continue?"), locale.T("auth_press_enter"), ptr.To(false), nil)
 			if err != nil {
 				return errs.Wrap(err, "Not confirmed")
 			}
@@ -1,6 +1,7 @@
 package cves
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,11 +13,13 @@ import (
 	configMediator "github.com/ActiveState/cli/internal/mediators/config"
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/rtutils/ptr"
 	"github.com/ActiveState/cli/pkg/buildplan"
 	vulnModel "github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/model"
 	"github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/request"
 	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/ActiveState/cli/pkg/vulndb"
 )
 
 func init() {
@@ -29,6 +32,8 @@ func init() {
 		vulnModel.SeverityLow,
 	}, vulnModel.SeverityCritical)
 	configMediator.RegisterOption(constants.SecurityPromptLevelConfig, configMediator.Enum, severities)
+	configMediator.RegisterOption(constants.SecurityOfflineConfig, configMediator.Bool, false)
+	configMediator.RegisterOption(constants.SecurityOfflineDBConfig, configMediator.String, "")
 }
 
 type primeable interface {
@@ -80,7 +85,14 @@ func (c *CveReport) Report(newBuildPlan *buildplan.BuildPlan, oldBuildPlan *buil
 
 	pg := output.StartSpinner(c.prime.Output(), locale.T("progress_cve_search"), constants.TerminalAnimationInterval)
 
-	ingredientVulnerabilities, err := model.FetchVulnerabilitiesForIngredients(c.prime.Auth(), ingredients)
+	ingredientVulnerabilities, err := c.fetchVulnerabilities(ingredients)
+	if errors.Is(err, vulndb.ErrNotSynced) {
+		logging.Debug("Local vulnerability database has not been synced")
+		pg.Stop(locale.T("progress_fail"))
+		pg = nil
+		c.prime.Output().Notice(locale.Tl("warn_vulndb_not_synced", "[WARNING]Could not check for vulnerabilities because the local vulnerability database has not been synced. Run '[ACTIONABLE]state security sync[/RESET]' to sync it.[/RESET]"))
+		return nil
+	}
 	if err != nil {
 		return errs.Wrap(err, "Failed to retrieve vulnerabilities")
 	}
@@ -106,7 +118,7 @@ func (c *CveReport) Report(newBuildPlan *buildplan.BuildPlan, oldBuildPlan *buil
 
 	c.summarizeCVEs(vulnerabilities)
 
-	confirm, err := c.prime.Prompt().Confirm("", locale.Tr("prompt_continue_pkg_operation"), ptr.To(false), ptr.To(true))
+	confirm, err := c.prime.Prompt().Confirm(prompt.IDCVEsContinue, "", locale.Tr("prompt_continue_pkg_operation"), ptr.To(false), ptr.To(true))
 	if err == nil && !confirm {
 		err = locale.NewInputError("err_pkgop_security_prompt", "Operation aborted by user")
 	}
@@ -120,12 +132,26 @@ func (c *CveReport) Report(newBuildPlan *buildplan.BuildPlan, oldBuildPlan *buil
 	return nil
 }
 
+// fetchVulnerabilities retrieves the vulnerabilities of the given ingredients from the platform, or
+// from the local vulnerability database if offline checking is enabled.
+func (c *CveReport) fetchVulnerabilities(ingredients []*request.Ingredient) ([]*model.VulnerabilityIngredient, error) {
+	if !c.prime.Config().GetBool(constants.SecurityOfflineConfig) {
+		return model.FetchVulnerabilitiesForIngredients(c.prime.Auth(), ingredients)
+	}
+
+	db, err := vulndb.Open(vulndb.Dir(c.prime.Config()))
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not open vulnerability database")
+	}
+	return db.VulnerabilitiesForIngredients(ingredients), nil
+}
+
 func (c *CveReport) shouldSkipReporting(changeset buildplan.ArtifactChangeset) bool {
 	if !c.prime.Config().GetBool(constants.SecurityReportingConfig) {
 		return true
 	}
-	
-	if !c.prime.Auth().Authenticated() {
+
+	if !c.prime.Auth().Authenticated() && !c.prime.Config().GetBool(constants.SecurityOfflineConfig) {
 		return true
 	}
 
@@ -0,0 +1,49 @@
+package dependencies
+
+import (
+	"sort"
+
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+)
+
+// Change is an artifact that is added, removed or updated between two build plans.
+type Change struct {
+	Change     string `json:"change" locale:"change,[HEADING]Change[/RESET]"`
+	Name       string `json:"name" locale:"name,[HEADING]Name[/RESET]"`
+	Namespace  string `json:"namespace,omitempty" opts:"hidePlain"`
+	Version    string `json:"version,omitempty" locale:"version,[HEADING]Version[/RESET]"`
+	OldVersion string `json:"old_version,omitempty" locale:"old_version,[HEADING]Previous Version[/RESET]"`
+}
+
+// Changes lists the artifacts that are added, removed or updated in the new build plan compared to the old one,
+// sorted by name. Builders are left out as they are not part of the runtime.
+func Changes(newBuildPlan *buildplan.BuildPlan, oldBuildPlan *buildplan.BuildPlan) []*Change {
+	changes := []*Change{}
+	for _, change := range newBuildPlan.DiffArtifacts(oldBuildPlan, false) {
+		a := change.Artifact
+		if a.MimeType == types.XActiveStateBuilderMimeType {
+			continue
+		}
+		c := &Change{
+			Change:  change.ChangeType.String(),
+			Name:    a.Name(),
+			Version: a.Version(),
+		}
+		if len(a.Ingredients) > 0 {
+			c.Namespace = a.Ingredients[0].Namespace
+		}
+		if change.Old != nil {
+			c.OldVersion = change.Old.Version()
+		}
+		changes = append(changes, c)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].Change < changes[j].Change
+	})
+	return changes
+}
@@ -0,0 +1,197 @@
+// Package envexport renders environment variables in formats that shells and other tools can load, such as direnv's
+// .envrc or dotenv files.
+package envexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+const (
+	Direnv = "direnv"
+	Dotenv = "dotenv"
+	Posix  = "posix"
+	Fish   = "fish"
+	JSON   = "json"
+)
+
+// Formats lists the supported formats.
+var Formats = []string{Direnv, Dotenv, Posix, Fish, JSON}
+
+var ErrUnknownFormat = errs.New("unknown format")
+
+// Var is an environment variable to export.
+type Var struct {
+	Name string
+	// Value is the value to export, or nil if the variable should be unset.
+	Value *string
+	// Prepend prepends Value to the current value of the variable, treating both as path lists.
+	Prepend bool
+}
+
+// FromMap returns the given variables sorted by name. The variables named in prepend are prepended to their
+// current value rather than replacing it.
+func FromMap(env map[string]string, prepend ...string) []Var {
+	vars := make([]Var, 0, len(env))
+	for name, value := range env {
+		value := value
+		vars = append(vars, Var{Name: name, Value: &value})
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	for i := range vars {
+		for _, name := range prepend {
+			if vars[i].Name == name {
+				vars[i].Prepend = true
+			}
+		}
+	}
+	return vars
+}
+
+// Render returns the given variables in the given format.
+// The dotenv and json formats cannot refer to the current value of a variable, so variables that are prepended are
+// resolved against the current environment instead.
+func Render(format string, vars []Var) (string, error) {
+	switch format {
+	case Posix:
+		return renderLines(vars, posixLine), nil
+	case Direnv:
+		return renderLines(vars, direnvLine), nil
+	case Fish:
+		return renderLines(vars, fishLine), nil
+	case Dotenv:
+		return renderLines(vars, dotenvLine), nil
+	case JSON:
+		return renderJSON(vars)
+	default:
+		return "", errs.Wrap(ErrUnknownFormat, "Format: %s", format)
+	}
+}
+
+func renderLines(vars []Var, line func(v Var) string) string {
+	var lines []string
+	for _, v := range vars {
+		if l := line(v); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func posixLine(v Var) string {
+	if v.Value == nil {
+		return "unset " + v.Name
+	}
+	if v.Prepend {
+		return fmt.Sprintf(`export %s=%s"${%s:+%c$%s}"`, v.Name, quotePosix(*v.Value), v.Name, os.PathListSeparator, v.Name)
+	}
+	return fmt.Sprintf("export %s=%s", v.Name, quotePosix(*v.Value))
+}
+
+func direnvLine(v Var) string {
+	if v.Value == nil || !v.Prepend {
+		return posixLine(v)
+	}
+	// Use direnv's stdlib so that direnv can tell which entries it added, and remove them again on unload.
+	args := quoteList(splitList(*v.Value), quotePosix)
+	if v.Name == "PATH" {
+		return "PATH_add " + args
+	}
+	return fmt.Sprintf("path_add %s %s", v.Name, args)
+}
+
+func fishLine(v Var) string {
+	if v.Value == nil {
+		return "set -e " + v.Name
+	}
+	if v.Prepend {
+		return fmt.Sprintf("set -gx %s %s $%s", v.Name, quoteList(splitList(*v.Value), quoteFish), v.Name)
+	}
+	if v.Name == "PATH" {
+		// Fish holds PATH as a list rather than a separated string.
+		return fmt.Sprintf("set -gx %s %s", v.Name, quoteList(splitList(*v.Value), quoteFish))
+	}
+	return fmt.Sprintf("set -gx %s %s", v.Name, quoteFish(*v.Value))
+}
+
+func dotenvLine(v Var) string {
+	if v.Value == nil {
+		// Dotenv files cannot unset variables.
+		return ""
+	}
+	return fmt.Sprintf("%s=%s", v.Name, quoteDotenv(resolve(v)))
+}
+
+func renderJSON(vars []Var) (string, error) {
+	values := make(map[string]*string, len(vars))
+	for _, v := range vars {
+		if v.Value == nil {
+			values[v.Name] = nil
+			continue
+		}
+		value := resolve(v)
+		values[v.Name] = &value
+	}
+	b, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return "", errs.Wrap(err, "Could not marshal variables")
+	}
+	return string(b) + "\n", nil
+}
+
+// resolve returns the value of the given variable, with its current value appended if it is prepended.
+func resolve(v Var) string {
+	if current := os.Getenv(v.Name); v.Prepend && current != "" {
+		return *v.Value + string(os.PathListSeparator) + current
+	}
+	return *v.Value
+}
+
+func splitList(value string) []string {
+	return strings.Split(value, string(os.PathListSeparator))
+}
+
+// DirenvWatchFile returns the direnv directive that reloads the environment whenever the given file changes.
+func DirenvWatchFile(path string) string {
+	return "watch_file " + quotePosix(path) + "\n"
+}
+
+func quoteList(values []string, quote func(string) string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quote(v)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// quotePosix single quotes the given value, in which nothing has special meaning except for the single quote itself.
+func quotePosix(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// quoteFish single quotes the given value, in which only backslashes and single quotes need escaping.
+func quoteFish(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return "'" + strings.ReplaceAll(value, "'", `\'`) + "'"
+}
+
+// quoteDotenv single quotes the given value if possible, as dotenv implementations do not interpolate single quoted
+// values. Otherwise it double quotes it, escaping what most implementations treat as special.
+func quoteDotenv(value string) string {
+	if !strings.ContainsAny(value, "'\n") {
+		return "'" + value + "'"
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "$", `\$`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return `"` + value + `"`
+}
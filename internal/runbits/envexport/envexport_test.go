@@ -0,0 +1,56 @@
+//go:build !windows
+// +build !windows
+
+package envexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin")
+	vars := FromMap(map[string]string{
+		"PATH":  "/rt/bin:/rt/sbin",
+		"QUOTE": `it's "$HOME"`,
+	}, "PATH")
+	vars = append(vars, Var{Name: "GONE"})
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{Posix, `export PATH='/rt/bin:/rt/sbin'"${PATH:+:$PATH}"` + "\n" +
+			`export QUOTE='it'\''s "$HOME"'` + "\n" +
+			"unset GONE\n"},
+		{Direnv, `PATH_add '/rt/bin' '/rt/sbin'` + "\n" +
+			`export QUOTE='it'\''s "$HOME"'` + "\n" +
+			"unset GONE\n"},
+		{Fish, `set -gx PATH '/rt/bin' '/rt/sbin' $PATH` + "\n" +
+			`set -gx QUOTE 'it\'s "$HOME"'` + "\n" +
+			"set -e GONE\n"},
+		{Dotenv, `PATH='/rt/bin:/rt/sbin:/usr/bin'` + "\n" +
+			`QUOTE="it's \"\$HOME\""` + "\n"},
+		{JSON, "{\n" +
+			`  "GONE": null,` + "\n" +
+			`  "PATH": "/rt/bin:/rt/sbin:/usr/bin",` + "\n" +
+			`  "QUOTE": "it's \"$HOME\""` + "\n" +
+			"}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			out, err := Render(tt.format, vars)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+
+	_, err := Render("xml", vars)
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestDirenvWatchFile(t *testing.T) {
+	assert.Equal(t, "watch_file '/home/user/it'\\''s $HOME/activestate.yaml'\n", DirenvWatchFile("/home/user/it's $HOME/activestate.yaml"))
+}
@@ -59,7 +59,7 @@ func FromPath(path string, ns *project.Namespaced) (*project.Project, error) {
 // project in the current working directory if namespace was not given).
 // This is primarily used by `state use` in order to fetch a project to switch to if it already
 // exists locally. The namespace may omit the owner.
-func FromNamespaceLocal(ns *project.Namespaced, cfg projectfile.ConfigGetter, prompt prompt.Prompter) (*project.Project, error) {
+func FromNamespaceLocal(ns *project.Namespaced, cfg projectfile.ConfigGetter, prompter prompt.Prompter) (*project.Project, error) {
 	if ns == nil || !ns.IsValid() {
 		root, err := osutils.Getwd()
 		if err != nil {
@@ -95,7 +95,8 @@ func FromNamespaceLocal(ns *project.Namespaced, cfg projectfile.ConfigGetter, pr
 		sort.Strings(matchingNamespaces)
 		namespace := matchingNamespaces[0]
 		if len(matchingProjects) > 1 {
-			namespace, err = prompt.Select(
+			namespace, err = prompter.Select(
+				prompt.IDProjectSelectNamespace,
 				"",
 				locale.Tl("project_select_namespace", "Multiple projects with that name were found. Please select one."),
 				matchingNamespaces,
@@ -114,7 +115,8 @@ func FromNamespaceLocal(ns *project.Namespaced, cfg projectfile.ConfigGetter, pr
 		sort.Strings(paths)
 		path := paths[0]
 		if len(paths) > 1 {
-			path, err = prompt.Select(
+			path, err = prompter.Select(
+				prompt.IDProjectSelectPath,
 				"",
 				locale.Tl("project_select_path", "Multiple project paths for the selected project were found. Please select one."),
 				paths,
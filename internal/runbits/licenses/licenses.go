@@ -0,0 +1,165 @@
+// Package licenses enforces the license policy of a project whenever its runtime changes. The policy
+// is defined by the licenses section of the activestate.yaml, and optionally by an organization-wide
+// policy file configured via licenses.policy.file.
+package licenses
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
+	configMediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
+	"github.com/ActiveState/cli/internal/rtutils/ptr"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/projectfile"
+)
+
+func init() {
+	configMediator.RegisterOption(constants.LicensePolicyFileConfig, configMediator.String, "")
+}
+
+type primeable interface {
+	primer.Outputer
+	primer.Prompter
+	primer.Projecter
+	primer.Configurer
+}
+
+type Configurable interface {
+	GetString(key string) string
+}
+
+// LoadPolicy returns the license policy that applies to the given project, combining the organization
+// policy file (if configured) with the project's own policy. It returns nil if neither exists.
+func LoadPolicy(proj *project.Project, cfg Configurable) (*Policy, error) {
+	var orgRules *projectfile.Licenses
+	if path := cfg.GetString(constants.LicensePolicyFileConfig); path != "" {
+		var err error
+		orgRules, err = ReadPolicyFile(path)
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not read organization license policy")
+		}
+	}
+	var projectRules *projectfile.Licenses
+	if proj != nil {
+		projectRules = proj.LicensePolicy()
+	}
+	return NewPolicy(orgRules, projectRules)
+}
+
+type LicenseReport struct {
+	prime primeable
+}
+
+func NewLicenseReport(prime primeable) *LicenseReport {
+	return &LicenseReport{prime}
+}
+
+// Report evaluates the runtime artifacts that were added or updated between the given buildplans
+// against the license policy. Depending on the policy level violations are reported, prompted for or
+// fail the operation. If oldBuildPlan is nil all runtime artifacts are evaluated.
+func (r *LicenseReport) Report(newBuildPlan *buildplan.BuildPlan, oldBuildPlan *buildplan.BuildPlan) error {
+	policy, err := LoadPolicy(r.prime.Project(), r.prime.Config())
+	if err != nil {
+		return errs.Wrap(err, "Could not load license policy")
+	}
+	if policy == nil {
+		logging.Debug("No license policy, skipping license report")
+		return nil
+	}
+
+	var artifacts buildplan.Artifacts
+	for _, change := range newBuildPlan.DiffArtifacts(oldBuildPlan, false).Filter(buildplan.ArtifactAdded, buildplan.ArtifactUpdated) {
+		if change.Artifact.IsRuntimeDependency && change.Artifact.MimeType != types.XActiveStateBuilderMimeType {
+			artifacts = append(artifacts, change.Artifact)
+		}
+	}
+
+	violations := policy.Check(artifacts)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	out := r.prime.Output()
+	if policy.Level == LevelFail {
+		if !out.Type().IsStructured() {
+			r.summarize(violations)
+		}
+		return errs.AddTips(
+			locale.NewInputError("err_license_policy_violation", "The runtime contains {{.V0}} package(s) that violate the license policy.", strconv.Itoa(len(violations))),
+			locale.Tl("license_policy_tip", "Review the license policy in the '[ACTIONABLE]licenses[/RESET]' section of your activestate.yaml"),
+		)
+	}
+
+	if out.Type().IsStructured() {
+		return nil
+	}
+
+	if policy.Level == LevelWarn || r.prime.Prompt() == nil {
+		r.warn(violations)
+		return nil
+	}
+
+	r.summarize(violations)
+	confirm, err := r.prime.Prompt().Confirm(prompt.IDLicensesContinue, "", locale.Tr("prompt_continue_pkg_operation"), ptr.To(false), ptr.To(true))
+	if err == nil && !confirm {
+		err = locale.NewInputError("err_pkgop_license_prompt", "Operation aborted by user")
+	}
+	if err != nil {
+		return errs.AddTips(err,
+			locale.Tl("license_policy_tip", "Review the license policy in the '[ACTIONABLE]licenses[/RESET]' section of your activestate.yaml"),
+		)
+	}
+	out.Notice("") // Empty line
+
+	return nil
+}
+
+func (r *LicenseReport) warn(violations []Violation) {
+	out := r.prime.Output()
+	out.Notice("")
+	out.Notice("  " + locale.Tl("warning_license_policy_short", "[WARNING]Warning:[/RESET] {{.V0}} package(s) violate the license policy: {{.V1}}",
+		strconv.Itoa(len(violations)), strings.Join(violationNames(violations), ", ")))
+}
+
+func (r *LicenseReport) summarize(violations []Violation) {
+	out := r.prime.Output()
+	out.Print("")
+	out.Print("  " + locale.Tl("warning_license_policy", "[WARNING]Warning:[/RESET] The following packages violate the license policy:"))
+	for _, v := range violations {
+		out.Print("  • " + FormatViolation(v))
+	}
+	out.Print("")
+}
+
+// FormatViolation returns a human readable description of the given violation.
+func FormatViolation(v Violation) string {
+	name := v.Name
+	if v.Version != "" {
+		name += "@" + v.Version
+	}
+	verdict := locale.Tl("license_verdict_review", "[YELLOW]needs review[/RESET]")
+	if v.Verdict == Denied {
+		verdict = locale.Tl("license_verdict_denied", "[RED]denied[/RESET]")
+	}
+	return fmt.Sprintf("[ACTIONABLE]%s[/RESET]: %s (%s)", name, v.License, verdict)
+}
+
+func violationNames(violations []Violation) []string {
+	names := make([]string, len(violations))
+	for i, v := range violations {
+		names[i] = v.Name
+		if v.Version != "" {
+			names[i] += "@" + v.Version
+		}
+	}
+	return names
+}
@@ -0,0 +1,234 @@
+package licenses
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/projectfile"
+	"github.com/ActiveState/cli/pkg/spdx"
+	"gopkg.in/yaml.v2"
+)
+
+type Level string
+
+const (
+	LevelWarn   Level = "warn"
+	LevelPrompt Level = "prompt"
+	LevelFail   Level = "fail"
+)
+
+var levelSeverity = map[Level]int{LevelWarn: 0, LevelPrompt: 1, LevelFail: 2}
+
+type Verdict string
+
+const (
+	Allowed Verdict = "allowed"
+	Review  Verdict = "review"
+	Denied  Verdict = "denied"
+)
+
+var verdictSeverity = map[Verdict]int{Allowed: 0, Review: 1, Denied: 2}
+
+func worse(a, b Verdict) bool {
+	return verdictSeverity[a] > verdictSeverity[b]
+}
+
+// Violation is an artifact whose licenses do not comply with the policy.
+type Violation struct {
+	Name      string   `json:"name"`
+	Version   string   `json:"version,omitempty"`
+	License   string   `json:"license"`
+	Verdict   Verdict  `json:"verdict"`
+	Offending []string `json:"offending"` // the licenses responsible for the verdict
+}
+
+// Policy is a set of license rules, eg. of both the organization and the project. A license has to
+// comply with every rule.
+type Policy struct {
+	Level Level
+	rules []*projectfile.Licenses
+}
+
+// NewPolicy combines the given rules into a single policy, using the strictest level of all rules.
+// Nil rules are ignored, and nil is returned if no rules are given.
+func NewPolicy(rules ...*projectfile.Licenses) (*Policy, error) {
+	p := &Policy{Level: LevelWarn}
+	for _, r := range rules {
+		if r == nil {
+			continue
+		}
+		level := Level(strings.ToLower(r.Level))
+		if level == "" {
+			level = LevelWarn
+		}
+		if _, ok := levelSeverity[level]; !ok {
+			return nil, locale.NewInputError("err_license_policy_level", "Invalid license policy level '[ACTIONABLE]{{.V0}}[/RESET]', must be one of: warn, prompt, fail.", r.Level)
+		}
+		if levelSeverity[level] > levelSeverity[p.Level] {
+			p.Level = level
+		}
+		p.rules = append(p.rules, r)
+	}
+	if len(p.rules) == 0 {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// ReadPolicyFile reads license rules from a YAML file using the same format as the licenses section
+// of the activestate.yaml.
+func ReadPolicyFile(path string) (*projectfile.Licenses, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, locale.WrapExternalError(err, "err_license_policy_read", "Could not read license policy file '[ACTIONABLE]{{.V0}}[/RESET]'.", path)
+	}
+	rules := &projectfile.Licenses{}
+	if err := yaml.Unmarshal(b, rules); err != nil {
+		return nil, locale.WrapInputError(err, "err_license_policy_parse", "Could not parse license policy file '[ACTIONABLE]{{.V0}}[/RESET]': {{.V1}}", path, err.Error())
+	}
+	return rules, nil
+}
+
+// Evaluate returns the verdict for the given license expression, along with the licenses that led
+// to it. Licenses joined by OR only need one of them to comply, licenses joined by AND all do.
+func (p *Policy) Evaluate(license string) (Verdict, []string) {
+	verdict := Allowed
+	var offending []string
+	for _, r := range p.rules {
+		v, o := evaluateRule(r, license)
+		switch {
+		case worse(v, verdict):
+			verdict, offending = v, o
+		case v == verdict && v != Allowed:
+			offending = append(offending, o...)
+		}
+	}
+	return verdict, spdx.Unique(offending)
+}
+
+// Check returns the violations of the given artifacts. Artifacts without license information are not
+// evaluated.
+func (p *Policy) Check(artifacts buildplan.Artifacts) []Violation {
+	var violations []Violation
+	seen := map[string]struct{}{}
+	for _, a := range artifacts {
+		licenses := spdx.Unique(a.Licenses())
+		if len(licenses) == 0 {
+			continue
+		}
+		key := a.NameAndVersion()
+		if _, ok := seen[key]; ok {
+			continue // the same artifact for another platform
+		}
+		seen[key] = struct{}{}
+
+		expr := spdx.Join(licenses)
+		verdict, offending := p.Evaluate(expr)
+		if verdict == Allowed {
+			continue
+		}
+		violations = append(violations, Violation{
+			Name:      a.Name(),
+			Version:   a.Version(),
+			License:   expr,
+			Verdict:   verdict,
+			Offending: offending,
+		})
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].Verdict != violations[j].Verdict {
+			return worse(violations[i].Verdict, violations[j].Verdict)
+		}
+		return strings.ToLower(violations[i].Name) < strings.ToLower(violations[j].Name)
+	})
+	return violations
+}
+
+func evaluateRule(r *projectfile.Licenses, license string) (Verdict, []string) {
+	expr, err := spdx.Parse(license)
+	if err != nil {
+		expr = &spdx.Expression{License: license}
+	}
+	// A rule may list a complete expression, which takes precedence over its individual licenses.
+	if expr.Operator != "" {
+		if v, ok := lookupExact(r, license); ok {
+			return v, []string{license}
+		}
+	}
+	return evaluateExpression(r, expr)
+}
+
+// evaluateExpression returns the verdict a rule assigns to the given expression, along with the
+// licenses that led to it.
+func evaluateExpression(r *projectfile.Licenses, e *spdx.Expression) (Verdict, []string) {
+	if e.Operator == "" {
+		license := e.String()
+		verdict := lookup(r, license)
+		if verdict == Allowed {
+			return Allowed, nil
+		}
+		return verdict, []string{license}
+	}
+
+	var verdict Verdict
+	var offending []string
+	for i, op := range e.Operands {
+		v, o := evaluateExpression(r, op)
+		switch {
+		case i == 0,
+			e.Operator == "AND" && worse(v, verdict),
+			e.Operator == "OR" && worse(verdict, v):
+			verdict, offending = v, o
+		case v == verdict && v != Allowed:
+			offending = append(offending, o...)
+		}
+	}
+	return verdict, offending
+}
+
+// lookup returns the verdict a rule assigns to a single license. If the rule has an allow list,
+// licenses that are not on it are denied.
+func lookup(r *projectfile.Licenses, license string) Verdict {
+	switch {
+	case matchesAny(r.Deny, license, true):
+		return Denied
+	case matchesAny(r.Review, license, true):
+		return Review
+	case matchesAny(r.Allow, license, true), len(r.Allow) == 0:
+		return Allowed
+	}
+	return Denied
+}
+
+// lookupExact returns the verdict a rule assigns to a complete license expression, without
+// wildcards. It returns false if the rule does not list the expression.
+func lookupExact(r *projectfile.Licenses, expr string) (Verdict, bool) {
+	switch {
+	case matchesAny(r.Deny, expr, false):
+		return Denied, true
+	case matchesAny(r.Review, expr, false):
+		return Review, true
+	case matchesAny(r.Allow, expr, false):
+		return Allowed, true
+	}
+	return Allowed, false
+}
+
+func matchesAny(patterns []string, license string, wildcards bool) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && wildcards {
+			if len(license) >= len(prefix) && strings.EqualFold(license[:len(prefix)], prefix) {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(pattern, license) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package licenses
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/pkg/projectfile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	policy, err := NewPolicy(&projectfile.Licenses{
+		Allow:  []string{"MIT", "Apache-2.0", "BSD-*"},
+		Review: []string{"LGPL-*"},
+		Deny:   []string{"GPL-*", "AGPL-3.0-only OR MIT"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		license       string
+		wantVerdict   Verdict
+		wantOffending []string
+	}{
+		{"MIT", Allowed, nil},
+		{"mit", Allowed, nil},
+		{"BSD-3-Clause", Allowed, nil},
+		{"GPL-3.0-only", Denied, []string{"GPL-3.0-only"}},
+		{"LGPL-2.1-only", Review, []string{"LGPL-2.1-only"}},
+		{"Unlisted-1.0", Denied, []string{"Unlisted-1.0"}},
+		{"GPL-3.0-only OR MIT", Allowed, nil},
+		{"GPL-3.0-only AND MIT", Denied, []string{"GPL-3.0-only"}},
+		{"LGPL-2.1-only OR GPL-3.0-only", Review, []string{"LGPL-2.1-only"}},
+		{"(MIT OR GPL-3.0-only) AND LGPL-2.1-only", Review, []string{"LGPL-2.1-only"}},
+		{"GPL-2.0-only WITH Classpath-exception-2.0", Denied, []string{"GPL-2.0-only WITH Classpath-exception-2.0"}},
+		{"AGPL-3.0-only OR MIT", Denied, []string{"AGPL-3.0-only OR MIT"}},
+		{"MIT AND (", Denied, []string{"MIT AND ("}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.license, func(t *testing.T) {
+			verdict, offending := policy.Evaluate(tt.license)
+			assert.Equal(t, tt.wantVerdict, verdict)
+			assert.Equal(t, tt.wantOffending, offending)
+		})
+	}
+}
+
+func TestEvaluateWithoutAllowList(t *testing.T) {
+	policy, err := NewPolicy(&projectfile.Licenses{Deny: []string{"GPL-3.0-only"}})
+	require.NoError(t, err)
+
+	verdict, _ := policy.Evaluate("Unlisted-1.0")
+	assert.Equal(t, Allowed, verdict, "licenses are allowed by default if there is no allow list")
+
+	verdict, _ = policy.Evaluate("GPL-3.0-only")
+	assert.Equal(t, Denied, verdict)
+}
+
+func TestNewPolicy(t *testing.T) {
+	policy, err := NewPolicy(nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = NewPolicy(&projectfile.Licenses{Deny: []string{"GPL-*"}})
+	require.NoError(t, err)
+	assert.Equal(t, LevelWarn, policy.Level)
+
+	org := &projectfile.Licenses{Level: "fail", Deny: []string{"AGPL-*"}}
+	proj := &projectfile.Licenses{Level: "prompt", Review: []string{"MPL-2.0"}}
+	policy, err = NewPolicy(org, proj)
+	require.NoError(t, err)
+	assert.Equal(t, LevelFail, policy.Level, "the strictest level applies")
+
+	verdict, _ := policy.Evaluate("AGPL-3.0-only")
+	assert.Equal(t, Denied, verdict, "organization rules apply")
+	verdict, _ = policy.Evaluate("MPL-2.0")
+	assert.Equal(t, Review, verdict, "project rules apply")
+
+	_, err = NewPolicy(&projectfile.Licenses{Level: "block"})
+	assert.Error(t, err)
+}
+
+func TestReadPolicyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	err := os.WriteFile(path, []byte("level: prompt\nallow:\n  - MIT\ndeny:\n  - GPL-*\n"), 0644)
+	require.NoError(t, err)
+
+	rules, err := ReadPolicyFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, &projectfile.Licenses{Level: "prompt", Allow: []string{"MIT"}, Deny: []string{"GPL-*"}}, rules)
+
+	_, err = ReadPolicyFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
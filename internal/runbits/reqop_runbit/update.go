@@ -10,9 +10,11 @@ import (
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runbits/buildscript"
 	"github.com/ActiveState/cli/internal/runbits/cves"
 	"github.com/ActiveState/cli/internal/runbits/dependencies"
+	"github.com/ActiveState/cli/internal/runbits/licenses"
 	"github.com/ActiveState/cli/internal/runbits/runtime"
 	"github.com/ActiveState/cli/internal/runbits/runtime/trigger"
 	"github.com/ActiveState/cli/pkg/buildscript"
@@ -52,9 +54,119 @@ type Requirement struct {
 }
 
 func UpdateAndReload(prime primeable, script *buildscript.BuildScript, oldCommit *buildplanner.Commit, commitMsg string, trigger trigger.Trigger) error {
-	pj := prime.Project()
 	out := prime.Output()
 	cfg := prime.Config()
+
+	newCommit, err := stage(prime, script, oldCommit, commitMsg)
+	if err != nil {
+		return errs.Wrap(err, "Could not stage commit")
+	}
+
+	// Report changes and CVEs to user
+	dependencies.OutputChangeSummary(out, newCommit.BuildPlan(), oldCommit.BuildPlan())
+	if err := cves.NewCveReport(prime).Report(newCommit.BuildPlan(), oldCommit.BuildPlan()); err != nil {
+		return errs.Wrap(err, "Could not report CVEs")
+	}
+	if err := licenses.NewLicenseReport(prime).Report(newCommit.BuildPlan(), oldCommit.BuildPlan()); err != nil {
+		return errs.Wrap(err, "Could not report license policy violations")
+	}
+
+	// Start runtime sourcing UI
+	// Note normally we'd defer to Update's logic of async runtimes, but the reason we do this is to allow for solve
+	// errors to still be relayed even when using async. In this particular case the solving logic already happened
+	// when we created the commit, so running it again doesn't provide any value and only would slow things down.
+	if !cfg.GetBool(constants.AsyncRuntimeConfig) {
+		// refresh or install runtime
+		_, err := runtime_runbit.Update(prime, trigger,
+			runtime_runbit.WithCommit(newCommit),
+			runtime_runbit.WithoutBuildscriptValidation(),
+		)
+		if err != nil {
+			if !isBuildError(err) {
+				// If the error is not a build error we still want to update the commit
+				if err2 := updateCommitID(prime, newCommit.CommitID); err2 != nil {
+					return errs.Pack(err, locale.WrapError(err2, "err_package_update_commit_id"))
+				}
+			}
+			return errs.Wrap(err, "Failed to refresh runtime")
+		}
+	} else {
+		prime.Output().Notice("") // blank line
+		prime.Output().Notice(locale.Tr("notice_async_runtime", constants.AsyncRuntimeConfig))
+	}
+
+	// Update commit ID
+	if err := updateCommitID(prime, newCommit.CommitID); err != nil {
+		return locale.WrapError(err, "err_package_update_commit_id")
+	}
+	return nil
+}
+
+// DryRun solves the given build script like UpdateAndReload would, and reports the changes that would be made to the
+// runtime without updating the local checkout. Requested describes the requirements that were operated on, and is
+// included in structured output.
+//
+// The platform can only solve build scripts as commits, so this does stage a commit on top of the old commit. It is
+// not referenced by the project or the local checkout, and so does not become part of the project's history.
+func DryRun(prime primeable, script *buildscript.BuildScript, oldCommit *buildplanner.Commit, commitMsg string, requested interface{}) error {
+	out := prime.Output()
+
+	newCommit, err := stage(prime, script, oldCommit, commitMsg)
+	if err != nil {
+		return errs.Wrap(err, "Could not stage commit")
+	}
+
+	changes := dependencies.Changes(newCommit.BuildPlan(), oldCommit.BuildPlan())
+	if !out.Type().IsStructured() {
+		dependencies.OutputChangeSummary(out, newCommit.BuildPlan(), oldCommit.BuildPlan())
+	}
+
+	// Run the same reports as UpdateAndReload, but without asking whether to continue as nothing is applied anyway.
+	if err := cves.NewCveReport(reportOnly{prime}).Report(newCommit.BuildPlan(), oldCommit.BuildPlan()); err != nil {
+		return errs.Wrap(err, "Could not report CVEs")
+	}
+	if err := licenses.NewLicenseReport(reportOnly{prime}).Report(newCommit.BuildPlan(), oldCommit.BuildPlan()); err != nil {
+		return errs.Wrap(err, "Could not report license policy violations")
+	}
+
+	if out.Type().IsStructured() {
+		out.Print(output.Structured(&dryRunOutput{requested, changes, newCommit.CommitID.String(), true}))
+		return nil
+	}
+
+	out.Notice("") // blank line
+	if len(changes) == 0 {
+		out.Notice(locale.Tl("reqop_dry_run_no_changes", "The runtime would not change."))
+	} else {
+		out.Print(changes)
+	}
+	out.Notice("") // blank line
+	out.Notice(locale.Tl("reqop_dry_run", "Dry run, the changes were not applied. They were solved as the staged commit [ACTIONABLE]{{.V0}}[/RESET], which is not part of your project's history. Run without '[ACTIONABLE]--dry-run[/RESET]' to apply them.", newCommit.CommitID.String()))
+
+	return nil
+}
+
+// reportOnly hides the prompter from reports, so that they warn about what they would otherwise ask to continue
+// despite.
+type reportOnly struct {
+	primeable
+}
+
+func (reportOnly) Prompt() prompt.Prompter {
+	return nil
+}
+
+type dryRunOutput struct {
+	Requested interface{}            `json:"requested"`
+	Changes   []*dependencies.Change `json:"changes"`
+	CommitID  string                 `json:"commit_id"`
+	DryRun    bool                   `json:"dry_run"`
+}
+
+// stage solves the given build script as a commit on top of the old commit. The commit is not set as the local
+// commit.
+func stage(prime primeable, script *buildscript.BuildScript, oldCommit *buildplanner.Commit, commitMsg string) (*buildplanner.Commit, error) {
+	pj := prime.Project()
 	bp := buildplanner.NewBuildPlannerModel(prime.Auth(), prime.SvcModel())
 
 	var pg *output.Spinner
@@ -63,22 +175,22 @@ func UpdateAndReload(prime primeable, script *buildscript.BuildScript, oldCommit
 			pg.Stop(locale.T("progress_fail"))
 		}
 	}()
-	pg = output.StartSpinner(out, locale.T("progress_solve_preruntime"), constants.TerminalAnimationInterval)
+	pg = output.StartSpinner(prime.Output(), locale.T("progress_solve_preruntime"), constants.TerminalAnimationInterval)
 
 	if script.Dynamic() {
 		// Evaluate with dynamic imports first. Then commit.
 		err := bp.Evaluate(pj.Owner(), pj.Name(), script)
 		if err != nil {
-			return errs.Wrap(err, "Unable to dynamically evaluate build expression")
+			return nil, errs.Wrap(err, "Unable to dynamically evaluate build expression")
 		}
 		// StageCommitAndPoll needs to be called with "solve" node
 		if err := script.SetDynamic(false); err != nil {
-			return errs.Wrap(err, "Setting dynamic failed")
+			return nil, errs.Wrap(err, "Setting dynamic failed")
 		}
 		// StageCommitAndPoll needs to be called with atTime=now, not the previous script's atTime.
 		latest, err := model.FetchLatestRevisionTimeStamp(prime.Auth())
 		if err != nil {
-			return errs.Wrap(err, "Failed to fetch latest timestamp")
+			return nil, errs.Wrap(err, "Failed to fetch latest timestamp")
 		}
 		script.SetAtTime(latest, true)
 	}
@@ -94,48 +206,14 @@ func UpdateAndReload(prime primeable, script *buildscript.BuildScript, oldCommit
 	// Solve runtime
 	newCommit, err := bp.StageCommitAndPoll(commitParams)
 	if err != nil {
-		return errs.Wrap(err, "Could not stage commit")
+		return nil, errs.Wrap(err, "Could not stage commit")
 	}
 
 	// Stop process of creating the commit
 	pg.Stop(locale.T("progress_success"))
 	pg = nil
 
-	// Report changes and CVEs to user
-	dependencies.OutputChangeSummary(out, newCommit.BuildPlan(), oldCommit.BuildPlan())
-	if err := cves.NewCveReport(prime).Report(newCommit.BuildPlan(), oldCommit.BuildPlan()); err != nil {
-		return errs.Wrap(err, "Could not report CVEs")
-	}
-
-	// Start runtime sourcing UI
-	// Note normally we'd defer to Update's logic of async runtimes, but the reason we do this is to allow for solve
-	// errors to still be relayed even when using async. In this particular case the solving logic already happened
-	// when we created the commit, so running it again doesn't provide any value and only would slow things down.
-	if !cfg.GetBool(constants.AsyncRuntimeConfig) {
-		// refresh or install runtime
-		_, err := runtime_runbit.Update(prime, trigger,
-			runtime_runbit.WithCommit(newCommit),
-			runtime_runbit.WithoutBuildscriptValidation(),
-		)
-		if err != nil {
-			if !isBuildError(err) {
-				// If the error is not a build error we still want to update the commit
-				if err2 := updateCommitID(prime, newCommit.CommitID); err2 != nil {
-					return errs.Pack(err, locale.WrapError(err2, "err_package_update_commit_id"))
-				}
-			}
-			return errs.Wrap(err, "Failed to refresh runtime")
-		}
-	} else {
-		prime.Output().Notice("") // blank line
-		prime.Output().Notice(locale.Tr("notice_async_runtime", constants.AsyncRuntimeConfig))
-	}
-
-	// Update commit ID
-	if err := updateCommitID(prime, newCommit.CommitID); err != nil {
-		return locale.WrapError(err, "err_package_update_commit_id")
-	}
-	return nil
+	return newCommit, nil
 }
 
 func updateCommitID(prime primeable, commitID strfmt.UUID) error {
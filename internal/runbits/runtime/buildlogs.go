@@ -0,0 +1,93 @@
+package runtime_runbit
+
+import (
+	"sync"
+
+	"github.com/ActiveState/cli/internal/chanutils/workerpool"
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/logging"
+	configMediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/pkg/buildlogs"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+	"github.com/ActiveState/cli/pkg/runtime/events"
+	"github.com/go-openapi/strfmt"
+)
+
+// maxArchiveRequests is the number of build logs that are downloaded at the same time.
+const maxArchiveRequests = 8
+
+func init() {
+	configMediator.RegisterOption(constants.BuildLogArchiveConfig, configMediator.Bool, true)
+	configMediator.AllowInProject(constants.BuildLogArchiveConfig)
+}
+
+// logArchiver records the artifacts built during runtime setup, so that their build logs can be
+// added to the local build log archive once setup completes.
+type logArchiver struct {
+	commitID  strfmt.UUID
+	artifacts buildplan.ArtifactIDMap
+	mutex     sync.Mutex
+	entries   []buildlogs.Entry
+}
+
+func newLogArchiver(commitID strfmt.UUID, bp *buildplan.BuildPlan) *logArchiver {
+	return &logArchiver{commitID: commitID, artifacts: bp.Artifacts().ToIDMap()}
+}
+
+func (l *logArchiver) handle(event events.Event) error {
+	var (
+		id     strfmt.UUID
+		logURI string
+		status string
+		errMsg string
+	)
+	switch e := event.(type) {
+	case events.ArtifactBuildSuccess:
+		id, logURI, status = e.ArtifactID, e.LogURI, types.ArtifactSucceeded
+	case events.ArtifactBuildFailure:
+		id, logURI, status, errMsg = e.ArtifactID, e.LogURI, types.ArtifactFailedPermanently, e.ErrorMessage
+	default:
+		return nil
+	}
+
+	entry := buildlogs.Entry{ArtifactID: id, CommitID: l.commitID, LogURL: logURI, Status: status, ErrorMessage: errMsg}
+	if a, ok := l.artifacts[id]; ok {
+		entry.Name = a.Name()
+		entry.Version = a.Version()
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+// archive archives the logs of the artifacts that were built, or failed to build, during setup.
+// Artifacts that were not built in this run are left to `state artifacts logs`, which downloads and
+// archives their logs when asked for, so that setting up an already built runtime costs nothing.
+// Failing to archive a log must never fail runtime setup, so errors are only logged.
+func (l *logArchiver) archive() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	archive := buildlogs.New()
+	wp := workerpool.New(maxArchiveRequests)
+	for _, e := range l.entries {
+		entry := e
+		if entry.LogURL == "" {
+			continue
+		}
+		wp.Submit(func() error {
+			if err := archive.Fetch(entry); err != nil {
+				logging.Warning("Could not archive build log for %s: %s", entry.ArtifactID, errs.JoinMessage(err))
+			}
+			return nil
+		})
+	}
+	if err := wp.Wait(); err != nil {
+		logging.Warning("Could not archive build logs: %s", errs.JoinMessage(err))
+	}
+	logging.Debug("Archived the build logs of %d artifacts to %s", len(l.entries), archive.Dir())
+}
@@ -0,0 +1,276 @@
+package progress
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
+	configMediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/runtime/events"
+)
+
+func init() {
+	configMediator.RegisterOption(constants.RuntimeProgressConfig, configMediator.String, "")
+}
+
+// NDJSONFormat is the only machine readable progress format, in which every runtime event is written as one JSON
+// object per line.
+const NDJSONFormat = "ndjson"
+
+// The kinds of targets NDJSON progress can be written to.
+const (
+	TargetStderr = "stderr"
+	TargetFd     = "fd"
+	TargetUnix   = "unix"
+	TargetFile   = "file"
+)
+
+// Target describes where machine readable progress is written to.
+type Target struct {
+	Kind    string
+	Address string
+}
+
+// ParseTarget parses a progress target of the form "ndjson" (which writes to stderr), "ndjson:fd:<number>",
+// "ndjson:unix:<socket path>" or "ndjson:file:<path>".
+func ParseTarget(v string) (*Target, error) {
+	if v == NDJSONFormat {
+		return &Target{TargetStderr, ""}, nil
+	}
+	format, rest, _ := strings.Cut(v, ":")
+	if format != NDJSONFormat {
+		return nil, invalidTargetError(v)
+	}
+
+	kind, address, _ := strings.Cut(rest, ":")
+	if address == "" {
+		return nil, invalidTargetError(v)
+	}
+	switch kind {
+	case TargetFd:
+		if n, err := strconv.Atoi(address); err != nil || n < 0 {
+			return nil, invalidTargetError(v)
+		}
+	case TargetUnix, TargetFile:
+	default:
+		return nil, invalidTargetError(v)
+	}
+
+	return &Target{kind, address}, nil
+}
+
+func invalidTargetError(v string) error {
+	return locale.NewInputError("err_progress_target", "Invalid progress target: [ACTIONABLE]{{.V0}}[/RESET]. Valid targets are [ACTIONABLE]ndjson[/RESET] (written to stderr), [ACTIONABLE]ndjson:fd:<number>[/RESET], [ACTIONABLE]ndjson:unix:<socket path>[/RESET] and [ACTIONABLE]ndjson:file:<path>[/RESET].", v)
+}
+
+// Open opens the target for writing.
+func (t *Target) Open() (io.WriteCloser, error) {
+	switch t.Kind {
+	case TargetStderr:
+		return nopCloser{os.Stderr}, nil
+	case TargetFd:
+		fd, _ := strconv.Atoi(t.Address)
+		return openFd(fd)
+	case TargetUnix:
+		conn, err := net.Dial("unix", t.Address)
+		if err != nil {
+			return nil, locale.WrapInputError(err, "err_progress_unix", "Could not connect to the progress socket at [ACTIONABLE]{{.V0}}[/RESET].", t.Address)
+		}
+		return conn, nil
+	case TargetFile:
+		f, err := os.OpenFile(t.Address, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, locale.WrapInputError(err, "err_progress_file", "Could not open the progress file at [ACTIONABLE]{{.V0}}[/RESET].", t.Address)
+		}
+		return f, nil
+	}
+	return nil, errs.New("Unknown progress target kind: %s", t.Kind)
+}
+
+// fdFiles holds the files of the descriptors progress was written to. A descriptor belongs to whoever started the State
+// Tool, and a single command can set up several runtimes, so it is opened once per process and never closed.
+var (
+	fdFiles      = map[int]*os.File{}
+	fdFilesMutex sync.Mutex
+)
+
+func openFd(fd int) (io.WriteCloser, error) {
+	// The standard streams are still used by the State Tool itself.
+	switch fd {
+	case 1:
+		return nopCloser{os.Stdout}, nil
+	case 2:
+		return nopCloser{os.Stderr}, nil
+	}
+
+	fdFilesMutex.Lock()
+	defer fdFilesMutex.Unlock()
+	f, ok := fdFiles[fd]
+	if !ok {
+		f = os.NewFile(uintptr(fd), "progress")
+		if _, err := f.Stat(); err != nil {
+			return nil, locale.WrapInputError(err, "err_progress_fd", "File descriptor {{.V0}} is not valid.", strconv.Itoa(fd))
+		}
+		fdFiles[fd] = f
+	}
+	return nopCloser{f}, nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// NDJSONDigester writes every runtime event as a line of JSON, so that other tools can render their own progress.
+// Each line has the name of the event, the time it happened at, and the fields of the event in snake case. Download
+// and unpack progress events additionally carry the number of bytes processed so far and the total number of bytes.
+type NDJSONDigester struct {
+	w io.WriteCloser
+
+	// Sizes of the artifacts being downloaded or unpacked, keyed by artifactStepID
+	totals    map[artifactStepID]int
+	processed map[artifactStepID]int
+
+	// Whether writing failed, in which case we stop writing but let the runtime setup carry on
+	failed bool
+
+	// We use a mutex because events are fired from multiple goroutines.
+	mutex sync.Mutex
+}
+
+// NewNDJSONProgressIndicator returns a digester writing to the given progress target.
+func NewNDJSONProgressIndicator(target string) (*NDJSONDigester, error) {
+	t, err := ParseTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	w, err := t.Open()
+	if err != nil {
+		return nil, err
+	}
+	return newNDJSONDigester(w), nil
+}
+
+func newNDJSONDigester(w io.WriteCloser) *NDJSONDigester {
+	return &NDJSONDigester{
+		w:         w,
+		totals:    map[artifactStepID]int{},
+		processed: map[artifactStepID]int{},
+	}
+}
+
+func (d *NDJSONDigester) Handle(ev events.Event) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.failed {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(ev))
+	record := map[string]interface{}{
+		"event": v.Type().Name(),
+		"time":  time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	for i := 0; i < v.NumField(); i++ {
+		record[snakeCase(v.Type().Field(i).Name)] = jsonValue(v.Field(i).Interface())
+	}
+	d.addByteCounts(ev, record)
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return errs.Wrap(err, "Could not marshal %s event", v.Type().Name())
+	}
+	if _, err := d.w.Write(append(b, '\n')); err != nil {
+		// Whoever was reading the progress went away, which should not stop the runtime from being set up.
+		logging.Warning("Could not write progress event, no longer writing progress: %v", err)
+		d.failed = true
+	}
+
+	return nil
+}
+
+func (d *NDJSONDigester) addByteCounts(ev events.Event, record map[string]interface{}) {
+	var id artifactStepID
+	switch v := ev.(type) {
+	case events.ArtifactDownloadStarted:
+		d.totals[artifactStep{v.ArtifactID, StepDownload}.ID()] = v.TotalSize
+		return
+	case events.ArtifactUnpackStarted:
+		d.totals[artifactStep{v.ArtifactID, StepUnpack}.ID()] = v.TotalSize
+		return
+	case events.ArtifactDownloadProgress:
+		id = artifactStep{v.ArtifactID, StepDownload}.ID()
+		d.processed[id] += v.IncrementBySize
+	case events.ArtifactUnpackProgress:
+		id = artifactStep{v.ArtifactID, StepUnpack}.ID()
+		d.processed[id] += v.IncrementBySize
+	default:
+		return
+	}
+	record["bytes_processed"] = d.processed[id]
+	record["total_size"] = d.totals[id]
+}
+
+func (d *NDJSONDigester) Close() error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.w.Close()
+}
+
+type artifactRecord struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// jsonValue converts event fields that do not marshal to something meaningful on their own.
+func jsonValue(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case error:
+		return errs.JoinMessage(vv)
+	case buildplan.ArtifactIDMap:
+		artifacts := make([]artifactRecord, 0, len(vv))
+		for id, a := range vv {
+			record := artifactRecord{ID: id.String()}
+			if a != nil {
+				record.Name, record.Version = a.Name(), a.Version()
+			}
+			artifacts = append(artifacts, record)
+		}
+		sort.Slice(artifacts, func(i, j int) bool { return artifacts[i].ID < artifacts[j].ID })
+		return artifacts
+	}
+	return v
+}
+
+// snakeCase converts Go field names to snake case, keeping acronyms together (eg. ArtifactID becomes artifact_id).
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,119 @@
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/runtime/events"
+)
+
+func TestParseTarget(t *testing.T) {
+	valid := map[string]Target{
+		"ndjson":                 {TargetStderr, ""},
+		"ndjson:fd:3":            {TargetFd, "3"},
+		"ndjson:unix:/tmp/sock":  {TargetUnix, "/tmp/sock"},
+		"ndjson:file:C:\\p.json": {TargetFile, "C:\\p.json"},
+	}
+	for v, expected := range valid {
+		target, err := ParseTarget(v)
+		require.NoError(t, err, v)
+		assert.Equal(t, expected, *target, v)
+	}
+
+	for _, v := range []string{"", "json", "ndjson:", "ndjson:fd:", "ndjson:fd:x", "ndjson:fd:-1", "ndjson:tcp:localhost:80"} {
+		_, err := ParseTarget(v)
+		assert.Error(t, err, v)
+	}
+}
+
+func TestTargetOpen_StdStreams(t *testing.T) {
+	for _, fd := range []string{"1", "2"} {
+		w, err := (&Target{TargetFd, fd}).Open()
+		require.NoError(t, err, fd)
+		require.NoError(t, w.Close(), fd)
+	}
+	_, err := os.Stdout.Stat()
+	assert.NoError(t, err, "stdout must not be closed")
+	_, err = os.Stderr.Stat()
+	assert.NoError(t, err, "stderr must not be closed")
+}
+
+func TestTargetOpen_Fd(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer r.Close()
+	defer w.Close()
+	target := &Target{TargetFd, strconv.Itoa(int(w.Fd()))}
+
+	for _, line := range []string{"first\n", "second\n"} {
+		wc, err := target.Open()
+		require.NoError(t, err)
+		_, err = wc.Write([]byte(line))
+		require.NoError(t, err, "the descriptor must still be open after an earlier target was closed")
+		require.NoError(t, wc.Close())
+	}
+
+	buf := make([]byte, 64)
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\n", string(buf[:n]))
+}
+
+func TestNDJSONDigester(t *testing.T) {
+	id := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	buf := &bytes.Buffer{}
+	d := newNDJSONDigester(nopCloser{buf})
+
+	evs := []events.Event{
+		events.Start{ArtifactsToDownload: buildplan.ArtifactIDMap{id: nil}},
+		events.ArtifactDownloadStarted{ArtifactID: id, TotalSize: 100},
+		events.ArtifactDownloadProgress{ArtifactID: id, IncrementBySize: 40},
+		events.ArtifactDownloadProgress{ArtifactID: id, IncrementBySize: 60},
+		events.ArtifactInstallFailure{ArtifactID: id, Error: errors.New("disk full")},
+	}
+	for _, ev := range evs {
+		require.NoError(t, d.Handle(ev))
+	}
+	require.NoError(t, d.Close())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, len(evs))
+	records := make([]map[string]interface{}, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &records[i]), line)
+		assert.NotEmpty(t, records[i]["time"])
+	}
+
+	assert.Equal(t, "Start", records[0]["event"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": id.String(), "name": ""}}, records[0]["artifacts_to_download"])
+	assert.Equal(t, "ArtifactDownloadStarted", records[1]["event"])
+	assert.Equal(t, id.String(), records[1]["artifact_id"])
+	assert.Equal(t, float64(100), records[1]["total_size"])
+	assert.Equal(t, float64(40), records[2]["increment_by_size"])
+	assert.Equal(t, float64(40), records[2]["bytes_processed"])
+	assert.Equal(t, float64(100), records[3]["bytes_processed"])
+	assert.Equal(t, float64(100), records[3]["total_size"])
+	assert.Equal(t, "disk full", records[4]["error"])
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{
+		"ArtifactID":      "artifact_id",
+		"IncrementBySize": "increment_by_size",
+		"LogURI":          "log_uri",
+		"ProgressUrl":     "progress_url",
+		"Error":           "error",
+	} {
+		assert.Equal(t, expected, snakeCase(name))
+	}
+}
@@ -252,14 +252,31 @@ func Update(
 		multilog.Error("Unable to determine if runtime is in use: %v", errs.JoinMessage(err))
 	}
 
-	pg := progress.NewRuntimeProgressIndicator(prime.Output())
+	// Machine readable progress replaces the progress we show the user, as whoever asked for it renders their own.
+	var pg events.Handler
+	if target := prime.Config().GetString(constants.RuntimeProgressConfig); target != "" {
+		pg, err = progress.NewNDJSONProgressIndicator(target)
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not open progress target")
+		}
+	} else {
+		pg = progress.NewRuntimeProgressIndicator(prime.Output())
+	}
 	defer rtutils.Closer(pg.Close, &rerr)
 
 	skipped := &skipReporter{}
 
+	handlers := []events.HandlerFunc{pg.Handle, ah.handle, skipped.handle}
+	if prime.Config().GetBool(constants.BuildLogArchiveConfig) {
+		// Archive the logs of anything built for us, including failed builds.
+		archiver := newLogArchiver(commitID, buildPlan)
+		defer archiver.archive()
+		handlers = append(handlers, archiver.handle)
+	}
+
 	rtOpts := []runtime.SetOpt{
 		runtime.WithAnnotations(proj.Owner(), proj.Name(), commitID),
-		runtime.WithEventHandlers(pg.Handle, ah.handle, skipped.handle),
+		runtime.WithEventHandlers(handlers...),
 		runtime.WithPreferredLibcVersion(prime.Config().GetString(constants.PreferredGlibcVersionConfig)),
 		runtime.WithAuthToken(prime.Auth().BearerToken()),
 	}
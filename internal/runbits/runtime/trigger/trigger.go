@@ -29,6 +29,7 @@ const (
 	TriggerUse       Trigger = "use"
 	TriggerInstall   Trigger = "install"
 	TriggerUninstall Trigger = "uninstall"
+	TriggerSecurity  Trigger = "security-fix"
 )
 
 func NewExecTrigger(cmd string) Trigger {
@@ -40,6 +40,9 @@ type Params struct {
 	CommitID  string
 	Target    string
 	Full      bool
+	Diff      bool
+	DiffFrom  string
+	DiffTo    string
 }
 
 type Configurable interface {
@@ -118,6 +121,13 @@ func (b *Artifacts) Run(params *Params) (rerr error) {
 		b.out.Notice(locale.Tr("operating_message", b.project.NamespaceString(), b.project.Dir()))
 	}
 
+	if params.Diff {
+		return b.runDiff(params)
+	}
+	if params.DiffFrom != "" {
+		return locale.NewInputError("err_artifacts_diff_flag", "Commits can only be given when comparing artifacts, use '[ACTIONABLE]--diff[/RESET]' to compare the artifacts of two commits.")
+	}
+
 	bp, err := buildplanner_runbit.GetBuildPlan(
 		params.Namespace, params.CommitID, params.Target, b.prime)
 	if err != nil {
@@ -0,0 +1,350 @@
+package artifacts
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ActiveState/cli/internal/chanutils/workerpool"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/retryhttp"
+	buildplanner_runbit "github.com/ActiveState/cli/internal/runbits/buildplanner"
+	"github.com/ActiveState/cli/internal/strutils"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+	"github.com/go-openapi/strfmt"
+)
+
+// maxSizeRequests is the number of concurrent requests made to determine artifact download sizes.
+const maxSizeRequests = 8
+
+const (
+	statusSucceeded = "succeeded"
+	statusFailed    = "failed"
+	statusSkipped   = "skipped"
+	statusBuilding  = "building"
+)
+
+type diffOutput struct {
+	From      string          `json:"from"`
+	To        string          `json:"to"`
+	Changes   []*artifactDiff `json:"changes"`
+	SizeDelta *int64          `json:"sizeDelta,omitempty"` // nil if the size of any changed artifact is unknown
+}
+
+func (o *diffOutput) MarshalStructured(output.Format) interface{} {
+	return o
+}
+
+type artifactDiff struct {
+	Change          string   `json:"change"`
+	Name            string   `json:"name"`
+	ID              string   `json:"id"`
+	OldID           string   `json:"oldId,omitempty"`
+	Version         string   `json:"version,omitempty"`
+	OldVersion      string   `json:"oldVersion,omitempty"`
+	Status          string   `json:"status"`
+	OldStatus       string   `json:"oldStatus,omitempty"`
+	Size            *int64   `json:"size,omitempty"`
+	OldSize         *int64   `json:"oldSize,omitempty"`
+	SizeDelta       *int64   `json:"sizeDelta,omitempty"`
+	Licenses        []string `json:"licenses,omitempty"`
+	LicensesAdded   []string `json:"licensesAdded,omitempty"`
+	LicensesRemoved []string `json:"licensesRemoved,omitempty"`
+}
+
+func (b *Artifacts) runDiff(params *Params) error {
+	if params.DiffFrom == "" {
+		return locale.NewInputError("err_artifacts_diff_commits", "Please specify the commit to compare from, eg. '[ACTIONABLE]state artifacts --diff <commitA> <commitB>[/RESET]'.")
+	}
+
+	from, err := buildplanner_runbit.GetCommit(params.Namespace, params.DiffFrom, params.Target, b.prime)
+	if err != nil {
+		return errs.Wrap(err, "Could not get commit %s", params.DiffFrom)
+	}
+	// If no second commit is given this resolves to the local (or latest remote) commit.
+	to, err := buildplanner_runbit.GetCommit(params.Namespace, params.DiffTo, params.Target, b.prime)
+	if err != nil {
+		return errs.Wrap(err, "Could not get commit %s", params.DiffTo)
+	}
+
+	var changeset buildplan.ArtifactChangeset
+	for _, change := range to.BuildPlan().DiffArtifacts(from.BuildPlan(), false) {
+		if change.Artifact.MimeType == types.XActiveStateBuilderMimeType {
+			continue
+		}
+		changeset = append(changeset, change)
+	}
+
+	sizes := fetchArtifactSizes(changeset)
+	out := newDiffOutput(from.CommitID, to.CommitID, changeset, sizes)
+
+	if b.out.Type().IsStructured() {
+		b.out.Print(out)
+		return nil
+	}
+
+	b.outputDiffPlain(out)
+	return nil
+}
+
+// newDiffOutput summarizes the given changeset. sizes holds the download size of each artifact
+// whose size is known.
+func newDiffOutput(from, to strfmt.UUID, changeset buildplan.ArtifactChangeset, sizes map[strfmt.UUID]int64) *diffOutput {
+	out := &diffOutput{From: from.String(), To: to.String(), Changes: []*artifactDiff{}}
+	var total int64
+	sizesKnown := true
+	for _, change := range changeset {
+		diff := newArtifactDiff(change, sizes)
+		out.Changes = append(out.Changes, diff)
+		if diff.SizeDelta == nil {
+			sizesKnown = false
+			continue
+		}
+		total += *diff.SizeDelta
+	}
+	if sizesKnown {
+		out.SizeDelta = &total
+	}
+	return out
+}
+
+func newArtifactDiff(change buildplan.ArtifactChange, sizes map[strfmt.UUID]int64) *artifactDiff {
+	artifact := change.Artifact
+	diff := &artifactDiff{
+		Change:  change.ChangeType.String(),
+		Name:    artifact.Name(),
+		ID:      artifact.ArtifactID.String(),
+		Version: artifact.Version(),
+		Status:  artifactStatus(artifact),
+		Size:    lookupSize(sizes, artifact),
+	}
+
+	switch change.ChangeType {
+	case buildplan.ArtifactAdded:
+		diff.Licenses = uniqueLicenses(artifact)
+		diff.SizeDelta = diff.Size
+	case buildplan.ArtifactRemoved:
+		diff.Licenses = uniqueLicenses(artifact)
+		if diff.Size != nil {
+			delta := -*diff.Size
+			diff.SizeDelta = &delta
+		}
+	case buildplan.ArtifactUpdated:
+		old := change.Old
+		diff.OldID = old.ArtifactID.String()
+		diff.OldVersion = old.Version()
+		diff.OldStatus = artifactStatus(old)
+		diff.OldSize = lookupSize(sizes, old)
+		if diff.Size != nil && diff.OldSize != nil {
+			delta := *diff.Size - *diff.OldSize
+			diff.SizeDelta = &delta
+		}
+		diff.Licenses = uniqueLicenses(artifact)
+		diff.LicensesAdded, diff.LicensesRemoved = diffLicenses(uniqueLicenses(old), diff.Licenses)
+	}
+
+	return diff
+}
+
+func lookupSize(sizes map[strfmt.UUID]int64, artifact *buildplan.Artifact) *int64 {
+	size, ok := sizes[artifact.ArtifactID]
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+func artifactStatus(artifact *buildplan.Artifact) string {
+	switch {
+	case artifact.Status == types.ArtifactFailedPermanently || artifact.Status == types.ArtifactFailedTransiently:
+		return statusFailed
+	case artifact.Status == types.ArtifactSkipped:
+		return statusSkipped
+	case artifact.Status == types.ArtifactSucceeded && artifact.URL != "":
+		return statusSucceeded
+	}
+	return statusBuilding
+}
+
+// uniqueLicenses returns the sorted, de-duplicated licenses of all ingredients of the artifact.
+func uniqueLicenses(artifact *buildplan.Artifact) []string {
+	seen := map[string]struct{}{}
+	var licenses []string
+	for _, license := range artifact.Licenses() {
+		if _, ok := seen[license]; ok || license == "" {
+			continue
+		}
+		seen[license] = struct{}{}
+		licenses = append(licenses, license)
+	}
+	sort.Strings(licenses)
+	return licenses
+}
+
+// diffLicenses returns the licenses only in new, and the licenses only in old. Both inputs must be sorted.
+func diffLicenses(old, new []string) (added, removed []string) {
+	for _, license := range new {
+		if i := sort.SearchStrings(old, license); i == len(old) || old[i] != license {
+			added = append(added, license)
+		}
+	}
+	for _, license := range old {
+		if i := sort.SearchStrings(new, license); i == len(new) || new[i] != license {
+			removed = append(removed, license)
+		}
+	}
+	return added, removed
+}
+
+// fetchArtifactSizes determines the download size of all artifacts in the changeset. The buildplan
+// does not carry artifact sizes, so this relies on the Content-Length reported for the artifact URL.
+// Artifacts that have not been built, or whose size could not be determined, are omitted.
+func fetchArtifactSizes(changeset buildplan.ArtifactChangeset) map[strfmt.UUID]int64 {
+	var artifacts []*buildplan.Artifact
+	for _, change := range changeset {
+		artifacts = append(artifacts, change.Artifact)
+		if change.Old != nil {
+			artifacts = append(artifacts, change.Old)
+		}
+	}
+
+	sizes := map[strfmt.UUID]int64{}
+	mutex := sync.Mutex{}
+	wp := workerpool.New(maxSizeRequests)
+	for _, a := range artifacts {
+		if a.URL == "" {
+			continue
+		}
+		artifact := a
+		wp.Submit(func() error {
+			size, err := fetchArtifactSize(artifact.URL)
+			if err != nil {
+				logging.Debug("Could not determine size of artifact %s: %v", artifact.ArtifactID, errs.JoinMessage(err))
+				return nil
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			sizes[artifact.ArtifactID] = size
+			return nil
+		})
+	}
+	if err := wp.Wait(); err != nil {
+		logging.Debug("Could not determine artifact sizes: %v", errs.JoinMessage(err))
+	}
+	return sizes
+}
+
+func fetchArtifactSize(url string) (int64, error) {
+	resp, err := retryhttp.DefaultClient.Head(url)
+	if err != nil {
+		return 0, errs.Wrap(err, "Could not request artifact")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, errs.New("Unexpected status: %s", resp.Status)
+	}
+	if resp.ContentLength < 0 {
+		return 0, errs.New("No content length reported")
+	}
+	return resp.ContentLength, nil
+}
+
+func (b *Artifacts) outputDiffPlain(out *diffOutput) {
+	b.out.Print(locale.Tl("artifacts_diff_header", "Artifact changes from [ACTIONABLE]{{.V0}}[/RESET] to [ACTIONABLE]{{.V1}}[/RESET]:", out.From, out.To))
+	if len(out.Changes) == 0 {
+		b.out.Print(fmt.Sprintf("  • %s", locale.Tl("artifacts_diff_no_changes", "No changes")))
+		return
+	}
+
+	for _, diff := range out.Changes {
+		var title string
+		switch diff.Change {
+		case buildplan.ArtifactAdded.String():
+			title = fmt.Sprintf("[SUCCESS]+[/RESET] %s", nameWithVersion(diff.Name, diff.Version))
+		case buildplan.ArtifactRemoved.String():
+			title = fmt.Sprintf("[ERROR]-[/RESET] %s", nameWithVersion(diff.Name, diff.Version))
+		default:
+			version := ""
+			if diff.OldVersion != diff.Version {
+				version = fmt.Sprintf("@%s → %s", orUnknown(diff.OldVersion), orUnknown(diff.Version))
+			}
+			title = fmt.Sprintf("[NOTICE]~[/RESET] %s%s", diff.Name, version)
+		}
+		b.out.Print(fmt.Sprintf("  %s (%s)", title, formatStatus(diff)))
+
+		if size := formatSizeChange(diff); size != "" {
+			b.out.Print(fmt.Sprintf("      %s: %s", locale.Tl("artifacts_diff_size", "Size"), size))
+		}
+		switch {
+		case len(diff.LicensesAdded) > 0 || len(diff.LicensesRemoved) > 0:
+			var licenses []string
+			for _, l := range diff.LicensesAdded {
+				licenses = append(licenses, "[SUCCESS]+"+l+"[/RESET]")
+			}
+			for _, l := range diff.LicensesRemoved {
+				licenses = append(licenses, "[ERROR]-"+l+"[/RESET]")
+			}
+			b.out.Print(fmt.Sprintf("      %s: %s", locale.Tl("artifacts_diff_licenses", "Licenses"), strings.Join(licenses, ", ")))
+		case diff.Change != buildplan.ArtifactUpdated.String() && len(diff.Licenses) > 0:
+			b.out.Print(fmt.Sprintf("      %s: %s", locale.Tl("artifacts_diff_licenses", "Licenses"), strings.Join(diff.Licenses, ", ")))
+		}
+	}
+
+	if out.SizeDelta != nil {
+		b.out.Print("") // blank line
+		b.out.Print(locale.Tl("artifacts_diff_total_size", "Total download size change: {{.V0}}", formatSizeDelta(*out.SizeDelta)))
+	}
+}
+
+func nameWithVersion(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return name + "@" + version
+}
+
+func orUnknown(version string) string {
+	if version == "" {
+		return "?"
+	}
+	return version
+}
+
+func formatStatus(diff *artifactDiff) string {
+	colorize := func(status string) string {
+		switch status {
+		case statusFailed:
+			return "[ERROR]" + status + "[/RESET]"
+		case statusBuilding:
+			return "[WARNING]" + status + "[/RESET]"
+		}
+		return status
+	}
+	if diff.OldStatus != "" && diff.OldStatus != diff.Status {
+		return colorize(diff.OldStatus) + " → " + colorize(diff.Status)
+	}
+	return colorize(diff.Status)
+}
+
+func formatSizeChange(diff *artifactDiff) string {
+	switch {
+	case diff.SizeDelta == nil:
+		return ""
+	case diff.OldSize != nil:
+		return fmt.Sprintf("%s → %s (%s)", strutils.FormatSize(*diff.OldSize), strutils.FormatSize(*diff.Size), formatSizeDelta(*diff.SizeDelta))
+	}
+	return formatSizeDelta(*diff.SizeDelta)
+}
+
+func formatSizeDelta(delta int64) string {
+	if delta < 0 {
+		return "-" + strutils.FormatSize(-delta)
+	}
+	return "+" + strutils.FormatSize(delta)
+}
@@ -0,0 +1,75 @@
+package artifacts
+
+import (
+	"testing"
+
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/buildplan/raw"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestArtifact(id, name, version, status string, licenses ...string) *buildplan.Artifact {
+	return &buildplan.Artifact{
+		ArtifactID: strfmt.UUID(id),
+		Status:     status,
+		URL:        "https://example.com/" + id,
+		Ingredients: []*buildplan.Ingredient{{
+			IngredientSource: &raw.IngredientSource{Name: name, Version: version, Licenses: licenses},
+		}},
+	}
+}
+
+func TestNewDiffOutput(t *testing.T) {
+	added := newTestArtifact("00000000-0000-0000-0000-000000000001", "six", "1.16.0", types.ArtifactSucceeded, "MIT")
+	removed := newTestArtifact("00000000-0000-0000-0000-000000000002", "zlib", "1.3", types.ArtifactSucceeded, "Zlib")
+	oldReq := newTestArtifact("00000000-0000-0000-0000-000000000003", "requests", "2.31.0", types.ArtifactSucceeded, "Apache-2.0")
+	newReq := newTestArtifact("00000000-0000-0000-0000-000000000004", "requests", "2.32.0", types.ArtifactFailedPermanently, "Apache-2.0", "MIT", "MIT")
+
+	changeset := buildplan.ArtifactChangeset{
+		{ChangeType: buildplan.ArtifactAdded, Artifact: added},
+		{ChangeType: buildplan.ArtifactRemoved, Artifact: removed},
+		{ChangeType: buildplan.ArtifactUpdated, Artifact: newReq, Old: oldReq},
+	}
+	sizes := map[strfmt.UUID]int64{
+		added.ArtifactID:   100,
+		removed.ArtifactID: 40,
+		oldReq.ArtifactID:  1000,
+		newReq.ArtifactID:  1500,
+	}
+
+	out := newDiffOutput("from", "to", changeset, sizes)
+	require.Len(t, out.Changes, 3)
+	require.NotNil(t, out.SizeDelta)
+	assert.Equal(t, int64(100-40+500), *out.SizeDelta)
+
+	assert.Equal(t, "added", out.Changes[0].Change)
+	assert.Equal(t, []string{"MIT"}, out.Changes[0].Licenses)
+	assert.Equal(t, int64(100), *out.Changes[0].SizeDelta)
+
+	assert.Equal(t, "removed", out.Changes[1].Change)
+	assert.Equal(t, int64(-40), *out.Changes[1].SizeDelta)
+
+	updated := out.Changes[2]
+	assert.Equal(t, "2.31.0", updated.OldVersion)
+	assert.Equal(t, "2.32.0", updated.Version)
+	assert.Equal(t, statusSucceeded, updated.OldStatus)
+	assert.Equal(t, statusFailed, updated.Status)
+	assert.Equal(t, int64(500), *updated.SizeDelta)
+	assert.Equal(t, []string{"MIT"}, updated.LicensesAdded)
+	assert.Empty(t, updated.LicensesRemoved)
+
+	// An unknown size makes the total unknown.
+	delete(sizes, oldReq.ArtifactID)
+	out = newDiffOutput("from", "to", changeset, sizes)
+	assert.Nil(t, out.SizeDelta)
+	assert.Nil(t, out.Changes[2].SizeDelta)
+	assert.Equal(t, int64(1500), *out.Changes[2].Size)
+}
+
+func TestFormatSizeDelta(t *testing.T) {
+	assert.Equal(t, "-1.0 KiB", formatSizeDelta(-1024))
+	assert.Equal(t, "+0 B", formatSizeDelta(0))
+}
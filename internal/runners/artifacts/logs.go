@@ -0,0 +1,283 @@
+package artifacts
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/analytics"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/output"
+	buildplanner_runbit "github.com/ActiveState/cli/internal/runbits/buildplanner"
+	"github.com/ActiveState/cli/pkg/buildlogs"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/localcommit"
+	"github.com/ActiveState/cli/pkg/platform/authentication"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/go-openapi/strfmt"
+)
+
+type LogsParams struct {
+	Name      string
+	Namespace *project.Namespaced
+	CommitID  string
+	Target    string
+	Grep      string
+	Failed    bool
+}
+
+type Logs struct {
+	prime     primeable
+	out       output.Outputer
+	project   *project.Project
+	analytics analytics.Dispatcher
+	auth      *authentication.Auth
+	archive   *buildlogs.Archive
+}
+
+func NewLogs(prime primeable) *Logs {
+	return &Logs{
+		prime:     prime,
+		out:       prime.Output(),
+		project:   prime.Project(),
+		analytics: prime.Analytics(),
+		auth:      prime.Auth(),
+		archive:   buildlogs.New(),
+	}
+}
+
+type logsOutput struct {
+	Logs []*artifactLog `json:"logs"`
+}
+
+func (o *logsOutput) MarshalStructured(output.Format) interface{} {
+	return o
+}
+
+type artifactLog struct {
+	ArtifactID   string            `json:"artifactID"`
+	CommitID     string            `json:"commitID"`
+	Name         string            `json:"name"`
+	Version      string            `json:"version,omitempty"`
+	Status       string            `json:"status"`
+	ErrorMessage string            `json:"errorMessage,omitempty"`
+	LogURL       string            `json:"logURL,omitempty"`
+	Archived     bool              `json:"archived"` // false if the log had to be downloaded
+	Lines        []string          `json:"lines,omitempty"`
+	Matches      []buildlogs.Match `json:"matches,omitempty"`
+	entry        buildlogs.Entry
+}
+
+func (l *Logs) Run(params *LogsParams) (rerr error) {
+	defer rationalizeArtifactsError(l.project, l.auth, &rerr)
+
+	if params.Name == "" && !params.Failed {
+		return locale.NewInputError("err_artifacts_logs_name_required", "Please specify the artifact to show logs for, or use '[ACTIONABLE]--failed[/RESET]' to show logs of all failed artifacts.")
+	}
+
+	var pattern *regexp.Regexp
+	if params.Grep != "" {
+		var err error
+		pattern, err = regexp.Compile(params.Grep)
+		if err != nil {
+			return locale.WrapInputError(err, "err_artifacts_logs_grep", "Invalid search pattern '[ACTIONABLE]{{.V0}}[/RESET]': {{.V1}}", params.Grep, err.Error())
+		}
+	}
+
+	if l.project != nil && !params.Namespace.IsValid() {
+		l.out.Notice(locale.Tr("operating_message", l.project.NamespaceString(), l.project.Dir()))
+	}
+
+	logs, err := l.fromArchive(params)
+	if err != nil {
+		return errs.Wrap(err, "Could not read build log archive")
+	}
+	if len(logs) == 0 {
+		logs, err = l.fromBuildPlan(params)
+		if err != nil {
+			return errs.Wrap(err, "Could not get build logs")
+		}
+	}
+
+	if len(logs) == 0 {
+		if params.Failed && params.Name == "" {
+			l.out.Notice(locale.Tl("artifacts_logs_no_failures", "No failed artifacts were found."))
+			return nil
+		}
+		return locale.NewInputError("err_artifacts_logs_not_found", "Could not find an artifact matching '[ACTIONABLE]{{.V0}}[/RESET]'.", params.Name)
+	}
+
+	for _, log := range logs {
+		lines, err := l.archive.Lines(log.entry)
+		if err != nil {
+			return errs.Wrap(err, "Could not read build log for %s", log.Name)
+		}
+		if pattern != nil {
+			log.Matches = buildlogs.Grep(lines, pattern)
+		} else {
+			log.Lines = lines
+		}
+	}
+
+	if l.out.Type().IsStructured() {
+		l.out.Print(&logsOutput{logs})
+		return nil
+	}
+
+	l.outputPlain(logs, pattern != nil)
+	return nil
+}
+
+// fromArchive returns the archived logs matching params for the local commit, without contacting
+// the platform. It returns nothing if the commit cannot be determined locally.
+func (l *Logs) fromArchive(params *LogsParams) ([]*artifactLog, error) {
+	commitID := strfmt.UUID(params.CommitID)
+	if commitID == "" {
+		if params.Namespace.IsValid() || l.project == nil {
+			return nil, nil
+		}
+		var err error
+		commitID, err = localcommit.Get(l.project.Dir())
+		if err != nil {
+			logging.Debug("Could not determine local commit, not using archive: %v", errs.JoinMessage(err))
+			return nil, nil
+		}
+	}
+
+	entries, err := l.archive.ForCommit(commitID)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read archive")
+	}
+
+	var logs []*artifactLog
+	for _, entry := range entries {
+		if params.Failed && !entry.Failed() {
+			continue
+		}
+		if params.Name != "" && !matchesArtifact(params.Name, entry.ArtifactID, entry.Name, entry.Version) {
+			continue
+		}
+		logs = append(logs, newArtifactLog(entry, true))
+	}
+	sortLogs(logs)
+	return logs, nil
+}
+
+// fromBuildPlan resolves the artifacts matching params from the commit's buildplan, and returns
+// their logs from the archive, downloading and archiving any logs that are not archived yet.
+func (l *Logs) fromBuildPlan(params *LogsParams) ([]*artifactLog, error) {
+	commit, err := buildplanner_runbit.GetCommit(params.Namespace, params.CommitID, params.Target, l.prime)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not get commit")
+	}
+
+	var filters []buildplan.FilterArtifact
+	if params.Failed {
+		filters = append(filters, buildplan.FilterFailedArtifacts())
+	}
+
+	var logs []*artifactLog
+	for _, artifact := range commit.BuildPlan().Artifacts(filters...) {
+		if params.Name != "" && !matchesArtifact(params.Name, artifact.ArtifactID, artifact.Name(), artifact.Version()) {
+			continue
+		}
+
+		entry := buildlogs.NewEntry(commit.CommitID, artifact)
+		archived, err := l.archive.Lookup(commit.CommitID, artifact.ArtifactID)
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not look up archived log")
+		}
+		switch {
+		case archived != nil && archived.CommitID == commit.CommitID:
+			logs = append(logs, newArtifactLog(*archived, true))
+			continue
+		case archived != nil:
+			// The same artifact was archived for another commit; index it for this commit too.
+			if err := l.archive.Index(entry); err != nil {
+				return nil, errs.Wrap(err, "Could not index archived log")
+			}
+			logs = append(logs, newArtifactLog(entry, true))
+			continue
+		}
+
+		if entry.LogURL == "" {
+			if params.Name == "" {
+				continue // not built yet, or built without a log
+			}
+			return nil, locale.NewInputError("err_artifacts_logs_no_log", "Artifact '[ACTIONABLE]{{.V0}}[/RESET]' does not have a build log.", artifact.NameAndVersion())
+		}
+		if err := l.archive.Fetch(entry); err != nil {
+			return nil, locale.WrapExternalError(err, "err_artifacts_logs_download", "Could not download the build log for '[ACTIONABLE]{{.V0}}[/RESET]'.", artifact.NameAndVersion())
+		}
+		logs = append(logs, newArtifactLog(entry, false))
+	}
+	sortLogs(logs)
+	return logs, nil
+}
+
+func newArtifactLog(entry buildlogs.Entry, archived bool) *artifactLog {
+	return &artifactLog{
+		ArtifactID:   entry.ArtifactID.String(),
+		CommitID:     entry.CommitID.String(),
+		Name:         entry.Name,
+		Version:      entry.Version,
+		Status:       entry.Status,
+		ErrorMessage: entry.ErrorMessage,
+		LogURL:       entry.LogURL,
+		Archived:     archived,
+		entry:        entry,
+	}
+}
+
+// matchesArtifact returns whether query identifies the given artifact, either by (a prefix of)
+// its ID, its name, or its name and version.
+func matchesArtifact(query string, id strfmt.UUID, name, version string) bool {
+	query = strings.ToLower(query)
+	if len(query) >= 8 && strings.HasPrefix(strings.ToLower(id.String()), query) {
+		return true
+	}
+	name = strings.ToLower(name)
+	return query == name || (version != "" && query == name+"@"+strings.ToLower(version))
+}
+
+func sortLogs(logs []*artifactLog) {
+	sort.Slice(logs, func(i, j int) bool {
+		return strings.ToLower(logs[i].Name) < strings.ToLower(logs[j].Name)
+	})
+}
+
+func (l *Logs) outputPlain(logs []*artifactLog, grep bool) {
+	for i, log := range logs {
+		if i > 0 {
+			l.out.Print("") // blank line
+		}
+		name := log.Name
+		if log.Version != "" {
+			name = fmt.Sprintf("%s@%s", name, log.Version)
+		}
+		status := locale.Tl("artifacts_logs_status_succeeded", "succeeded")
+		if log.entry.Failed() {
+			status = fmt.Sprintf("[ERROR]%s[/RESET]", locale.T("artifact_status_failed"))
+		}
+		l.out.Print(fmt.Sprintf("• [NOTICE]%s[/RESET] (%s, ID: [ACTIONABLE]%s[/RESET])", name, status, strings.ToUpper(log.ArtifactID[0:8])))
+		if log.ErrorMessage != "" {
+			l.out.Print(fmt.Sprintf("  %s: [ERROR]%s[/RESET]", locale.T("artifact_status_failed_message"), log.ErrorMessage))
+		}
+
+		if grep {
+			if len(log.Matches) == 0 {
+				l.out.Print(locale.Tl("artifacts_logs_no_matches", "  No matching lines"))
+			}
+			for _, m := range log.Matches {
+				l.out.Print(fmt.Sprintf("  [DISABLED]%d:[/RESET] %s", m.Line, m.Text))
+			}
+			continue
+		}
+		for _, line := range log.Lines {
+			l.out.Print(line)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/ActiveState/cli/internal/runbits/cves"
 	"github.com/ActiveState/cli/internal/runbits/dependencies"
 	"github.com/ActiveState/cli/internal/runbits/git"
+	"github.com/ActiveState/cli/internal/runbits/licenses"
 	"github.com/ActiveState/cli/internal/runbits/runtime"
 	"github.com/ActiveState/cli/internal/runbits/runtime/trigger"
 	"github.com/ActiveState/cli/internal/subshell"
@@ -191,6 +192,10 @@ func (u *Checkout) Run(params *Params) (rerr error) {
 		return errs.Wrap(err, "Could not report CVEs")
 	}
 
+	if err := licenses.NewLicenseReport(u.prime).Report(buildPlan, nil); err != nil {
+		return errs.Wrap(err, "Could not report license policy violations")
+	}
+
 	rti, err := runtime_runbit.Update(u.prime, trigger.TriggerCheckout, rtOpts...)
 	if err != nil {
 		return errs.Wrap(err, "Could not setup runtime")
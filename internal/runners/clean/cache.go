@@ -72,7 +72,7 @@ func (c *Cache) Run(params *CacheParams) error {
 
 func (c *Cache) removeCache(path string) error {
 	defaultValue := !c.prime.Prompt().IsInteractive()
-	ok, err := c.prime.Prompt().Confirm(locale.T("confirm"), locale.T("clean_cache_confirm"), &defaultValue, ptr.To(true))
+	ok, err := c.prime.Prompt().Confirm(prompt.IDCleanCache, locale.T("confirm"), locale.T("clean_cache_confirm"), &defaultValue, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
@@ -99,7 +99,7 @@ func (c *Cache) removeCache(path string) error {
 
 func (c *Cache) removeProjectCache(projectDir, namespace string) error {
 	defaultValue := !c.prime.Prompt().IsInteractive()
-	ok, err := c.prime.Prompt().Confirm(locale.T("confirm"), locale.Tr("clean_cache_artifact_confirm", namespace), &defaultValue, ptr.To(true))
+	ok, err := c.prime.Prompt().Confirm(prompt.IDCleanCacheArtifact, locale.T("confirm"), locale.Tr("clean_cache_artifact_confirm", namespace), &defaultValue, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
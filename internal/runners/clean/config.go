@@ -54,7 +54,7 @@ func (c *Config) Run(params *ConfigParams) error {
 	}
 
 	defaultChoice := !c.confirm.IsInteractive()
-	ok, err := c.confirm.Confirm(locale.T("confirm"), locale.T("clean_config_confirm"), &defaultChoice, ptr.To(true))
+	ok, err := c.confirm.Confirm(prompt.IDCleanConfig, locale.T("confirm"), locale.T("clean_config_confirm"), &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
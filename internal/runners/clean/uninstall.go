@@ -70,7 +70,7 @@ func (u *Uninstall) Run(params *UninstallParams) error {
 			locale.Tl("uninstall_prompt", "Uninstall the State Tool, but keep runtime cache and configuration files"),
 			locale.Tl("uninstall_prompt_all", "Completely uninstall the State Tool, including runtime cache and configuration files"),
 		}
-		selection, err := u.prompt.Select("", "", choices, ptr.To(""), nil)
+		selection, err := u.prompt.Select(prompt.IDCleanUninstallSelect, "", "", choices, ptr.To(""), nil)
 		if err != nil {
 			return locale.WrapError(err, "err_uninstall_prompt", "Could not read uninstall option")
 		}
@@ -83,7 +83,7 @@ func (u *Uninstall) Run(params *UninstallParams) error {
 		if params.All {
 			confirmMessage = locale.T("uninstall_confirm_all")
 		}
-		ok, err := u.prompt.Confirm(locale.T("confirm"), confirmMessage, &defaultChoice, ptr.To(true))
+		ok, err := u.prompt.Confirm(prompt.IDCleanUninstall, locale.T("confirm"), confirmMessage, &defaultChoice, ptr.To(true))
 		if err != nil {
 			return errs.Wrap(err, "Not confirmed")
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/ActiveState/cli/internal/runbits/commits_runbit"
 	"github.com/ActiveState/cli/internal/runbits/cves"
 	"github.com/ActiveState/cli/internal/runbits/dependencies"
+	"github.com/ActiveState/cli/internal/runbits/licenses"
 	"github.com/ActiveState/cli/internal/runbits/rationalize"
 	"github.com/ActiveState/cli/pkg/localcommit"
 	bpResp "github.com/ActiveState/cli/pkg/platform/api/buildplanner/response"
@@ -174,6 +175,9 @@ func (c *Commit) Run(params *Params) (rerr error) {
 		if err := cves.NewCveReport(c.prime).Report(rtCommit.BuildPlan(), oldCommit.BuildPlan()); err != nil {
 			return errs.Wrap(err, "Could not report CVEs")
 		}
+		if err := licenses.NewLicenseReport(c.prime).Report(rtCommit.BuildPlan(), oldCommit.BuildPlan()); err != nil {
+			return errs.Wrap(err, "Could not report license policy violations")
+		}
 	}
 
 	// Update local commit ID
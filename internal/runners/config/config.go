@@ -29,19 +29,21 @@ func NewList(prime primeable) (*List, error) {
 	}, nil
 }
 
-// Where an option's effective value comes from.
-const (
-	sourceEnvironment = "environment" // overridden by an environment variable
-	sourceLocal       = "local"       // set locally by the user (stored in config.db)
-	sourceDefault     = "default"     // neither set nor overridden; using the built-in default
-)
+type ListParams struct {
+	ShowOrigin bool
+}
 
 type structuredConfigData struct {
 	Key     string      `json:"key"`
 	Value   interface{} `json:"value"`
 	Default interface{} `json:"default"`
-	// Source is where the effective value comes from: "environment", "local", or "default".
+	// Source is the config layer the effective value comes from, e.g. "environment", "project",
+	// "local", "system" or "default".
 	Source string `json:"source"`
+	// Origin is the file or environment variable the effective value is read from, if any.
+	Origin string `json:"origin,omitempty"`
+	// Locked reports whether the value is locked by the system config.
+	Locked bool `json:"locked,omitempty"`
 	// EnvVar is the canonical environment variable that can override this key (always present).
 	EnvVar string `json:"envVar"`
 	// Env is the name of the environment variable currently overriding this value, if any.
@@ -49,29 +51,7 @@ type structuredConfigData struct {
 	opt mediator.Option
 }
 
-// effectiveValue returns the value the State Tool will actually use for the option, along with the
-// name of the environment variable overriding it (empty when the value comes from stored config or
-// the built-in default).
-func effectiveValue(cfg *config.Instance, opt mediator.Option) (interface{}, string) {
-	if envValue, envVar, ok := mediator.EnvOverride(opt); ok {
-		return envValue, envVar
-	}
-	return cfg.Get(opt.Name), ""
-}
-
-// configSource reports where an option's effective value comes from, and the name of the
-// environment variable in effect when the source is the environment.
-func configSource(cfg *config.Instance, opt mediator.Option) (source string, envVar string) {
-	if _, name, ok := mediator.EnvOverride(opt); ok {
-		return sourceEnvironment, name
-	}
-	if cfg.IsSet(opt.Name) {
-		return sourceLocal, ""
-	}
-	return sourceDefault, ""
-}
-
-func (c *List) Run() error {
+func (c *List) Run(params ListParams) error {
 	registered := mediator.Registered()
 
 	cfg := c.prime.Config()
@@ -79,23 +59,27 @@ func (c *List) Run() error {
 
 	var data []structuredConfigData
 	for _, opt := range registered {
-		configuredValue, envVar := effectiveValue(cfg, opt)
-		source, _ := configSource(cfg, opt)
-		data = append(data, structuredConfigData{
+		value, origin := cfg.Lookup(opt.Name)
+		d := structuredConfigData{
 			Key:     opt.Name,
-			Value:   configuredValue,
+			Value:   value,
 			Default: mediator.GetDefault(opt),
-			Source:  source,
+			Source:  string(origin.Layer),
+			Origin:  origin.Location,
+			Locked:  origin.Locked,
 			EnvVar:  mediator.CanonicalEnvVarName(opt.Name),
-			Env:     envVar,
 			opt:     opt,
-		})
+		}
+		if origin.Layer == config.LayerEnvironment {
+			d.Env = origin.Location
+		}
+		data = append(data, d)
 	}
 
 	if out.Type().IsStructured() {
 		out.Print(output.Structured(data))
 	} else {
-		if err := c.renderUserFacing(data); err != nil {
+		if err := c.renderUserFacing(data, params.ShowOrigin); err != nil {
 			return err
 		}
 	}
@@ -103,19 +87,26 @@ func (c *List) Run() error {
 	return nil
 }
 
-func (c *List) renderUserFacing(configData []structuredConfigData) error {
-	cfg := c.prime.Config()
+func (c *List) renderUserFacing(configData []structuredConfigData, showOrigin bool) error {
 	out := c.prime.Output()
 
-	tbl := table.New(locale.Ts("key", "value", "source", "default"))
+	headers := locale.Ts("key", "value", "source", "default")
+	if showOrigin {
+		headers = append(headers, locale.Tl("config_origin", "Origin"))
+	}
+	tbl := table.New(headers)
 	tbl.HideDash = true
 	for _, config := range configData {
-		tbl.AddRow([]string{
+		row := []string{
 			fmt.Sprintf("[CYAN]%s[/RESET]", config.Key),
-			renderConfigValue(cfg, config.opt),
-			renderConfigSource(cfg, config.opt),
+			renderConfigValue(config.opt, config.Value),
+			renderConfigSource(config),
 			fmt.Sprintf("[DISABLED]%s[/RESET]", formatValue(config.opt, config.Default)),
-		})
+		}
+		if showOrigin {
+			row = append(row, config.Origin)
+		}
+		tbl.AddRow(row)
 	}
 
 	out.Print(tbl.Render())
@@ -126,8 +117,7 @@ func (c *List) renderUserFacing(configData []structuredConfigData) error {
 	return nil
 }
 
-func renderConfigValue(cfg *config.Instance, opt mediator.Option) string {
-	configured, _ := effectiveValue(cfg, opt)
+func renderConfigValue(opt mediator.Option, configured interface{}) string {
 	var tags []string
 	if opt.Type == mediator.Bool {
 		if configured == true {
@@ -146,17 +136,22 @@ func renderConfigValue(cfg *config.Instance, opt mediator.Option) string {
 }
 
 // renderConfigSource renders the Source column: the environment variable in effect (when overridden
-// by the environment), "local" (set by the user), or a de-emphasized "default".
-func renderConfigSource(cfg *config.Instance, opt mediator.Option) string {
-	source, envVar := configSource(cfg, opt)
-	switch source {
-	case sourceEnvironment:
-		return fmt.Sprintf("[BOLD]%s[/RESET]", envVar)
-	case sourceLocal:
-		return fmt.Sprintf("[BOLD]%s[/RESET]", locale.Tl("config_source_local", "local"))
+// by the environment), the config layer the value comes from, or a de-emphasized "default". Values
+// locked by the system config are marked as such.
+func renderConfigSource(data structuredConfigData) string {
+	var source string
+	switch config.Layer(data.Source) {
+	case config.LayerEnvironment:
+		source = fmt.Sprintf("[BOLD]%s[/RESET]", data.Env)
+	case config.LayerDefault:
+		source = fmt.Sprintf("[DISABLED]%s[/RESET]", locale.Tl("config_source_default", "default"))
 	default:
-		return fmt.Sprintf("[DISABLED]%s[/RESET]", locale.Tl("config_source_default", "default"))
+		source = fmt.Sprintf("[BOLD]%s[/RESET]", data.Source)
+	}
+	if data.Locked {
+		source += " " + locale.Tl("config_source_locked", "(locked)")
 	}
+	return source
 }
 
 func formatValue(opt mediator.Option, value interface{}) string {
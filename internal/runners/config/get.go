@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/ActiveState/cli/internal/config"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/output"
@@ -14,7 +16,8 @@ type Get struct {
 }
 
 type GetParams struct {
-	Key Key
+	Key        Key
+	ShowOrigin bool
 }
 
 func NewGet(prime primeable) *Get {
@@ -23,7 +26,7 @@ func NewGet(prime primeable) *Get {
 
 func (g *Get) Run(params GetParams) error {
 	key := params.Key.String()
-	value := g.cfg.Get(key)
+	value, origin := g.cfg.Lookup(key)
 	if value == nil {
 		return locale.NewInputError("err_config_not_found", "No config value for key: {{.V0}}", key)
 	}
@@ -33,15 +36,47 @@ func (g *Get) Run(params GetParams) error {
 		return locale.WrapError(err, "err_config_get_event", "Could not retrieve config value. If this continues to happen please contact support.")
 	}
 
+	if !params.ShowOrigin {
+		g.out.Print(output.Prepare(
+			value,
+			&struct {
+				Name  string      `json:"name"`
+				Value interface{} `json:"value"`
+			}{
+				key,
+				value,
+			},
+		))
+		return nil
+	}
+
 	g.out.Print(output.Prepare(
-		value,
+		fmt.Sprintf("%v\t[DISABLED]%s[/RESET]", value, formatOrigin(origin)),
 		&struct {
-			Name  string      `json:"name"`
-			Value interface{} `json:"value"`
+			Name   string      `json:"name"`
+			Value  interface{} `json:"value"`
+			Source string      `json:"source"`
+			Origin string      `json:"origin,omitempty"`
+			Locked bool        `json:"locked,omitempty"`
 		}{
 			key,
 			value,
+			string(origin.Layer),
+			origin.Location,
+			origin.Locked,
 		},
 	))
 	return nil
 }
+
+// formatOrigin describes the origin of a config value, e.g. "local: /home/user/.config/activestate/config.db".
+func formatOrigin(origin config.Origin) string {
+	result := string(origin.Layer)
+	if origin.Location != "" {
+		result += ": " + origin.Location
+	}
+	if origin.Locked {
+		result += " " + locale.Tl("config_source_locked", "(locked)")
+	}
+	return result
+}
@@ -42,6 +42,9 @@ func (s *Set) Run(params SetParams) error {
 	if !configMediator.KnownOption(option) {
 		return locale.NewInputError("unknown_config_key", "Unknown config key: {{.V0}}", params.Key.String())
 	}
+	if s.cfg.IsLocked(params.Key.String()) {
+		return locale.NewInputError("err_config_set_locked", "The config key '{{.V0}}' is locked by your system administrator and cannot be changed.", params.Key.String())
+	}
 	switch option.Type {
 	case configMediator.Bool:
 		var err error
@@ -103,6 +106,12 @@ func (s *Set) Run(params SetParams) error {
 			params.Value,
 		},
 	))
+
+	// Let the user know if the value they set is shadowed by a higher precedence config layer.
+	switch _, origin := s.cfg.Lookup(key); origin.Layer {
+	case config.LayerOverride, config.LayerEnvironment, config.LayerProject:
+		s.out.Notice(locale.Tl("config_set_shadowed", "[WARNING]Note:[/RESET] This value is currently overridden by the {{.V0}} config layer. Run '[ACTIONABLE]state config get {{.V1}} --show-origin[/RESET]' for details.", string(origin.Layer), key))
+	}
 	return nil
 }
 
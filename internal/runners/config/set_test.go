@@ -1,13 +1,18 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ActiveState/cli/internal/analytics/client/blackhole"
 	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/constants"
 	configMediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/internal/rtutils/singlethread"
 	"github.com/ActiveState/cli/internal/testhelpers/outputhelper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSetUnknownKey(t *testing.T) {
@@ -52,3 +57,19 @@ func TestDefaultKey(t *testing.T) {
 	assert.Nil(t, cfg.Get("quux"))
 	assert.False(t, cfg.IsSet("quux"))
 }
+
+func TestSetLockedKey(t *testing.T) {
+	dir := t.TempDir()
+	systemPath := filepath.Join(dir, constants.SystemConfigFileName)
+	require.NoError(t, os.WriteFile(systemPath, []byte("locked: [test.locked]"), 0644))
+	t.Setenv(constants.SystemConfigEnvVarName, systemPath)
+
+	cfg, err := config.NewCustom(filepath.Join(dir, "appdata"), singlethread.New(), true)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, cfg.Close()) }()
+
+	configMediator.RegisterOption("test.locked", configMediator.Bool, false)
+	set := Set{outputhelper.NewCatcher(), cfg, nil, blackhole.New()}
+	assert.Error(t, set.Run(SetParams{"test.locked", "true"}))
+	assert.False(t, cfg.IsSet("test.locked"))
+}
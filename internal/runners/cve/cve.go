@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/constants"
 	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/output"
@@ -15,21 +17,30 @@ import (
 	"github.com/ActiveState/cli/internal/runbits/rationalize"
 	"github.com/ActiveState/cli/pkg/localcommit"
 	medmodel "github.com/ActiveState/cli/pkg/platform/api/mediator/model"
+	"github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/request"
 	"github.com/ActiveState/cli/pkg/platform/authentication"
 	"github.com/ActiveState/cli/pkg/platform/model"
 	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/runtime"
+	"github.com/ActiveState/cli/pkg/runtime_helpers"
+	"github.com/ActiveState/cli/pkg/vulndb"
 )
 
 type primeable interface {
 	primer.Projecter
 	primer.Auther
 	primer.Outputer
+	primer.Configurer
+	primer.Prompter
+	primer.SvcModeler
+	primer.Analyticer
 }
 
 type Cve struct {
 	proj *project.Project
 	auth *authentication.Auth
 	out  output.Outputer
+	cfg  *config.Instance
 }
 
 type CveInfo struct {
@@ -39,11 +50,12 @@ type CveInfo struct {
 }
 
 func NewCve(prime primeable) *Cve {
-	return &Cve{prime.Project(), prime.Auth(), prime.Output()}
+	return &Cve{prime.Project(), prime.Auth(), prime.Output(), prime.Config()}
 }
 
 type Params struct {
 	Namespace *project.Namespaced
+	Offline   bool
 }
 
 type cveData struct {
@@ -64,20 +76,32 @@ func (r *Cve) Run(params *Params) error {
 		return rationalize.ErrNoProject
 	}
 
-	if !r.auth.Authenticated() {
+	offline := params.Offline || r.cfg.GetBool(constants.SecurityOfflineConfig)
+
+	if !r.auth.Authenticated() && !offline {
 		return errs.AddTips(
 			locale.NewInputError("cve_needs_authentication"),
 			locale.T("auth_tip"),
 		)
 	}
 
-	vulnerabilities, err := r.fetchVulnerabilities(params.Namespace)
-	if err != nil {
-		var errProjectNotFound *model.ErrProjectNotFound
-		if errors.As(err, &errProjectNotFound) {
-			return locale.WrapExternalError(err, "cve_mediator_resp_not_found", "That project was not found")
+	var vulnerabilities *medmodel.CommitVulnerabilities
+	if offline {
+		var err error
+		vulnerabilities, err = r.fetchOfflineVulnerabilities(params.Namespace)
+		if err != nil {
+			return errs.Wrap(err, "Could not check vulnerabilities offline")
+		}
+	} else {
+		var err error
+		vulnerabilities, err = r.fetchVulnerabilities(params.Namespace)
+		if err != nil {
+			var errProjectNotFound *model.ErrProjectNotFound
+			if errors.As(err, &errProjectNotFound) {
+				return locale.WrapExternalError(err, "cve_mediator_resp_not_found", "That project was not found")
+			}
+			return locale.WrapError(err, "cve_mediator_resp", "Failed to retrieve vulnerability information")
 		}
-		return locale.WrapError(err, "cve_mediator_resp", "Failed to retrieve vulnerability information")
 	}
 
 	packageVulnerabilities := model.ExtractPackageVulnerabilities(vulnerabilities.Sources)
@@ -129,6 +153,47 @@ func (r *Cve) fetchVulnerabilities(namespaceOverride *project.Namespaced) (*medm
 	return resp, nil
 }
 
+// fetchOfflineVulnerabilities checks the ingredients installed in the project's runtime against the
+// local vulnerability database. The commit's build plan is not available offline, so the project's
+// runtime has to be installed.
+func (r *Cve) fetchOfflineVulnerabilities(namespaceOverride *project.Namespaced) (*medmodel.CommitVulnerabilities, error) {
+	if namespaceOverride.IsValid() {
+		return nil, locale.NewInputError("err_cve_offline_namespace", "Offline vulnerability checks are only supported for the local project.")
+	}
+
+	db, err := vulndb.Open(vulndb.Dir(r.cfg))
+	if err != nil {
+		if errors.Is(err, vulndb.ErrNotSynced) {
+			return nil, locale.WrapInputError(err, "err_cve_offline_not_synced", "The local vulnerability database has not been synced. Run '[ACTIONABLE]state security sync[/RESET]' while online to sync it.")
+		}
+		return nil, errs.Wrap(err, "Could not open vulnerability database")
+	}
+
+	commitID, err := localcommit.Get(r.proj.Dir())
+	if err != nil {
+		return nil, errs.Wrap(err, "Unable to get local commit")
+	}
+
+	rt, err := runtime.New(runtime_helpers.TargetDirFromProject(r.proj))
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not initialize runtime")
+	}
+	if !rt.HasCache() {
+		return nil, locale.NewInputError("err_cve_offline_no_runtime", "Offline vulnerability checks require the project's runtime to be installed. Run '[ACTIONABLE]state refresh[/RESET]' while online to install it.")
+	}
+
+	var ingredients []*request.Ingredient
+	for _, ing := range rt.Ingredients() {
+		ingredients = append(ingredients, &request.Ingredient{
+			Namespace: ing.Namespace,
+			Name:      ing.Name,
+			Version:   ing.Version,
+		})
+	}
+
+	return db.CommitVulnerabilities(commitID.String(), ingredients), nil
+}
+
 type SeverityCountOutput struct {
 	Count    string `locale:"count,Count" json:"count"`
 	Severity string `locale:"severity,Severity" json:"severity"`
@@ -0,0 +1,538 @@
+package cve
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/prompt"
+	"github.com/ActiveState/cli/internal/rtutils/ptr"
+	buildscript_runbit "github.com/ActiveState/cli/internal/runbits/buildscript"
+	"github.com/ActiveState/cli/internal/runbits/dependencies"
+	"github.com/ActiveState/cli/internal/runbits/rationalize"
+	runtime_runbit "github.com/ActiveState/cli/internal/runbits/runtime"
+	"github.com/ActiveState/cli/internal/runbits/runtime/trigger"
+	"github.com/ActiveState/cli/internal/table"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/localcommit"
+	bpTypes "github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+	vulnModel "github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/model"
+	"github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/request"
+	"github.com/ActiveState/cli/pkg/platform/model"
+	bpModel "github.com/ActiveState/cli/pkg/platform/model/buildplanner"
+	"github.com/ActiveState/cli/pkg/vulndb"
+)
+
+// severities lists the vulnerability severities from most to least severe.
+var severities = []string{
+	vulnModel.SeverityCritical,
+	vulnModel.SeverityHigh,
+	vulnModel.SeverityMedium,
+	vulnModel.SeverityLow,
+}
+
+var ErrFixAborted = errors.New("aborted")
+
+type Fix struct {
+	prime primeable
+}
+
+type FixParams struct {
+	Severity string
+	DryRun   bool
+}
+
+func NewFix(prime primeable) *Fix {
+	return &Fix{prime}
+}
+
+// remediation describes the upgrade of a vulnerable ingredient to the version that fixes it. Requested
+// ingredients are pinned to that version, others are pinned by adding a requirement for them.
+type remediation struct {
+	Name            string   `json:"name"`
+	Namespace       string   `json:"namespace"`
+	Version         string   `json:"version"`
+	FixVersion      string   `json:"fixVersion,omitempty"`
+	NewerFixVersion string   `json:"newerFixVersion,omitempty"` // only available after the project's timestamp
+	ResolvedVersion string   `json:"resolvedVersion,omitempty"` // not resolved on dry runs`
+	Requested       bool     `json:"requested"`
+	CVEs            []string `json:"cves"`
+}
+
+type fixOutput struct {
+	Fixes     []*remediation `json:"fixes"`
+	Unfixable []*remediation `json:"unfixable,omitempty"`
+	Before    map[string]int `json:"vulnerabilitiesBefore"`
+	After     map[string]int `json:"vulnerabilitiesAfter"`
+	CommitID  string         `json:"commitID,omitempty"` // not staged on dry runs
+	DryRun    bool           `json:"dryRun"`
+}
+
+func (f *Fix) Run(params *FixParams) (rerr error) {
+	defer func() {
+		if errors.Is(rerr, ErrFixAborted) {
+			rerr = errs.WrapUserFacing(rerr, locale.Tl("cve_fix_aborted", "Vulnerability fixes were not applied."), errs.SetInput())
+		}
+	}()
+
+	proj := f.prime.Project()
+	if proj == nil {
+		return rationalize.ErrNoProject
+	}
+	if proj.IsHeadless() {
+		return rationalize.ErrHeadless
+	}
+
+	threshold := strings.ToLower(params.Severity)
+	if threshold == "" {
+		threshold = vulnModel.SeverityLow
+	}
+	if severityIndex(threshold) == -1 {
+		return locale.NewInputError("err_cve_fix_severity", "Invalid severity '[ACTIONABLE]{{.V0}}[/RESET]', must be one of: {{.V1}}.", params.Severity, strings.Join(severities, ", "))
+	}
+
+	offline := f.prime.Config().GetBool(constants.SecurityOfflineConfig)
+	if !f.prime.Auth().Authenticated() && !offline {
+		return errs.AddTips(
+			locale.NewInputError("cve_needs_authentication"),
+			locale.T("auth_tip"),
+		)
+	}
+
+	out := f.prime.Output()
+	out.Notice(locale.Tr("operating_message", proj.NamespaceString(), proj.Dir()))
+
+	pg := output.StartSpinner(out, locale.Tl("progress_cve_fix", "Searching for vulnerability fixes"), constants.TerminalAnimationInterval)
+	defer func() {
+		if pg != nil {
+			pg.Stop(locale.T("progress_fail"))
+		}
+	}()
+
+	localCommitID, err := localcommit.Get(proj.Dir())
+	if err != nil {
+		return errs.Wrap(err, "Failed to get local commit")
+	}
+	bpm := bpModel.NewBuildPlannerModel(f.prime.Auth(), f.prime.SvcModel())
+	oldCommit, err := bpm.FetchCommit(localCommitID, proj.Owner(), proj.Name(), nil)
+	if err != nil {
+		return errs.Wrap(err, "Failed to fetch build result")
+	}
+
+	before, err := f.fetchVulnerabilities(runtimeIngredients(oldCommit.BuildPlan()))
+	if err != nil {
+		return errs.Wrap(err, "Could not fetch vulnerabilities")
+	}
+
+	requested := map[string]bool{}
+	for _, ing := range oldCommit.BuildPlan().RequestedIngredients() {
+		requested[ing.Namespace+"/"+ing.Name] = true
+	}
+
+	script, err := oldCommit.BuildScript().Clone()
+	if err != nil {
+		return errs.Wrap(err, "Failed to clone build script")
+	}
+
+	var fixes, unfixable []*remediation
+	var latest *time.Time
+	for _, v := range before {
+		cves := cvesAtOrAbove(v.Vulnerabilities, threshold)
+		if len(cves) == 0 {
+			continue
+		}
+		r := &remediation{
+			Name:      v.Name,
+			Namespace: v.PrimaryNamespace,
+			Version:   v.Version,
+			Requested: requested[v.PrimaryNamespace+"/"+v.Name],
+			CVEs:      cves,
+		}
+
+		// Only versions available at the project's timestamp can be resolved without changing the
+		// timestamp, which would update unrelated packages as well.
+		r.FixVersion, err = f.findFixVersion(r, threshold, script.AtTime())
+		if err != nil {
+			return errs.Wrap(err, "Could not find fix for %s", r.Name)
+		}
+		if r.FixVersion == "" {
+			if latest == nil {
+				ts, err := model.FetchLatestRevisionTimeStamp(f.prime.Auth())
+				if err != nil {
+					return errs.Wrap(err, "Failed to fetch latest timestamp")
+				}
+				latest = &ts
+			}
+			r.NewerFixVersion, err = f.findFixVersion(r, threshold, latest)
+			if err != nil {
+				return errs.Wrap(err, "Could not find fix for %s", r.Name)
+			}
+		}
+
+		if r.FixVersion == "" {
+			unfixable = append(unfixable, r)
+			continue
+		}
+		fixes = append(fixes, r)
+
+		operation := bpTypes.OperationAdded
+		if r.Requested {
+			operation = bpTypes.OperationUpdated
+		}
+		err = script.UpdateRequirement(operation, bpTypes.Requirement{
+			Name:      r.Name,
+			Namespace: r.Namespace,
+			VersionRequirement: []bpTypes.VersionRequirement{{
+				bpTypes.VersionRequirementComparatorKey: bpTypes.ComparatorEQ,
+				bpTypes.VersionRequirementVersionKey:    r.FixVersion,
+			}},
+		})
+		if err != nil {
+			return errs.Wrap(err, "Could not update requirement %s", r.Name)
+		}
+	}
+
+	if len(fixes) == 0 {
+		pg.Stop(locale.T("progress_success"))
+		pg = nil
+		if len(unfixable) == 0 {
+			out.Print(output.Prepare(
+				locale.Tl("cve_fix_none", "[SUCCESS]✔ No vulnerabilities found at or above severity {{.V0}}.[/RESET]", threshold),
+				&fixOutput{Before: severityCounts(before), After: severityCounts(before), DryRun: params.DryRun},
+			))
+			return nil
+		}
+		f.outputUnfixable(unfixable)
+		return locale.NewInputError("err_cve_fix_no_fixes", "None of the vulnerable packages have a version that fixes their vulnerabilities.")
+	}
+
+	if params.DryRun {
+		// Solving the fixes requires staging a commit, so a dry run only reports the fixed versions.
+		after, err := f.fetchVulnerabilities(fixedIngredients(oldCommit.BuildPlan(), fixes))
+		if err != nil {
+			return errs.Wrap(err, "Could not fetch vulnerabilities")
+		}
+		pg.Stop(locale.T("progress_success"))
+		pg = nil
+
+		f.outputDryRun(&fixOutput{
+			Fixes:     fixes,
+			Unfixable: unfixable,
+			Before:    severityCounts(before),
+			After:     severityCounts(after),
+			DryRun:    true,
+		})
+		return nil
+	}
+
+	newCommit, err := bpm.StageCommitAndPoll(bpModel.StageCommitParams{
+		Owner:        proj.Owner(),
+		Project:      proj.Name(),
+		ParentCommit: localCommitID.String(),
+		Description:  locale.Tl("cve_fix_commit_message", "Fix vulnerabilities"),
+		Script:       script,
+	})
+	if err != nil {
+		return errs.Wrap(err, "Failed to stage commit")
+	}
+
+	after, err := f.fetchVulnerabilities(runtimeIngredients(newCommit.BuildPlan()))
+	if err != nil {
+		return errs.Wrap(err, "Could not fetch vulnerabilities")
+	}
+
+	pg.Stop(locale.T("progress_success"))
+	pg = nil
+
+	resolved := map[string]string{}
+	for _, ing := range runtimeIngredients(newCommit.BuildPlan()) {
+		resolved[ing.Namespace+"/"+ing.Name] = ing.Version
+	}
+	for _, r := range fixes {
+		r.ResolvedVersion = resolved[r.Namespace+"/"+r.Name]
+	}
+
+	result := &fixOutput{
+		Fixes:     fixes,
+		Unfixable: unfixable,
+		Before:    severityCounts(before),
+		After:     severityCounts(after),
+		CommitID:  newCommit.CommitID.String(),
+		DryRun:    params.DryRun,
+	}
+
+	if out.Type().IsStructured() {
+		// Structured output has no user to show the changes to, so it is only printed once the
+		// changes are confirmed.
+		if err := f.confirm(); err != nil {
+			return err
+		}
+		out.Print(output.Structured(result))
+	} else {
+		dependencies.OutputChangeSummary(out, newCommit.BuildPlan(), oldCommit.BuildPlan())
+		f.outputFixes(result)
+	}
+
+	if !out.Type().IsStructured() {
+		out.Notice(" ") // Empty line (prompts use Notice)
+		if err := f.confirm(); err != nil {
+			return err
+		}
+	}
+
+	if err := localcommit.Set(proj.Dir(), newCommit.CommitID.String()); err != nil {
+		return locale.WrapError(err, "err_package_update_commit_id")
+	}
+	if f.prime.Config().GetBool(constants.OptinBuildscriptsConfig) {
+		if err := buildscript_runbit.Update(proj, newCommit.BuildScript()); err != nil {
+			return locale.WrapError(err, "err_update_build_script")
+		}
+	}
+
+	if _, err := runtime_runbit.Update(f.prime, trigger.TriggerSecurity, runtime_runbit.WithCommit(newCommit), runtime_runbit.WithoutBuildscriptValidation()); err != nil {
+		return errs.Wrap(err, "Failed to refresh runtime")
+	}
+
+	out.Notice(locale.Tl("cve_fix_success", "Vulnerability fixes applied."))
+	return nil
+}
+
+// outputDryRun reports the given fixes without applying them.
+func (f *Fix) outputDryRun(result *fixOutput) {
+	out := f.prime.Output()
+	if out.Type().IsStructured() {
+		out.Print(output.Structured(result))
+		return
+	}
+	f.outputFixes(result)
+	out.Notice(locale.Tl("cve_fix_dry_run", "Dry run, the changes were not applied. Run without '[ACTIONABLE]--dry-run[/RESET]' to apply them."))
+}
+
+// confirm asks whether to apply the fixes. Fixes rewrite and commit the project, so without a user
+// present they are only applied when forced or answered by an answers file.
+func (f *Fix) confirm() error {
+	var defaultChoice *bool
+	if f.prime.Prompt().IsInteractive() {
+		defaultChoice = ptr.To(true)
+	}
+	confirm, err := f.prime.Prompt().Confirm(prompt.IDCVEFix, "", locale.Tl("cve_fix_confirm", "Apply these changes?"), defaultChoice, ptr.To(true))
+	if err != nil {
+		return errs.Wrap(err, "Not confirmed")
+	}
+	if !confirm {
+		return ErrFixAborted
+	}
+	return nil
+}
+
+// fetchVulnerabilities retrieves the vulnerabilities of the given ingredients from the platform, or
+// from the local vulnerability database if offline checking is enabled.
+func (f *Fix) fetchVulnerabilities(ingredients []*request.Ingredient) ([]*model.VulnerabilityIngredient, error) {
+	if !f.prime.Config().GetBool(constants.SecurityOfflineConfig) {
+		return model.FetchVulnerabilitiesForIngredients(f.prime.Auth(), ingredients)
+	}
+
+	db, err := vulndb.Open(vulndb.Dir(f.prime.Config()))
+	if err != nil {
+		if errors.Is(err, vulndb.ErrNotSynced) {
+			return nil, locale.WrapInputError(err, "err_cve_offline_not_synced", "The local vulnerability database has not been synced. Run '[ACTIONABLE]state security sync[/RESET]' while online to sync it.")
+		}
+		return nil, errs.Wrap(err, "Could not open vulnerability database")
+	}
+	return db.VulnerabilitiesForIngredients(ingredients), nil
+}
+
+// findFixVersion returns the lowest version of the given ingredient that is newer than its current
+// version, available at the given time, and has no vulnerabilities at or above the given severity.
+func (f *Fix) findFixVersion(r *remediation, threshold string, ts *time.Time) (string, error) {
+	results, err := model.SearchIngredientsStrict(r.Namespace, r.Name, true, true, ts, f.prime.Auth())
+	if err != nil {
+		return "", errs.Wrap(err, "Could not search ingredient versions")
+	}
+	var versions []string
+	for _, res := range results {
+		versions = append(versions, res.Version)
+	}
+
+	candidates := newerVersions(r.Version, versions)
+	if len(candidates) == 0 {
+		return "", nil
+	}
+
+	ingredients := make([]*request.Ingredient, len(candidates))
+	for i, v := range candidates {
+		ingredients[i] = &request.Ingredient{Namespace: r.Namespace, Name: r.Name, Version: v}
+	}
+	vulns, err := f.fetchVulnerabilities(ingredients)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not fetch vulnerabilities")
+	}
+	vulnerable := map[string]bool{}
+	for _, v := range vulns {
+		if len(cvesAtOrAbove(v.Vulnerabilities, threshold)) > 0 {
+			vulnerable[v.Version] = true
+		}
+	}
+
+	return lowestFixVersion(candidates, vulnerable), nil
+}
+
+func (f *Fix) outputFixes(result *fixOutput) {
+	out := f.prime.Output()
+
+	out.Notice("") // Empty line
+	tbl := table.New(locale.Ts("name", "version", "cve_fix_cves", "cve_fix_type"))
+	tbl.HideDash = true
+	for _, r := range result.Fixes {
+		kind := locale.Tl("cve_fix_type_pin", "pinned")
+		if r.Requested {
+			kind = locale.Tl("cve_fix_type_requested", "requested")
+		}
+		version := r.ResolvedVersion
+		if version == "" {
+			version = r.FixVersion
+		}
+		tbl.AddRow([]string{
+			r.Name,
+			locale.Tr("upgrade_field_change", r.Version, version),
+			strings.Join(r.CVEs, ", "),
+			kind,
+		})
+	}
+	out.Print(tbl.Render())
+
+	if len(result.Unfixable) > 0 {
+		f.outputUnfixable(result.Unfixable)
+	}
+
+	out.Notice("")
+	out.Notice(locale.Tl("cve_fix_delta", "Vulnerabilities: {{.V0}} → {{.V1}}", formatCounts(result.Before), formatCounts(result.After)))
+}
+
+func (f *Fix) outputUnfixable(unfixable []*remediation) {
+	out := f.prime.Output()
+	out.Notice("")
+	out.Notice(locale.Tl("cve_fix_unfixable", "[WARNING]No fixed version is available at the project's timestamp for:[/RESET]"))
+	newer := false
+	for _, r := range unfixable {
+		line := fmt.Sprintf("  • %s@%s: %s", r.Name, r.Version, strings.Join(r.CVEs, ", "))
+		if r.NewerFixVersion != "" {
+			line += " " + locale.Tl("cve_fix_newer", "(fixed in {{.V0}})", r.NewerFixVersion)
+			newer = true
+		}
+		out.Notice(line)
+	}
+	if newer {
+		out.Notice(locale.Tl("cve_fix_newer_tip", "Some fixes were released after the project's timestamp. Run '[ACTIONABLE]state upgrade[/RESET]' to update the project's timestamp, then run this command again."))
+	}
+}
+
+// runtimeIngredients returns the unique ingredients of the runtime artifacts of the given buildplan.
+func runtimeIngredients(bp *buildplan.BuildPlan) []*request.Ingredient {
+	var result []*request.Ingredient
+	seen := map[string]bool{}
+	for _, a := range bp.Artifacts(buildplan.FilterRuntimeArtifacts()) {
+		for _, ing := range a.Ingredients {
+			key := ing.Namespace + "/" + ing.Name + "@" + ing.Version
+			if seen[key] || ing.Namespace == buildplan.NamespaceInternal {
+				continue
+			}
+			seen[key] = true
+			result = append(result, &request.Ingredient{Namespace: ing.Namespace, Name: ing.Name, Version: ing.Version})
+		}
+	}
+	return result
+}
+
+// fixedIngredients returns the runtime ingredients of the given buildplan with the fixed versions
+// swapped in. Unlike solving the fixes, this does not account for dependencies that change with them.
+func fixedIngredients(bp *buildplan.BuildPlan, fixes []*remediation) []*request.Ingredient {
+	fixed := map[string]string{}
+	for _, r := range fixes {
+		fixed[r.Namespace+"/"+r.Name] = r.FixVersion
+	}
+	ingredients := runtimeIngredients(bp)
+	for _, ing := range ingredients {
+		if v, ok := fixed[ing.Namespace+"/"+ing.Name]; ok {
+			ing.Version = v
+		}
+	}
+	return ingredients
+}
+
+// newerVersions returns the versions that are newer than the current version, in ascending order.
+func newerVersions(current string, versions []string) []string {
+	var result []string
+	seen := map[string]bool{}
+	for _, v := range versions {
+		if seen[v] || vulndb.CompareVersions(v, current) <= 0 {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return vulndb.CompareVersions(result[i], result[j]) < 0
+	})
+	return result
+}
+
+// lowestFixVersion returns the first of the given ascending candidate versions that is not
+// vulnerable, or an empty string if all of them are.
+func lowestFixVersion(candidates []string, vulnerable map[string]bool) string {
+	for _, v := range candidates {
+		if !vulnerable[v] {
+			return v
+		}
+	}
+	return ""
+}
+
+func severityIndex(severity string) int {
+	for i, s := range severities {
+		if s == severity {
+			return i
+		}
+	}
+	return -1
+}
+
+// cvesAtOrAbove returns the vulnerabilities with the given severity or a more severe one.
+func cvesAtOrAbove(v *model.Vulnerabilities, severity string) []string {
+	levels := [][]string{v.Critical, v.High, v.Medium, v.Low}
+	var result []string
+	for i := 0; i <= severityIndex(severity); i++ {
+		result = append(result, levels[i]...)
+	}
+	return result
+}
+
+func severityCounts(vulns []*model.VulnerabilityIngredient) map[string]int {
+	counts := map[string]int{}
+	for _, v := range vulns {
+		for severity, count := range v.Vulnerabilities.Count() {
+			counts[severity] += count
+		}
+	}
+	return counts
+}
+
+func formatCounts(counts map[string]int) string {
+	var parts []string
+	for _, s := range severities {
+		if counts[s] > 0 {
+			parts = append(parts, strconv.Itoa(counts[s])+" "+s)
+		}
+	}
+	if len(parts) == 0 {
+		return "0"
+	}
+	return strings.Join(parts, ", ")
+}
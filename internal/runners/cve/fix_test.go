@@ -0,0 +1,33 @@
+package cve
+
+import (
+	"testing"
+
+	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewerVersions(t *testing.T) {
+	versions := []string{"2.0.0", "1.2.0", "1.10.0", "1.0.0", "1.2.0", "1.2.0rc1", "0.9"}
+	assert.Equal(t, []string{"1.2.0rc1", "1.2.0", "1.10.0", "2.0.0"}, newerVersions("1.1", versions))
+	assert.Empty(t, newerVersions("3.0", versions))
+}
+
+func TestLowestFixVersion(t *testing.T) {
+	candidates := []string{"1.2.0", "1.3.0", "2.0.0"}
+	assert.Equal(t, "1.3.0", lowestFixVersion(candidates, map[string]bool{"1.2.0": true}))
+	assert.Equal(t, "1.2.0", lowestFixVersion(candidates, map[string]bool{}))
+	assert.Equal(t, "", lowestFixVersion(candidates, map[string]bool{"1.2.0": true, "1.3.0": true, "2.0.0": true}))
+}
+
+func TestCvesAtOrAbove(t *testing.T) {
+	v := &model.Vulnerabilities{
+		Critical: []string{"CVE-1"},
+		High:     []string{"CVE-2"},
+		Medium:   []string{"CVE-3"},
+		Low:      []string{"CVE-4"},
+	}
+	assert.Equal(t, []string{"CVE-1"}, cvesAtOrAbove(v, "critical"))
+	assert.Equal(t, []string{"CVE-1", "CVE-2"}, cvesAtOrAbove(v, "high"))
+	assert.Equal(t, []string{"CVE-1", "CVE-2", "CVE-3", "CVE-4"}, cvesAtOrAbove(v, "low"))
+}
@@ -0,0 +1,62 @@
+package cve
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/pkg/vulndb"
+)
+
+type Sync struct {
+	out output.Outputer
+	cfg *config.Instance
+}
+
+type SyncParams struct {
+	Sources []string
+}
+
+func NewSync(prime primeable) *Sync {
+	return &Sync{prime.Output(), prime.Config()}
+}
+
+type syncOutput struct {
+	Path            string    `json:"path"`
+	Sources         []string  `json:"sources"`
+	Vulnerabilities int       `json:"vulnerabilities"`
+	SyncedAt        time.Time `json:"synced_at"`
+}
+
+func (s *Sync) Run(params *SyncParams) error {
+	sources := params.Sources
+	if len(sources) == 0 {
+		sources = vulndb.DefaultSources()
+	}
+
+	pg := output.StartSpinner(s.out, locale.Tl("progress_vulndb_sync", "Syncing vulnerability database"), constants.TerminalAnimationInterval)
+	db, err := vulndb.Sync(sources)
+	if err != nil {
+		pg.Stop(locale.T("progress_fail"))
+		return locale.WrapExternalError(err, "err_vulndb_sync", "Could not sync the vulnerability database.")
+	}
+
+	dir := vulndb.Dir(s.cfg)
+	if err := db.Save(dir); err != nil {
+		pg.Stop(locale.T("progress_fail"))
+		return errs.Wrap(err, "Could not save vulnerability database")
+	}
+	pg.Stop(locale.T("progress_success"))
+
+	s.out.Print(output.Prepare(
+		locale.Tl("vulndb_synced", "Synced [ACTIONABLE]{{.V0}}[/RESET] vulnerabilities to [ACTIONABLE]{{.V1}}[/RESET].\nRun '[ACTIONABLE]state security --offline[/RESET]' to check your project against them, or enable offline checks during installs with '[ACTIONABLE]state config set {{.V2}} true[/RESET]'.",
+			strconv.Itoa(len(db.Records)), dir, constants.SecurityOfflineConfig),
+		&syncOutput{dir, sources, len(db.Records), db.SyncedAt},
+	))
+
+	return nil
+}
@@ -64,7 +64,8 @@ type primeable interface {
 }
 
 type Params struct {
-	Path string
+	Path    string
+	Project string
 }
 
 func New(prime primeable) *Exec {
@@ -94,6 +95,17 @@ func (s *Exec) Run(params *Params, args ...string) (rerr error) {
 
 	trigger := trigger.NewExecTrigger(args[0])
 
+	if params.Project != "" {
+		if params.Path != "" {
+			return locale.NewInputError("err_exec_project_path", "The '[ACTIONABLE]--project[/RESET]' and '[ACTIONABLE]--path[/RESET]' flags cannot be combined.")
+		}
+		path, err := workspaceMemberPath(params.Project)
+		if err != nil {
+			return errs.Wrap(err, "Could not find workspace member")
+		}
+		params.Path = path
+	}
+
 	// Detect target and project dir
 	// If the path passed resolves to a runtime dir (ie. has a runtime marker) then the project is not used
 	var proj *project.Project
@@ -191,6 +203,19 @@ func (s *Exec) Run(params *Params, args ...string) (rerr error) {
 	return nil
 }
 
+// workspaceMemberPath returns the directory of the given member of the workspace the working directory is in.
+func workspaceMemberPath(member string) (string, error) {
+	wd, err := osutils.Getwd()
+	if err != nil {
+		return "", errs.Wrap(err, "Could not get working directory")
+	}
+	ws, err := projectfile.FindWorkspace(wd)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not find workspace")
+	}
+	return ws.FindMember(member)
+}
+
 func projectFromRuntimeDir(cfg projectfile.ConfigGetter, runtimeDir string) string {
 	projects := projectfile.GetProjectMapping(cfg)
 	for _, paths := range projects {
@@ -0,0 +1,33 @@
+# syntax=docker/dockerfile:1
+# Generated by `state export container` for {{.Namespace}} at commit {{.CommitID}}.
+#
+# Private projects need an API key, which is passed as a build secret:
+#   docker build --secret id=activestate_api_key,env=ACTIVESTATE_API_KEY .
+
+ARG BASE_IMAGE={{.BaseImage}}
+
+# The state stage installs the State Tool and the project's runtime. It is what the devcontainer is built from, so
+# that the State Tool is available for development.
+FROM ${BASE_IMAGE} AS state
+RUN apt-get update \
+    && apt-get install -y --no-install-recommends ca-certificates curl \
+    && rm -rf /var/lib/apt/lists/*
+RUN curl -fsSL https://platform.activestate.com/dl/cli/install.sh -o /tmp/install.sh \
+    && sh /tmp/install.sh -n -t {{.StatePath}} \
+    && rm /tmp/install.sh
+ENV PATH={{.StatePath}}/bin:$PATH
+RUN --mount=type=secret,id=activestate_api_key \
+    {{.APIKeyEnv}}="$(cat /run/secrets/activestate_api_key 2>/dev/null)" \
+    state checkout {{.Namespace}}#{{.CommitID}} {{.ProjectPath}} --runtime-path {{.RuntimePath}} --portable --no-clone -n
+WORKDIR {{.ProjectPath}}
+RUN state export env --format posix > {{.EnvPath}}
+
+# The runtime stage only holds the runtime, which is self-contained as it was checked out with --portable.
+FROM ${BASE_IMAGE} AS runtime
+COPY --from=state {{.RuntimePath}} {{.RuntimePath}}
+COPY --from=state {{.ProjectPath}} {{.ProjectPath}}
+COPY --from=state {{.EnvPath}} {{.EnvPath}}
+WORKDIR {{.ProjectPath}}
+ENV ENV={{.EnvPath}} BASH_ENV={{.EnvPath}}
+ENTRYPOINT ["/bin/sh", "-c", ". {{.EnvPath}} && exec \"$@\"", "--"]
+CMD ["/bin/sh"]
@@ -0,0 +1,154 @@
+// Package container generates the files needed to run a project's runtime in a container: a Dockerfile, a
+// devcontainer.json referencing it, and a VS Code tasks.json exposing the project's scripts inside it.
+package container
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runbits/rationalize"
+	"github.com/ActiveState/cli/internal/strutils"
+	"github.com/ActiveState/cli/pkg/localcommit"
+	"github.com/ActiveState/cli/pkg/project"
+)
+
+const (
+	Dockerfile   = "dockerfile"
+	Devcontainer = "devcontainer"
+	VSCodeTasks  = "vscode-tasks"
+)
+
+// Formats lists the supported formats.
+var Formats = []string{Dockerfile, Devcontainer, VSCodeTasks}
+
+var ErrUnknownFormat = errs.New("unknown format")
+
+//go:embed Dockerfile.tpl
+var dockerfileTpl string
+
+//go:embed devcontainer.json.tpl
+var devcontainerTpl string
+
+//go:embed tasks.json.tpl
+var tasksTpl string
+
+// Script is a script of the project, which the tasks.json exposes as a task.
+type Script struct {
+	Name        string
+	Description string
+}
+
+// Data holds everything the templates need to know about the project.
+type Data struct {
+	Name        string
+	Namespace   string
+	CommitID    string
+	Scripts     []Script
+	BaseImage   string
+	StatePath   string
+	ProjectPath string
+	RuntimePath string
+	EnvPath     string
+	APIKeyEnv   string
+}
+
+// NewData returns the template data for the given project at the given commit, using the default paths within the
+// container.
+func NewData(namespace, name, commitID string, scripts []Script) *Data {
+	return &Data{
+		Name:        name,
+		Namespace:   namespace,
+		CommitID:    commitID,
+		Scripts:     scripts,
+		BaseImage:   "ubuntu:22.04",
+		StatePath:   "/opt/activestate/state",
+		ProjectPath: "/project",
+		RuntimePath: "/opt/activestate/runtime",
+		EnvPath:     "/opt/activestate/env.sh",
+		APIKeyEnv:   constants.APIKeyEnvVarName,
+	}
+}
+
+// Generate renders the file of the given format.
+func Generate(format string, data *Data) (string, error) {
+	var tpl string
+	switch format {
+	case Dockerfile:
+		tpl = dockerfileTpl
+	case Devcontainer:
+		tpl = devcontainerTpl
+	case VSCodeTasks:
+		tpl = tasksTpl
+	default:
+		return "", errs.Wrap(ErrUnknownFormat, "Format: %s", format)
+	}
+
+	return strutils.ParseTemplate(tpl, data, template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	})
+}
+
+type primeable interface {
+	primer.Projecter
+	primer.Outputer
+}
+
+type Params struct {
+	Format string
+}
+
+type Container struct {
+	project *project.Project
+	out     output.Outputer
+}
+
+func New(prime primeable) *Container {
+	return &Container{prime.Project(), prime.Output()}
+}
+
+func (c *Container) Run(params *Params) error {
+	if c.project == nil {
+		return rationalize.ErrNoProject
+	}
+
+	commitID, err := localcommit.Get(c.project.Dir())
+	if err != nil {
+		return errs.Wrap(err, "Could not get local commit")
+	}
+
+	projectScripts, err := c.project.Scripts()
+	if err != nil {
+		return errs.Wrap(err, "Could not get scripts")
+	}
+	scripts := make([]Script, len(projectScripts))
+	for i, s := range projectScripts {
+		scripts[i] = Script{s.Name(), s.Description()}
+	}
+
+	if params.Format == "" {
+		params.Format = Dockerfile
+	}
+	out, err := Generate(params.Format, NewData(c.project.NamespaceString(), c.project.Name(), commitID.String(), scripts))
+	if err != nil {
+		if errors.Is(err, ErrUnknownFormat) {
+			return locale.NewInputError("err_export_container_format", "Unknown format: [ACTIONABLE]{{.V0}}[/RESET]. Supported formats are: {{.V1}}.", params.Format, strings.Join(Formats, ", "))
+		}
+		return errs.Wrap(err, "Could not generate %s", params.Format)
+	}
+
+	// Write the file as is, as it is meant to be redirected to a file and must not be wrapped or colorized.
+	fmt.Fprint(c.out.Config().OutWriter, out)
+	return nil
+}
@@ -0,0 +1,85 @@
+package container
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "regenerate golden files")
+
+func goldenData() *Data {
+	return NewData("ActiveState-CLI/Python3", "Python3", "6d79f2ae-f8b5-46bd-917a-d4b2558ec7b8", []Script{
+		{"test", "Run the test suite"},
+		{"serve", ""},
+	})
+}
+
+// TestGenerate compares the generated files against the committed golden files. Run `go test -update` to regenerate
+// them after an intentional change to the templates.
+func TestGenerate(t *testing.T) {
+	for format, golden := range map[string]string{
+		Dockerfile:   "testdata/Dockerfile.golden",
+		Devcontainer: "testdata/devcontainer.json.golden",
+		VSCodeTasks:  "testdata/tasks.json.golden",
+	} {
+		t.Run(format, func(t *testing.T) {
+			got, err := Generate(format, goldenData())
+			require.NoError(t, err)
+
+			if *update {
+				require.NoError(t, os.MkdirAll(filepath.Dir(golden), 0o755))
+				require.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+			}
+
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err, "run `go test -update` to create the golden file")
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestGenerateIsValidJSON(t *testing.T) {
+	for _, format := range []string{Devcontainer, VSCodeTasks} {
+		for _, scripts := range [][]Script{nil, {{"quote", `say "hi"`}}} {
+			data := goldenData()
+			data.Scripts = scripts
+			got, err := Generate(format, data)
+			require.NoError(t, err)
+			assert.True(t, json.Valid([]byte(got)), got)
+		}
+	}
+}
+
+func TestGenerateTasks(t *testing.T) {
+	got, err := Generate(VSCodeTasks, goldenData())
+	require.NoError(t, err)
+
+	tasks := struct {
+		Version string `json:"version"`
+		Tasks   []struct {
+			Label   string `json:"label"`
+			Type    string `json:"type"`
+			Command string `json:"command"`
+			Detail  string `json:"detail"`
+		} `json:"tasks"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(got), &tasks))
+	assert.Equal(t, "2.0.0", tasks.Version)
+	require.Len(t, tasks.Tasks, 2)
+	assert.Equal(t, "test", tasks.Tasks[0].Label)
+	assert.Equal(t, "shell", tasks.Tasks[0].Type)
+	assert.Equal(t, "state run test", tasks.Tasks[0].Command)
+	assert.Equal(t, "Run the test suite", tasks.Tasks[0].Detail)
+	assert.Equal(t, "state run serve", tasks.Tasks[1].Command)
+}
+
+func TestGenerateUnknownFormat(t *testing.T) {
+	_, err := Generate("compose", goldenData())
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
@@ -0,0 +1,14 @@
+{
+  "name": {{json .Name}},
+  "build": {
+    "dockerfile": "Dockerfile",
+    "target": "state",
+    "args": {
+      "BASE_IMAGE": {{json .BaseImage}}
+    }
+  },
+  "containerEnv": {
+    "ENV": {{json .EnvPath}},
+    "BASH_ENV": {{json .EnvPath}}
+  }
+}
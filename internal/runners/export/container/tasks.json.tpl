@@ -0,0 +1,11 @@
+{
+  "version": "2.0.0",
+  "tasks": [{{range $i, $s := .Scripts}}{{if $i}},{{end}}
+    {
+      "label": {{json $s.Name}},
+      "type": "shell",
+      "command": {{json (print "state run " $s.Name)}}{{if $s.Description}},
+      "detail": {{json $s.Description}}{{end}}
+    }{{end}}
+  ]
+}
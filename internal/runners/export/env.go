@@ -1,10 +1,18 @@
 package export
 
 import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
 	"github.com/ActiveState/cli/internal/analytics"
 	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/runbits/envexport"
 	"github.com/ActiveState/cli/internal/runbits/runtime"
 	"github.com/ActiveState/cli/internal/runbits/runtime/trigger"
 	"github.com/ActiveState/cli/pkg/platform/authentication"
@@ -37,7 +45,12 @@ func NewEnv(prime primeable) *Env {
 	}
 }
 
-func (e *Env) Run() error {
+type EnvParams struct {
+	Format  string
+	Inherit bool
+}
+
+func (e *Env) Run(params *EnvParams) error {
 	if e.project == nil {
 		return locale.NewInputError("err_env_no_project", "No project found.")
 	}
@@ -52,9 +65,36 @@ func (e *Env) Run() error {
 		return locale.WrapError(err, "err_export_new_runtime", "Could not initialize runtime")
 	}
 
-	envVars := rt.Env(false).VariablesWithExecutors
+	envVars := rt.Env(params.Inherit).VariablesWithExecutors
+
+	if params.Format == "" {
+		e.out.Print(output.Prepare(envVars, envVars))
+		return nil
+	}
+
+	// Without inheriting, PATH only holds the runtime's executors, so it is prepended to the PATH of whoever loads it.
+	var prepend []string
+	if !params.Inherit {
+		prepend = append(prepend, "PATH")
+	}
+	vars := envexport.FromMap(envVars, prepend...)
+
+	var script string
+	if params.Format == envexport.Direnv {
+		// Have direnv reload the environment whenever the project changes.
+		script = envexport.DirenvWatchFile(filepath.Join(e.project.Dir(), constants.ConfigFileName))
+	}
+	formatted, err := envexport.Render(params.Format, vars)
+	if err != nil {
+		if errors.Is(err, envexport.ErrUnknownFormat) {
+			return locale.NewInputError("err_export_env_format", "Unknown format: [ACTIONABLE]{{.V0}}[/RESET]. Supported formats are: {{.V1}}.", params.Format, strings.Join(envexport.Formats, ", "))
+		}
+		return errs.Wrap(err, "Could not render environment")
+	}
+	script += formatted
 
-	e.out.Print(output.Prepare(envVars, envVars))
+	// Write the script as is, as it is meant to be evaluated rather than read and must not be wrapped or colorized.
+	fmt.Fprint(e.out.Config().OutWriter, script)
 
 	return nil
 }
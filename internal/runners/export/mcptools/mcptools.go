@@ -0,0 +1,106 @@
+package mcptools
+
+import (
+	"path"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/captain"
+	"github.com/ActiveState/cli/internal/constants"
+	configMediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/primer"
+)
+
+// DefaultAllow lists the tools that are exposed unless the allow list is changed. They only report on the project,
+// runtime and platform, so that agents cannot change anything without the user opting in to it, e.g. by
+// adding "install" or "push" to the allow list, or setting it to "*".
+const DefaultAllow = "artifacts,artifacts_logs,branch,bundles,bundles_search,events," +
+	"export_buildplan,export_config,export_env,export_log,export_runtime,export_runtime-report,export_sbom,history,info," +
+	"languages,languages_search,manifest,notifications,organizations,packages,platforms,platforms_search,projects," +
+	"projects_remote,scripts,search,security,show,show_constants,usage,usage_idle,use_show," +
+	// Tools written for the MCP server
+	"list_history,list_manifest,search_packages,show_buildscript"
+
+// DefaultDeny lists the command tools that are not exposed unless the deny list is changed, even if the allow list
+// includes them, as they hand out credentials and secrets, or read or change the configuration of the State Tool
+// itself, which holds the API token.
+const DefaultDeny = "export_jwt,export_private-key,export_new-api-key,secrets_*,config,config_*"
+
+func init() {
+	configMediator.RegisterOption(constants.MCPToolsAllowConfig, configMediator.String, DefaultAllow)
+	configMediator.RegisterOption(constants.MCPToolsDenyConfig, configMediator.String, DefaultDeny)
+}
+
+// Configurable is the subset of the config instance the allow and deny lists are read from.
+type Configurable interface {
+	GetString(key string) string
+}
+
+type primeable interface {
+	primer.Outputer
+	primer.Configurer
+}
+
+type Tools struct {
+	prime primeable
+}
+
+type Params struct{}
+
+func New(prime primeable) *Tools {
+	return &Tools{prime}
+}
+
+type toolOutput struct {
+	Name        string `json:"-" locale:"name,[HEADING]Name[/RESET]"`
+	Description string `json:"-" locale:"description,[HEADING]Description[/RESET]"`
+}
+
+// Run outputs the tool specs of the commands the MCP server exposes, which are those that support structured output
+// and are allowed by the config.
+func (t *Tools) Run(params *Params, cmd *captain.Command) error {
+	cfg := t.prime.Config()
+
+	specs := []*captain.ToolSpec{}
+	plain := []toolOutput{}
+	for _, spec := range captain.ToolSpecs(cmd.TopParent(), cfg.GetBool(constants.UnstableConfig)) {
+		if !AllowedByConfig(cfg, spec.Name) {
+			continue
+		}
+		specs = append(specs, spec)
+		plain = append(plain, toolOutput{spec.Name, spec.Description})
+	}
+
+	t.prime.Output().Print(output.Prepare(plain, specs))
+	return nil
+}
+
+func patterns(v string) []string {
+	result := []string{}
+	for _, p := range strings.Split(v, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// AllowedByConfig returns whether the tool with the given name is allowed by the configured allow and deny lists.
+func AllowedByConfig(cfg Configurable, name string) bool {
+	return Allowed(name, patterns(cfg.GetString(constants.MCPToolsAllowConfig)), patterns(cfg.GetString(constants.MCPToolsDenyConfig)))
+}
+
+// Allowed returns whether the tool with the given name matches the allow list and does not match the deny list.
+// Patterns can use wildcards, e.g. "export_*", or "*" to allow all tools.
+func Allowed(name string, allow, deny []string) bool {
+	return matchesAny(name, allow) && !matchesAny(name, deny)
+}
+
+func matchesAny(name string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package mcptools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowed(t *testing.T) {
+	allow := patterns(DefaultAllow)
+	deny := patterns(DefaultDeny)
+	assert.True(t, Allowed("manifest", allow, deny))
+	assert.True(t, Allowed("export_sbom", allow, deny))
+	assert.True(t, Allowed("list_manifest", allow, deny))
+	for _, name := range []string{"install", "install_packages", "switch_branch", "push", "reset", "revert", "security_fix", "update_lock", "auth", "checkout", "export_jwt", "config_get"} {
+		assert.False(t, Allowed(name, allow, deny), "tools that change state or hand out credentials are not exposed by default: %s", name)
+	}
+
+	all := patterns("*")
+	assert.True(t, Allowed("packages_install", all, deny))
+	assert.False(t, Allowed("export_jwt", all, deny))
+	assert.False(t, Allowed("secrets_get", all, deny))
+	assert.False(t, Allowed("config", all, deny))
+	assert.False(t, Allowed("config_get", all, deny), "config values include the API token")
+
+	allow = patterns("packages*, manifest")
+	assert.True(t, Allowed("packages_install", allow, deny))
+	assert.True(t, Allowed("manifest", allow, deny))
+	assert.False(t, Allowed("history", allow, deny))
+	assert.False(t, Allowed("packages_install", allow, patterns("packages_install")))
+	assert.False(t, Allowed("manifest", nil, deny), "an empty allow list exposes nothing")
+}
@@ -0,0 +1,185 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/runbits/rationalize"
+	"github.com/ActiveState/cli/pkg/runtime"
+	"github.com/ActiveState/cli/pkg/runtime/profile"
+	"github.com/ActiveState/cli/pkg/runtime_helpers"
+)
+
+type RuntimeReport struct {
+	prime primeable
+}
+
+type RuntimeReportParams struct {
+	Trace string
+}
+
+func NewRuntimeReport(prime primeable) *RuntimeReport {
+	return &RuntimeReport{prime}
+}
+
+type runtimeReportArtifact struct {
+	Name     string `locale:"name,[HEADING]Artifact[/RESET]"`
+	Download string `locale:"download,[HEADING]Download[/RESET]"`
+	Verify   string `locale:"verify,[HEADING]Verify[/RESET]"`
+	Unpack   string `locale:"unpack,[HEADING]Unpack[/RESET]"`
+	Install  string `locale:"install,[HEADING]Install[/RESET]"`
+	Total    string `locale:"total,[HEADING]Total[/RESET]"`
+	Size     string `locale:"size,[HEADING]Size[/RESET]"`
+}
+
+type runtimeReportPhase struct {
+	Phase string `locale:"phase,[HEADING]Phase[/RESET]"`
+	Count int    `locale:"count,[HEADING]Count[/RESET]"`
+	Total string `locale:"total,[HEADING]Total[/RESET]"`
+	Max   string `locale:"max,[HEADING]Slowest[/RESET]"`
+	Size  string `locale:"size,[HEADING]Size[/RESET]"`
+}
+
+type runtimeReportOutput struct {
+	Start       time.Time                 `json:"start"`
+	Duration    time.Duration             `json:"duration"`
+	Failed      bool                      `json:"failed"`
+	Concurrency profile.Concurrency       `json:"concurrency"`
+	Phases      []profile.PhaseSummary    `json:"phases"`
+	Artifacts   []profile.ArtifactSummary `json:"artifacts"`
+}
+
+func (r *RuntimeReport) Run(params *RuntimeReportParams) error {
+	out := r.prime.Output()
+	proj := r.prime.Project()
+	if proj == nil {
+		return rationalize.ErrNoProject
+	}
+
+	path := runtime.ProfilePath(runtime_helpers.TargetDirFromProject(proj))
+	if !fileutils.FileExists(path) {
+		return locale.NewInputError("err_export_runtime_report_none",
+			"There is no record of a runtime setup for this project yet. One is recorded the next time your runtime is updated, eg. by running '[ACTIONABLE]state refresh[/RESET]'.")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return errs.Wrap(err, "Could not open runtime profile")
+	}
+	defer f.Close()
+	prof, err := profile.Load(f)
+	if err != nil {
+		return locale.WrapError(err, "err_export_runtime_report_load", "Could not read the record of the last runtime setup.")
+	}
+
+	if params.Trace != "" {
+		if err := writeTrace(prof, params.Trace); err != nil {
+			return err
+		}
+	}
+
+	phases := prof.Phases()
+	artifacts := prof.Artifacts()
+	concurrency := prof.Concurrency()
+
+	if out.Type().IsStructured() {
+		out.Print(&runtimeReportOutput{prof.Start, prof.Duration, prof.Failed, concurrency, phases, artifacts})
+		return nil
+	}
+
+	out.Print(output.Title(locale.Tl("export_runtime_report_title", "Runtime setup of {{.V0}}", proj.NamespaceString())))
+	status := locale.Tl("export_runtime_report_succeeded", "succeeded")
+	if prof.Failed {
+		status = locale.Tl("export_runtime_report_failed", "failed")
+	}
+	out.Print(locale.Tl("export_runtime_report_summary",
+		"Started {{.V0}} and {{.V1}} after [ACTIONABLE]{{.V2}}[/RESET], keeping up to {{.V3}} of {{.V4}} workers busy ({{.V5}} on average).",
+		prof.Start.Local().Format(time.RFC1123), status, formatDuration(prof.Duration),
+		fmt.Sprintf("%d", concurrency.Peak), fmt.Sprintf("%d", concurrency.Workers), fmt.Sprintf("%.1f", concurrency.Average)))
+
+	if len(artifacts) > 0 {
+		rows := make([]runtimeReportArtifact, 0, len(artifacts))
+		for _, a := range artifacts {
+			download := a.Phases[profile.PhaseDownload]
+			if download == 0 {
+				download = a.Phases[profile.PhaseReadArchive]
+			}
+			rows = append(rows, runtimeReportArtifact{
+				Name:     a.Name,
+				Download: formatDuration(download),
+				Verify:   formatDuration(a.Phases[profile.PhaseVerify]),
+				Unpack:   formatDuration(a.Phases[profile.PhaseUnpack] + a.Phases[profile.PhaseDecrypt]),
+				Install:  formatDuration(a.Phases[profile.PhaseInstall]),
+				Total:    formatDuration(a.Total),
+				Size:     formatBytes(a.Bytes),
+			})
+		}
+		out.Print("")
+		out.Print(output.Title(locale.Tl("export_runtime_report_artifacts", "Artifacts")))
+		out.Print(rows)
+	}
+
+	rows := make([]runtimeReportPhase, 0, len(phases))
+	for _, p := range phases {
+		rows = append(rows, runtimeReportPhase{
+			Phase: string(p.Phase),
+			Count: p.Count,
+			Total: formatDuration(p.Total),
+			Max:   formatDuration(p.Max),
+			Size:  formatBytes(p.Bytes),
+		})
+	}
+	out.Print("")
+	out.Print(output.Title(locale.Tl("export_runtime_report_phases", "Phases")))
+	out.Print(rows)
+
+	if params.Trace != "" {
+		out.Print("")
+		out.Notice(locale.Tl("export_runtime_report_trace",
+			"Trace written to [ACTIONABLE]{{.V0}}[/RESET], which can be opened with chrome://tracing or https://ui.perfetto.dev.", params.Trace))
+	}
+
+	return nil
+}
+
+func writeTrace(prof *profile.Profile, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return locale.WrapInputError(err, "err_export_runtime_report_trace", "Could not create the trace file at [ACTIONABLE]{{.V0}}[/RESET].", path)
+	}
+	defer f.Close()
+	if err := prof.WriteTrace(f); err != nil {
+		return errs.Wrap(err, "Could not write trace")
+	}
+	return nil
+}
+
+func formatDuration(d time.Duration) string {
+	switch {
+	case d == 0:
+		return "-"
+	case d < time.Second:
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(10 * time.Millisecond).String()
+}
+
+func formatBytes(b int) string {
+	if b == 0 {
+		return "-"
+	}
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := unit, 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
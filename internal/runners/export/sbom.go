@@ -0,0 +1,140 @@
+package export
+
+import (
+	"strings"
+
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/runbits/buildplanner"
+	"github.com/ActiveState/cli/internal/runbits/rationalize"
+	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/sbom"
+	"github.com/ActiveState/cli/pkg/sysinfo"
+	"github.com/go-openapi/strfmt"
+)
+
+type SBOMParams struct {
+	Namespace       *project.Namespaced
+	CommitID        string
+	Target          string
+	Platform        string
+	Format          string
+	Vulnerabilities bool
+}
+
+type SBOM struct {
+	prime primeable
+}
+
+func NewSBOM(p primeable) *SBOM {
+	return &SBOM{p}
+}
+
+// SBOMFormats returns the supported SBOM formats.
+func SBOMFormats() []string {
+	formats := make([]string, len(sbom.Formats))
+	for i, f := range sbom.Formats {
+		formats[i] = string(f)
+	}
+	return formats
+}
+
+func (s *SBOM) Run(params *SBOMParams) (rerr error) {
+	defer rationalizeError(&rerr, s.prime.Auth())
+
+	proj := s.prime.Project()
+	out := s.prime.Output()
+
+	format := sbom.Format(params.Format)
+	if format == "" {
+		format = sbom.CycloneDXJSON
+	}
+	supported := false
+	for _, f := range sbom.Formats {
+		supported = supported || f == format
+	}
+	if !supported {
+		return locale.NewInputError("err_export_sbom_format", "Unsupported SBOM format '[ACTIONABLE]{{.V0}}[/RESET]', supported formats are: {{.V1}}.", params.Format, strings.Join(SBOMFormats(), ", "))
+	}
+
+	var projectName string
+	switch {
+	case params.Namespace.IsValid():
+		projectName = params.Namespace.Owner + "/" + params.Namespace.Project
+	case proj != nil:
+		projectName = proj.Owner() + "/" + proj.Name()
+		out.Notice(locale.Tr("operating_message", proj.NamespaceString(), proj.Dir()))
+	default:
+		return rationalize.ErrNoProject
+	}
+
+	commit, err := buildplanner.GetCommit(params.Namespace, params.CommitID, params.Target, s.prime)
+	if err != nil {
+		return errs.Wrap(err, "Could not get commit")
+	}
+	bp := commit.BuildPlan()
+
+	platformID, platformName, err := resolveSBOMPlatform(params.Platform, bp.Platforms())
+	if err != nil {
+		return errs.Wrap(err, "Could not resolve platform")
+	}
+
+	doc := sbom.New(projectName, commit.CommitID, platformID, platformName, bp)
+
+	if params.Vulnerabilities {
+		vulns, err := model.FetchVulnerabilitiesForIngredients(s.prime.Auth(), doc.Ingredients())
+		if err != nil {
+			return errs.Wrap(err, "Could not fetch vulnerabilities")
+		}
+		doc.AddVulnerabilities(vulns)
+	}
+
+	b, err := doc.Marshal(format)
+	if err != nil {
+		return errs.Wrap(err, "Could not marshal SBOM")
+	}
+	structured, err := doc.Document(format)
+	if err != nil {
+		return errs.Wrap(err, "Could not create SBOM document")
+	}
+
+	out.Print(output.Prepare(string(b), structured))
+	return nil
+}
+
+// resolveSBOMPlatform returns the ID and name of the platform identified by the given platform ID or
+// name. If none is given, the platform matching the host is used.
+func resolveSBOMPlatform(query string, platformIDs []strfmt.UUID) (strfmt.UUID, string, error) {
+	platforms, err := model.FetchPlatformsMap()
+	if err != nil {
+		return "", "", errs.Wrap(err, "Could not get platforms")
+	}
+	name := func(id strfmt.UUID) string {
+		if p, ok := platforms[id]; ok && p.DisplayName != nil {
+			return *p.DisplayName
+		}
+		return ""
+	}
+
+	if query == "" {
+		id, err := model.FilterCurrentPlatform(sysinfo.OS().String(), platformIDs, "")
+		if err != nil {
+			return "", "", errs.Wrap(err, "Could not get platform ID")
+		}
+		return id, name(id), nil
+	}
+
+	for _, id := range platformIDs {
+		if strings.EqualFold(id.String(), query) || strings.EqualFold(name(id), query) {
+			return id, name(id), nil
+		}
+	}
+
+	var available []string
+	for _, id := range platformIDs {
+		available = append(available, name(id)+" ("+id.String()+")")
+	}
+	return "", "", locale.NewInputError("err_export_sbom_platform", "The commit does not build for platform '[ACTIONABLE]{{.V0}}[/RESET]'. Available platforms are: {{.V1}}.", query, strings.Join(available, ", "))
+}
@@ -105,7 +105,7 @@ func determineOwner(username string, prompter prompt.Prompter, auth *authenticat
 	}
 	options = append([]string{username}, options...)
 
-	r, err := prompter.Select(locale.Tl("fork_owner_title", "Owner"), locale.Tl("fork_select_org", "Who should the new project belong to?"), options, ptr.To(""), nil)
+	r, err := prompter.Select(prompt.IDForkOwner, locale.Tl("fork_owner_title", "Owner"), locale.Tl("fork_select_org", "Who should the new project belong to?"), options, ptr.To(""), nil)
 	owner, exists := displayNameToURLNameMap[r]
 	if !exists {
 		return "", errs.New("Selected organization does not have a URL name")
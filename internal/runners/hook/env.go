@@ -0,0 +1,236 @@
+package hook
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/installation/storage"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/runbits/envexport"
+	"github.com/ActiveState/cli/internal/subshell"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/runtime"
+	"github.com/ActiveState/cli/pkg/runtime_helpers"
+)
+
+var errRuntimeOutdated = errs.New("runtime is not up to date")
+
+// state is what the hook records about the project it activated, so that it can tell whether the environment
+// needs to change, and restore the environment when the user leaves the project.
+type state struct {
+	Dir  string `json:"dir"`
+	Hash string `json:"hash"`
+	// Prev holds the values the activation replaced, where nil means the variable was unset.
+	Prev map[string]*string `json:"prev"`
+}
+
+type EnvParams struct {
+	Shell string
+}
+
+type Env struct {
+	out      output.Outputer
+	subshell subshell.SubShell
+}
+
+func NewEnv(prime primeable) *Env {
+	return &Env{prime.Output(), prime.Subshell()}
+}
+
+// Run prints the script that moves the shell's environment from the project it activated last, if any, to the
+// project the working directory is in, if any. It prints nothing if neither changed, so that it is cheap to run on
+// every directory change. The shell also runs it when the runtime hash file it is told to watch changes, so that a
+// runtime that was outdated, or changed, is activated once it is set up.
+func (e *Env) Run(params *EnvParams) error {
+	if params.Shell == "" {
+		params.Shell = e.subshell.Shell()
+	}
+	shell, ok := shells[params.Shell]
+	if !ok {
+		return unsupportedShellError(params.Shell)
+	}
+
+	if os.Getenv(constants.ActivatedStateEnvVarName) != "" {
+		// The shell was activated by `state shell` or `state activate`, which we should not interfere with.
+		return nil
+	}
+
+	prev, err := decodeState(os.Getenv(constants.HookStateEnvVarName))
+	if err != nil {
+		logging.Warning("Ignoring invalid hook state: %v", errs.JoinMessage(err))
+		prev = nil
+	}
+
+	wd, err := osutils.Getwd()
+	if err != nil {
+		return errs.Wrap(err, "Could not get working directory")
+	}
+
+	var proj *project.Project
+	var dir, hash, watch string
+	if path, err := fileutils.FindFileInPath(wd, constants.ConfigFileName); err == nil {
+		dir = filepath.Dir(path)
+		proj, err = project.FromExactPath(dir)
+		if err != nil {
+			return locale.WrapInputError(err, "err_hook_project", "Could not load the project at [ACTIONABLE]{{.V0}}[/RESET].", dir)
+		}
+		hash, err = runtime_helpers.Hash(proj, nil)
+		if err != nil {
+			return errs.Wrap(err, "Could not get runtime hash")
+		}
+		watch = runtime.HashPath(runtime_helpers.TargetDirFromProject(proj))
+	} else if !errors.Is(err, fileutils.ErrorFileNotFound) {
+		return errs.Wrap(err, "Could not search for project file")
+	}
+
+	if os.Getenv(constants.HookWatchEnvVarName) == watch {
+		if prev == nil && dir == "" {
+			return nil
+		}
+		if prev != nil && prev.Dir == dir && prev.Hash == hash {
+			return nil
+		}
+	}
+
+	// Start out by restoring whatever the previous activation replaced.
+	env := osutils.EnvSliceToMap(os.Environ())
+	updates := map[string]*string{}
+	if prev != nil {
+		for name, value := range prev.Prev {
+			updates[name] = value
+			if value == nil {
+				delete(env, name)
+			} else {
+				env[name] = *value
+			}
+		}
+	}
+
+	var next *state
+	if proj != nil {
+		rtEnv, err := runtimeEnv(proj, hash)
+		switch {
+		case errors.Is(err, errRuntimeOutdated):
+			e.out.Notice(locale.Tl("hook_runtime_outdated", "The runtime of [ACTIONABLE]{{.V0}}[/RESET] is not up to date, so it was not activated. Run '[ACTIONABLE]state refresh[/RESET]' to update it.", proj.NamespaceString()))
+		case err != nil:
+			return errs.Wrap(err, "Could not get runtime environment")
+		default:
+			next = &state{Dir: dir, Hash: hash, Prev: map[string]*string{}}
+			for name, value := range rtEnv {
+				if current, ok := env[name]; ok {
+					next.Prev[name] = &current
+					if name == "PATH" && current != "" {
+						value = value + string(os.PathListSeparator) + current
+					}
+				} else {
+					next.Prev[name] = nil
+				}
+				value := value
+				updates[name] = &value
+			}
+		}
+	}
+
+	if next != nil {
+		encoded, err := encodeState(next)
+		if err != nil {
+			return errs.Wrap(err, "Could not encode hook state")
+		}
+		updates[constants.HookStateEnvVarName] = &encoded
+	} else {
+		updates[constants.HookStateEnvVarName] = nil
+	}
+	if watch != "" {
+		updates[constants.HookWatchEnvVarName] = &watch
+	} else {
+		updates[constants.HookWatchEnvVarName] = nil
+	}
+
+	vars := make([]envexport.Var, 0, len(updates))
+	for name, value := range updates {
+		vars = append(vars, envexport.Var{Name: name, Value: value})
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+
+	script, err := envexport.Render(shell.format, vars)
+	if err != nil {
+		return errs.Wrap(err, "Could not render environment")
+	}
+
+	// Write the script as is, as it is evaluated by the shell and must not be wrapped or colorized.
+	fmt.Fprint(e.out.Config().OutWriter, script)
+
+	return nil
+}
+
+// runtimeEnv returns the environment of the given project's runtime. Loading a runtime's environment is relatively
+// expensive, so it is cached by the runtime hash, which changes whenever the runtime does.
+func runtimeEnv(proj *project.Project, hash string) (map[string]string, error) {
+	targetDir := runtime_helpers.TargetDirFromProject(proj)
+	cacheFile := filepath.Join(storage.CachePath(), "hook", hash+".json")
+	if fileutils.TargetExists(cacheFile) && fileutils.DirExists(targetDir) {
+		b, err := fileutils.ReadFile(cacheFile)
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not read cached environment")
+		}
+		env := map[string]string{}
+		err = json.Unmarshal(b, &env)
+		if err == nil {
+			return env, nil
+		}
+		logging.Warning("Ignoring invalid cached environment at %s: %v", cacheFile, err)
+	}
+
+	rt, err := runtime_helpers.FromProject(proj)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not initialize runtime")
+	}
+	if rt.Hash() != hash {
+		return nil, errRuntimeOutdated
+	}
+	env := rt.Env(false).Variables
+
+	b, err := json.Marshal(env)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not marshal environment")
+	}
+	if err := fileutils.WriteFile(cacheFile, b); err != nil {
+		return nil, errs.Wrap(err, "Could not cache environment")
+	}
+
+	return env, nil
+}
+
+func encodeState(s *state) (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not marshal state")
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func decodeState(v string) (*state, error) {
+	if v == "" {
+		return nil, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not decode state")
+	}
+	s := &state{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, errs.Wrap(err, "Could not unmarshal state")
+	}
+	return s, nil
+}
@@ -0,0 +1,111 @@
+// Package hook installs a shell hook that activates the runtime of the nearest project whenever the user changes
+// directory, and deactivates it again when they leave the project.
+package hook
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/ActiveState/cli/internal/assets"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/runbits/envexport"
+	"github.com/ActiveState/cli/internal/subshell"
+	"github.com/ActiveState/cli/internal/subshell/bash"
+	"github.com/ActiveState/cli/internal/subshell/fish"
+	"github.com/ActiveState/cli/internal/subshell/sscommon"
+	"github.com/ActiveState/cli/internal/subshell/zsh"
+)
+
+type primeable interface {
+	primer.Outputer
+	primer.Subsheller
+}
+
+// shells maps the shells the hook supports to the hook script and the format their environment is exported in.
+var shells = map[string]struct {
+	asset  string
+	format string
+}{
+	bash.Name: {"shells/hook_bash.sh", envexport.Posix},
+	zsh.Name:  {"shells/hook_zsh.sh", envexport.Posix},
+	fish.Name: {"shells/hook_fish.fish", envexport.Fish},
+}
+
+type Install struct {
+	out      output.Outputer
+	subshell subshell.SubShell
+}
+
+func NewInstall(prime primeable) *Install {
+	return &Install{prime.Output(), prime.Subshell()}
+}
+
+func (i *Install) Run() error {
+	shell, ok := shells[i.subshell.Shell()]
+	if !ok {
+		return unsupportedShellError(i.subshell.Shell())
+	}
+
+	rcFile, err := i.subshell.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "Could not get rc file")
+	}
+
+	tpl, err := assets.ReadFileBytes(shell.asset)
+	if err != nil {
+		return errs.Wrap(err, "Could not read hook script")
+	}
+	t, err := template.New("hook").Parse(string(tpl))
+	if err != nil {
+		return errs.Wrap(err, "Could not parse hook script")
+	}
+	var script bytes.Buffer
+	if err := t.Execute(&script, map[string]string{"Exec": osutils.NewBashEscaper().Quote(osutils.Executable())}); err != nil {
+		return errs.Wrap(err, "Could not render hook script")
+	}
+
+	if err := sscommon.WriteRcData(script.String(), rcFile, sscommon.HookID); err != nil {
+		return errs.Wrap(err, "Could not write hook to %s", rcFile)
+	}
+
+	i.out.Notice(locale.Tl("hook_installed", "The hook was installed in [ACTIONABLE]{{.V0}}[/RESET]. Start a new shell for it to take effect.", rcFile))
+	return nil
+}
+
+type Uninstall struct {
+	out      output.Outputer
+	subshell subshell.SubShell
+}
+
+func NewUninstall(prime primeable) *Uninstall {
+	return &Uninstall{prime.Output(), prime.Subshell()}
+}
+
+func (u *Uninstall) Run() error {
+	if _, ok := shells[u.subshell.Shell()]; !ok {
+		return unsupportedShellError(u.subshell.Shell())
+	}
+
+	rcFile, err := u.subshell.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "Could not get rc file")
+	}
+
+	if fileutils.FileExists(rcFile) {
+		if err := sscommon.CleanRcFile(rcFile, sscommon.HookID); err != nil {
+			return errs.Wrap(err, "Could not remove hook from %s", rcFile)
+		}
+	}
+
+	u.out.Notice(locale.Tl("hook_uninstalled", "The hook was removed from [ACTIONABLE]{{.V0}}[/RESET]. Start a new shell for it to take effect.", rcFile))
+	return nil
+}
+
+func unsupportedShellError(shell string) error {
+	return locale.NewInputError("err_hook_unsupported_shell", "The hook is not supported for the [ACTIONABLE]{{.V0}}[/RESET] shell. Supported shells are bash, zsh and fish.", shell)
+}
@@ -18,14 +18,16 @@ import (
 	"github.com/ActiveState/cli/internal/osutils"
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/primer"
-	"github.com/ActiveState/cli/internal/runbits/buildscript"
+	buildscript_runbit "github.com/ActiveState/cli/internal/runbits/buildscript"
 	"github.com/ActiveState/cli/internal/runbits/dependencies"
 	"github.com/ActiveState/cli/internal/runbits/org"
 	"github.com/ActiveState/cli/internal/runbits/rationalize"
 	"github.com/ActiveState/cli/internal/runbits/runtime"
 	"github.com/ActiveState/cli/internal/runbits/runtime/trigger"
+	"github.com/ActiveState/cli/pkg/buildscript"
 	"github.com/ActiveState/cli/pkg/localcommit"
 	"github.com/ActiveState/cli/pkg/platform/api"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
 	"github.com/ActiveState/cli/pkg/platform/authentication"
 	"github.com/ActiveState/cli/pkg/platform/model"
 	bpModel "github.com/ActiveState/cli/pkg/platform/model/buildplanner"
@@ -43,6 +45,8 @@ type RunParams struct {
 	Path        string
 	Language    string
 	Private     bool
+	Template    string
+	Vars        []string
 }
 
 // Initialize stores scope-related dependencies.
@@ -146,6 +150,22 @@ func (r *Initialize) Run(params *RunParams) (rerr error) {
 		return errs.Wrap(err, "Could not determine absolute path to '%s'", params.Path)
 	}
 
+	var tpl *Template
+	if params.Template != "" {
+		tpl, err = LoadTemplate(params.Template, r.config)
+		if err != nil {
+			return errs.Wrap(err, "Could not load template")
+		}
+		defer func() {
+			if err := tpl.Close(); err != nil {
+				logging.Warning("Could not remove template files: %v", errs.JoinMessage(err))
+			}
+		}()
+		if params.Language == "" {
+			params.Language = tpl.Language
+		}
+	}
+
 	var languageName, languageVersion string
 	if params.Language != "" {
 		langParts := strings.Split(params.Language, "@")
@@ -179,6 +199,24 @@ func (r *Initialize) Run(params *RunParams) (rerr error) {
 	resolvedProjectName = r.getProjectName(paramProjectName, lang.String())
 	namespace := project.Namespaced{Owner: resolvedOwner, Project: resolvedProjectName}
 
+	var tplData map[string]interface{}
+	var tplContent, tplScript string
+	if tpl != nil {
+		tplData, err = tpl.Data(namespace.Owner, namespace.Project, lang.String(), version, params.Vars)
+		if err != nil {
+			return errs.Wrap(err, "Invalid template variables")
+		}
+		if tplContent, err = tpl.Render(constants.ConfigFileName, tplData); err != nil {
+			return errs.Wrap(err, "Could not render template project file")
+		}
+		if tplScript, err = tpl.Render(constants.BuildScriptFileName, tplData); err != nil {
+			return errs.Wrap(err, "Could not render template build script")
+		}
+		if err := tpl.CheckFiles(path, tplData); err != nil {
+			return errs.Wrap(err, "Template files conflict with existing files")
+		}
+	}
+
 	r.out.Notice(locale.T("initializing_project"))
 
 	createParams := &projectfile.CreateParams{
@@ -186,6 +224,7 @@ func (r *Initialize) Run(params *RunParams) (rerr error) {
 		Project:   namespace.Project,
 		Language:  lang.String(),
 		Directory: path,
+		Content:   tplContent,
 		Private:   params.Private,
 		Host:      api.HostOverride(),
 	}
@@ -242,6 +281,18 @@ func (r *Initialize) Run(params *RunParams) (rerr error) {
 		return errs.Wrap(err, "Could not create project")
 	}
 
+	if tplScript != "" {
+		commitID, err = r.applyTemplateScript(namespace, commitID, tplScript)
+		if err != nil {
+			logging.Debug("Deleting remotely created project due to template error")
+			if err2 := model.DeleteProject(namespace.Owner, namespace.Project, r.auth); err2 != nil {
+				multilog.Error("Error deleting remotely created project after template error: %v", errs.JoinMessage(err2))
+				return errDeleteProjectAfterError
+			}
+			return errs.Wrap(err, "Could not apply template build script")
+		}
+	}
+
 	if err := localcommit.Set(proj.Dir(), commitID.String()); err != nil {
 		return errs.Wrap(err, "Unable to create local commit file")
 	}
@@ -276,6 +327,14 @@ func (r *Initialize) Run(params *RunParams) (rerr error) {
 	}
 	executorsPath := rti.Env(false).ExecutorsPath
 
+	var files []string
+	if tpl != nil {
+		files, err = tpl.CopyFiles(path, tplData)
+		if err != nil {
+			return errs.Wrap(err, "Could not copy template files")
+		}
+	}
+
 	projectfile.StoreProjectMapping(r.config, namespace.String(), filepath.Dir(proj.Source().Path()))
 
 	initSuccessMsg := locale.Tr("init_success", namespace.String(), path, executorsPath)
@@ -286,19 +345,66 @@ func (r *Initialize) Run(params *RunParams) (rerr error) {
 	r.out.Print(output.Prepare(
 		initSuccessMsg,
 		&struct {
-			Namespace   string `json:"namespace"`
-			Path        string `json:"path" `
-			Executables string `json:"executables"`
+			Namespace   string   `json:"namespace"`
+			Path        string   `json:"path" `
+			Executables string   `json:"executables"`
+			Template    string   `json:"template,omitempty"`
+			Files       []string `json:"files,omitempty"`
 		}{
 			namespace.String(),
 			path,
 			executorsPath,
+			params.Template,
+			files,
 		},
 	))
 
 	return nil
 }
 
+// applyTemplateScript commits the given template build script on top of the newly created project's
+// initial commit, and returns the ID of the new commit.
+func (r *Initialize) applyTemplateScript(namespace project.Namespaced, commitID strfmt.UUID, content string) (strfmt.UUID, error) {
+	script, err := buildscript.Unmarshal([]byte(content))
+	if err != nil {
+		return "", errs.Wrap(err, "Could not parse template build script")
+	}
+
+	bp := bpModel.NewBuildPlannerModel(r.auth, r.svcModel)
+	if script.AtTime() == nil {
+		commit, err := bp.FetchCommit(commitID, namespace.Owner, namespace.Project, nil)
+		if err != nil {
+			return "", errs.Wrap(err, "Could not fetch initial commit")
+		}
+		if atTime := commit.BuildScript().AtTime(); atTime != nil {
+			script.SetAtTime(*atTime, false)
+		}
+	}
+
+	commit, err := bp.StageCommitAndPoll(bpModel.StageCommitParams{
+		Owner:        namespace.Owner,
+		Project:      namespace.Project,
+		ParentCommit: commitID.String(),
+		Description:  locale.Tl("commit_message_init_template", "Apply project template"),
+		Script:       script,
+	})
+	if err != nil {
+		return "", errs.Wrap(err, "Could not stage template commit")
+	}
+
+	if _, err := bp.MergeCommit(&bpModel.MergeCommitParams{
+		Owner:     namespace.Owner,
+		Project:   namespace.Project,
+		TargetRef: constants.DefaultBranchName,
+		OtherRef:  commit.CommitID.String(),
+		Strategy:  types.MergeCommitStrategyFastForward,
+	}); err != nil {
+		return "", errs.Wrap(err, "Could not update project branch")
+	}
+
+	return commit.CommitID, nil
+}
+
 func getKnownVersions(lang language.Language, auth *authentication.Auth) ([]string, error) {
 	pkgs, err := model.SearchIngredientsStrict(model.NewNamespaceLanguage().String(), lang.Requirement(), false, true, nil, auth)
 	if err != nil {
@@ -0,0 +1,361 @@
+package initialize
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/installation/storage"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
+	configMediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/internal/strutils"
+	"github.com/go-git/go-git/v5"
+	"gopkg.in/yaml.v2"
+)
+
+func init() {
+	configMediator.RegisterOption(constants.InitTemplatesPathConfig, configMediator.String, "")
+}
+
+const (
+	templateManifestFile = "template.yaml"
+	templateFilesDir     = "files"
+	// templateRenderSuffix marks starter files whose contents are rendered; the suffix is dropped.
+	templateRenderSuffix = ".tpl"
+)
+
+// Template is a project template used by `state init --template`. A template is a directory with the
+// following layout, in which every file other than the manifest is optional:
+//
+//	template.yaml     the manifest, declaring the template's description, language and variables
+//	activestate.yaml  scripts, events and constants to add to the project's activestate.yaml
+//	buildscript.as    a build script with the project's requirements and platforms
+//	files/            starter files copied into the project directory
+//
+// All of these are Go templates with access to the .Owner, .Project, .Namespace, .Language and
+// .LanguageVersion of the project, and to the template's variables through .Vars. Starter files are
+// copied as-is unless their name ends in .tpl.
+type Template struct {
+	Name        string             `yaml:"-"`
+	Dir         string             `yaml:"-"`
+	Description string             `yaml:"description"`
+	Language    string             `yaml:"language"`
+	Variables   []TemplateVariable `yaml:"variables"`
+
+	tempDir string
+}
+
+type TemplateVariable struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Default is used when no value is given. Variables without a default are required.
+	Default *string `yaml:"default"`
+}
+
+// templateDirs returns the local directories templates are looked up in by name: those configured by
+// the user, followed by the default template directory.
+func templateDirs(cfg Configurable) []string {
+	var dirs []string
+	for _, dir := range filepath.SplitList(cfg.GetString(constants.InitTemplatesPathConfig)) {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return append(dirs, filepath.Join(storage.AppDataPath(), "templates"))
+}
+
+// availableTemplates returns the names of the templates in the given directories.
+func availableTemplates(dirs []string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && !seen[entry.Name()] && fileutils.FileExists(filepath.Join(dir, entry.Name(), templateManifestFile)) {
+				seen[entry.Name()] = true
+				names = append(names, entry.Name())
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func isGitURL(ref string) bool {
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git://", "git@"} {
+		if strings.HasPrefix(ref, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadTemplate loads the template identified by the given reference, which is the name of a template
+// in one of the template directories, the path to a template directory, or the URL of a git repository
+// holding a template. Close must be called on the returned template when done.
+func LoadTemplate(ref string, cfg Configurable) (*Template, error) {
+	var dir string
+	var tempDir string
+	switch {
+	case isGitURL(ref):
+		var err error
+		tempDir, err = os.MkdirTemp("", "state-init-template")
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not create temporary directory")
+		}
+		logging.Debug("Cloning template from %s", ref)
+		if _, err := git.PlainClone(tempDir, false, &git.CloneOptions{URL: ref, Depth: 1}); err != nil {
+			os.RemoveAll(tempDir)
+			return nil, locale.WrapExternalError(err, "err_init_template_clone", "Could not clone template repository [ACTIONABLE]{{.V0}}[/RESET]: {{.V1}}", ref, err.Error())
+		}
+		dir = tempDir
+	case fileutils.DirExists(ref) || strings.ContainsAny(ref, `/\`) || strings.HasPrefix(ref, "."):
+		dir = ref
+	default:
+		dirs := templateDirs(cfg)
+		for _, d := range dirs {
+			if fileutils.DirExists(filepath.Join(d, ref)) {
+				dir = filepath.Join(d, ref)
+				break
+			}
+		}
+		if dir == "" {
+			available := availableTemplates(dirs)
+			if len(available) == 0 {
+				return nil, locale.NewInputError("err_init_template_not_found_none", "Could not find the template [ACTIONABLE]{{.V0}}[/RESET]. No templates are available in: {{.V1}}.", ref, strings.Join(dirs, ", "))
+			}
+			return nil, locale.NewInputError("err_init_template_not_found", "Could not find the template [ACTIONABLE]{{.V0}}[/RESET]. Available templates are: {{.V1}}.", ref, strings.Join(available, ", "))
+		}
+	}
+
+	t, err := readTemplate(dir)
+	if err != nil {
+		if tempDir != "" {
+			os.RemoveAll(tempDir)
+		}
+		return nil, errs.Wrap(err, "Could not read template")
+	}
+	t.tempDir = tempDir
+	if !isGitURL(ref) {
+		t.Name = filepath.Base(filepath.Clean(ref))
+	} else {
+		t.Name = strings.TrimSuffix(filepath.Base(ref), ".git")
+	}
+	return t, nil
+}
+
+func readTemplate(dir string) (*Template, error) {
+	manifest := filepath.Join(dir, templateManifestFile)
+	if !fileutils.FileExists(manifest) {
+		return nil, locale.NewInputError("err_init_template_no_manifest", "The directory [ACTIONABLE]{{.V0}}[/RESET] is not a project template, as it has no {{.V1}}.", dir, templateManifestFile)
+	}
+	if err := checkRegularFile(manifest, templateManifestFile); err != nil {
+		return nil, errs.Wrap(err, "Invalid template manifest")
+	}
+	b, err := fileutils.ReadFile(manifest)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read template manifest")
+	}
+	t := &Template{}
+	if err := yaml.Unmarshal(b, t); err != nil {
+		return nil, locale.WrapInputError(err, "err_init_template_manifest", "Could not parse the template manifest at [ACTIONABLE]{{.V0}}[/RESET]: {{.V1}}", manifest, err.Error())
+	}
+	t.Dir = dir
+	return t, nil
+}
+
+// Close removes any temporary files created while loading the template.
+func (t *Template) Close() error {
+	if t.tempDir == "" {
+		return nil
+	}
+	return os.RemoveAll(t.tempDir)
+}
+
+// Data returns the data templates are rendered with, given the project's details and the user given
+// variable assignments (name=value).
+func (t *Template) Data(owner, project, lang, langVersion string, assignments []string) (map[string]interface{}, error) {
+	declared := map[string]bool{}
+	vars := map[string]string{}
+	for _, v := range t.Variables {
+		declared[v.Name] = true
+		if v.Default != nil {
+			vars[v.Name] = *v.Default
+		}
+	}
+
+	for _, a := range assignments {
+		name, value, ok := strings.Cut(a, "=")
+		if !ok || name == "" {
+			return nil, locale.NewInputError("err_init_template_var_format", "Template variables must be in '[ACTIONABLE]name=value[/RESET]' format, got: {{.V0}}", a)
+		}
+		if !declared[name] {
+			return nil, locale.NewInputError("err_init_template_var_unknown", "The template does not declare the variable [ACTIONABLE]{{.V0}}[/RESET].", name)
+		}
+		vars[name] = value
+	}
+
+	var missing []string
+	for _, v := range t.Variables {
+		if _, ok := vars[v.Name]; !ok {
+			missing = append(missing, v.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, locale.NewInputError("err_init_template_var_missing", "The template requires values for the following variables: {{.V0}}. Set them with '[ACTIONABLE]--var name=value[/RESET]'.", strings.Join(missing, ", "))
+	}
+
+	return map[string]interface{}{
+		"Owner":           owner,
+		"Project":         project,
+		"Namespace":       owner + "/" + project,
+		"Language":        lang,
+		"LanguageVersion": langVersion,
+		"Vars":            vars,
+	}, nil
+}
+
+// Render returns the rendered contents of the given template file, or an empty string if the template
+// does not have it.
+func (t *Template) Render(name string, data map[string]interface{}) (string, error) {
+	path := filepath.Join(t.Dir, name)
+	if !fileutils.FileExists(path) {
+		return "", nil
+	}
+	if err := checkRegularFile(path, name); err != nil {
+		return "", errs.Wrap(err, "Invalid template file")
+	}
+	b, err := fileutils.ReadFile(path)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not read %s", name)
+	}
+	result, err := strutils.ParseTemplate(string(b), data, nil)
+	if err != nil {
+		return "", locale.WrapInputError(err, "err_init_template_render", "Could not render the template file [ACTIONABLE]{{.V0}}[/RESET]: {{.V1}}", name, errs.JoinMessage(err))
+	}
+	return result, nil
+}
+
+// checkRegularFile returns an error if the given template file is not a regular file. Templates may be
+// cloned from anywhere, so symlinks are refused, as they could copy arbitrary local files into the project.
+func checkRegularFile(path, name string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return errs.Wrap(err, "Could not stat %s", name)
+	}
+	if !info.Mode().IsRegular() {
+		return errNotRegularFile(name)
+	}
+	return nil
+}
+
+func errNotRegularFile(name string) error {
+	return locale.NewInputError("err_init_template_not_regular", "The template file [ACTIONABLE]{{.V0}}[/RESET] is not a regular file. Templates cannot contain symlinks.", name)
+}
+
+// starterFile is a file of the template to be copied into the project.
+type starterFile struct {
+	source string
+	target string // relative to the project directory
+	render bool
+}
+
+// starterFiles returns the starter files of the template, with their target paths rendered.
+func (t *Template) starterFiles(data map[string]interface{}) ([]starterFile, error) {
+	root := filepath.Join(t.Dir, templateFilesDir)
+	if !fileutils.DirExists(root) {
+		return nil, nil
+	}
+
+	var files []starterFile
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return errs.Wrap(err, "Could not get relative path")
+		}
+		if !d.Type().IsRegular() {
+			return errNotRegularFile(rel)
+		}
+		target, err := strutils.ParseTemplate(rel, data, nil)
+		if err != nil {
+			return locale.WrapInputError(err, "err_init_template_render", "Could not render the template file [ACTIONABLE]{{.V0}}[/RESET]: {{.V1}}", rel, errs.JoinMessage(err))
+		}
+		// Rendered names must stay within the project directory.
+		if !filepath.IsLocal(target) {
+			return locale.NewInputError("err_init_template_file_outside", "The template file [ACTIONABLE]{{.V0}}[/RESET] would be written outside of the project directory, to {{.V1}}.", rel, target)
+		}
+		target = filepath.Clean(target)
+		render := strings.HasSuffix(target, templateRenderSuffix)
+		files = append(files, starterFile{path, strings.TrimSuffix(target, templateRenderSuffix), render})
+		return nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not walk template files")
+	}
+	return files, nil
+}
+
+// CheckFiles verifies that the template's starter files would not overwrite existing files in the given
+// directory.
+func (t *Template) CheckFiles(dir string, data map[string]interface{}) error {
+	files, err := t.starterFiles(data)
+	if err != nil {
+		return errs.Wrap(err, "Could not get starter files")
+	}
+	for _, f := range files {
+		if fileutils.TargetExists(filepath.Join(dir, f.target)) {
+			return locale.NewInputError("err_init_template_file_exists", "The template would overwrite the existing file [ACTIONABLE]{{.V0}}[/RESET].", f.target)
+		}
+	}
+	return nil
+}
+
+// CopyFiles copies the template's starter files into the given directory and returns their paths
+// relative to it.
+func (t *Template) CopyFiles(dir string, data map[string]interface{}) ([]string, error) {
+	files, err := t.starterFiles(data)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not get starter files")
+	}
+
+	var copied []string
+	for _, f := range files {
+		target := filepath.Join(dir, f.target)
+		if err := fileutils.MkdirUnlessExists(filepath.Dir(target)); err != nil {
+			return nil, errs.Wrap(err, "Could not create directory for %s", f.target)
+		}
+		if !f.render {
+			if err := fileutils.CopyFile(f.source, target); err != nil {
+				return nil, errs.Wrap(err, "Could not copy %s", f.target)
+			}
+		} else {
+			b, err := fileutils.ReadFile(f.source)
+			if err != nil {
+				return nil, errs.Wrap(err, "Could not read %s", f.source)
+			}
+			contents, err := strutils.ParseTemplate(string(b), data, nil)
+			if err != nil {
+				return nil, locale.WrapInputError(err, "err_init_template_render", "Could not render the template file [ACTIONABLE]{{.V0}}[/RESET]: {{.V1}}", f.target, errs.JoinMessage(err))
+			}
+			if err := fileutils.WriteFile(target, []byte(contents)); err != nil {
+				return nil, errs.Wrap(err, "Could not write %s", f.target)
+			}
+		}
+		copied = append(copied, filepath.ToSlash(f.target))
+	}
+	return copied, nil
+}
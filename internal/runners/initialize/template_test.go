@@ -0,0 +1,140 @@
+package initialize
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTemplate(t *testing.T, dir string, files map[string]string) {
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	}
+}
+
+func TestLoadTemplate(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, cfg.Close()) }()
+
+	registry := t.TempDir()
+	writeTemplate(t, filepath.Join(registry, "python-service"), map[string]string{
+		templateManifestFile: "description: A Python service\nlanguage: python@3.11\n",
+	})
+	require.NoError(t, cfg.Set(constants.InitTemplatesPathConfig, registry))
+
+	tpl, err := LoadTemplate("python-service", cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "python-service", tpl.Name)
+	assert.Equal(t, "python@3.11", tpl.Language)
+	assert.Equal(t, "A Python service", tpl.Description)
+
+	tpl, err = LoadTemplate(filepath.Join(registry, "python-service"), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "python-service", tpl.Name)
+
+	_, err = LoadTemplate("data-science", cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "python-service")
+
+	_, err = LoadTemplate(registry, cfg)
+	assert.Error(t, err, "directories without a manifest are not templates")
+}
+
+func TestTemplateData(t *testing.T) {
+	port := "8080"
+	tpl := &Template{Variables: []TemplateVariable{{Name: "port", Default: &port}, {Name: "module"}}}
+
+	_, err := tpl.Data("org", "proj", "python3", "3.11", nil)
+	assert.Error(t, err, "module is required")
+
+	_, err = tpl.Data("org", "proj", "python3", "3.11", []string{"module=app", "unknown=x"})
+	assert.Error(t, err)
+
+	data, err := tpl.Data("org", "proj", "python3", "3.11", []string{"module=app"})
+	require.NoError(t, err)
+	assert.Equal(t, "org/proj", data["Namespace"])
+	assert.Equal(t, map[string]string{"port": "8080", "module": "app"}, data["Vars"])
+}
+
+func TestTemplateFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, map[string]string{
+		templateManifestFile:                           "language: python@3.11\nvariables:\n  - name: module\n",
+		constants.ConfigFileName:                       "constants:\n  - name: module\n    value: {{.Vars.module}}\n",
+		"files/{{.Vars.module}}/__init__.py.tpl":       "PROJECT = \"{{.Namespace}}\"\n",
+		"files/{{.Vars.module}}/static/{{.Owner}}.txt": "{{.Project}}",
+	})
+
+	tpl, err := readTemplate(dir)
+	require.NoError(t, err)
+	data, err := tpl.Data("org", "proj", "python3", "3.11", []string{"module=app"})
+	require.NoError(t, err)
+
+	content, err := tpl.Render(constants.ConfigFileName, data)
+	require.NoError(t, err)
+	assert.Contains(t, content, "value: app")
+
+	script, err := tpl.Render(constants.BuildScriptFileName, data)
+	require.NoError(t, err)
+	assert.Empty(t, script)
+
+	target := t.TempDir()
+	require.NoError(t, tpl.CheckFiles(target, data))
+	files, err := tpl.CopyFiles(target, data)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app/__init__.py", "app/static/org.txt"}, files)
+
+	b, err := os.ReadFile(filepath.Join(target, "app", "__init__.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "PROJECT = \"org/proj\"\n", string(b))
+
+	// Files without the render suffix are copied as-is.
+	b, err = os.ReadFile(filepath.Join(target, "app", "static", "org.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "{{.Project}}", string(b))
+
+	assert.Error(t, tpl.CheckFiles(target, data), "existing files must not be overwritten")
+}
+
+func TestTemplateFiles_Unsafe(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, map[string]string{
+		templateManifestFile:         "variables:\n  - name: module\n",
+		"files/{{.Vars.module}}.txt": "escaped",
+	})
+	tpl, err := readTemplate(dir)
+	require.NoError(t, err)
+
+	for _, module := range []string{"../outside", "sub/../../outside"} {
+		data, err := tpl.Data("org", "proj", "python3", "3.11", []string{"module=" + module})
+		require.NoError(t, err)
+		_, err = tpl.CopyFiles(t.TempDir(), data)
+		assert.Error(t, err, "files must not be written outside of the project: %s", module)
+	}
+
+	if runtime.GOOS == "windows" {
+		return // creating symlinks requires privileges
+	}
+	secret := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("secret"), 0600))
+	require.NoError(t, os.Symlink(secret, filepath.Join(dir, "files", "link.txt")))
+	data, err := tpl.Data("org", "proj", "python3", "3.11", []string{"module=app"})
+	require.NoError(t, err)
+	target := t.TempDir()
+	_, err = tpl.CopyFiles(target, data)
+	assert.Error(t, err, "symlinks must not be copied")
+	assert.NoFileExists(t, filepath.Join(target, "link.txt"))
+
+	require.NoError(t, os.Symlink(secret, filepath.Join(dir, constants.ConfigFileName)))
+	_, err = tpl.Render(constants.ConfigFileName, data)
+	assert.Error(t, err, "symlinks must not be rendered")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ActiveState/cli/internal/logging"
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/rtutils/ptr"
 	"github.com/ActiveState/cli/internal/runbits/commits_runbit"
 	"github.com/ActiveState/cli/internal/runbits/rationalize"
@@ -40,6 +41,7 @@ type primeable interface {
 type Params struct {
 	Packages  captain.PackagesValue
 	Timestamp captain.TimeValue
+	DryRun    bool
 }
 
 type resolvedRequirement struct {
@@ -156,6 +158,13 @@ func (i *Install) Run(params Params) (rerr error) {
 		return errs.Wrap(err, "Could not prepare build script")
 	}
 
+	if params.DryRun {
+		if err := reqop_runbit.DryRun(i.prime, script, oldCommit, locale.Tr("commit_message_added", reqs.String()), reqs); err != nil {
+			return errs.Wrap(err, "Failed to solve changes")
+		}
+		return nil
+	}
+
 	// Update local checkout and source runtime changes
 	if err := reqop_runbit.UpdateAndReload(i.prime, script, oldCommit, locale.Tr("commit_message_added", reqs.String()), trigger.TriggerInstall); err != nil {
 		return errs.Wrap(err, "Failed to update local checkout")
@@ -323,6 +332,7 @@ func (i *Install) promptForMatchingIngredient(req *requirement, ingredients []*m
 
 	// Prompt the user with the ingredient choices
 	choice, err := i.prime.Prompt().Select(
+		prompt.IDInstallSelectIngredient,
 		locale.T("prompt_pkgop_ingredient"),
 		locale.Tr("prompt_pkgop_ingredient_msg", req.Requested.String()),
 		choices, &choices[0], nil,
@@ -107,7 +107,7 @@ func (i *invite) Run(params *Params, args []string) error {
 
 func (i *invite) promptForRole() (Role, error) {
 	choices := roleNames()
-	selection, err := i.prompt.Select(locale.Tl("invite_role", "Role"), locale.Tl("invite_select_org_role", "What role should the user(s) be given?"), choices, ptr.To(""), nil)
+	selection, err := i.prompt.Select(prompt.IDInviteRole, locale.Tl("invite_role", "Role"), locale.Tl("invite_select_org_role", "What role should the user(s) be given?"), choices, ptr.To(""), nil)
 	if err != nil {
 		return -1, err
 	}
@@ -9,10 +9,12 @@ import (
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/primer"
 	buildscript_runbit "github.com/ActiveState/cli/internal/runbits/buildscript"
+	"github.com/ActiveState/cli/internal/runbits/licenses"
 	"github.com/ActiveState/cli/internal/runbits/rationalize"
 	"github.com/ActiveState/cli/pkg/buildplan"
 	"github.com/ActiveState/cli/pkg/buildscript"
 	"github.com/ActiveState/cli/pkg/localcommit"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
 	"github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/request"
 	"github.com/ActiveState/cli/pkg/platform/authentication"
 	"github.com/ActiveState/cli/pkg/platform/model"
@@ -72,17 +74,24 @@ func (m *Manifest) Run(params Params) (rerr error) {
 		return errs.Wrap(err, "Could not fetch requirements")
 	}
 
-	bpReqs, err := m.fetchBuildplanRequirements()
+	bp, err := m.fetchBuildplan()
 	if err != nil {
 		return errs.Wrap(err, "Could not fetch artifacts")
 	}
+	bpReqs := bp.RequestedIngredients()
 
 	vulns, err := m.fetchVulnerabilities(reqs, bpReqs)
 	if err != nil {
 		return errs.Wrap(err, "Could not fetch vulnerabilities")
 	}
 
+	violations, err := m.checkLicenses(bp)
+	if err != nil {
+		return errs.Wrap(err, "Could not check licenses")
+	}
+
 	reqOut := newRequirements(reqs, bpReqs, vulns, !m.out.Type().IsStructured(), params.Expand)
+	reqOut.LicenseViolations = violations
 	if m.out.Type().IsStructured() {
 		m.out.Print(reqOut)
 	} else {
@@ -125,7 +134,7 @@ func (m *Manifest) fetchRequirements() ([]buildscript.Requirement, error) {
 	return reqs, nil
 }
 
-func (m *Manifest) fetchBuildplanRequirements() (buildplan.Ingredients, error) {
+func (m *Manifest) fetchBuildplan() (*buildplan.BuildPlan, error) {
 	commitID, err := localcommit.Get(m.project.Dir())
 	if err != nil {
 		return nil, errs.Wrap(err, "Failed to get local commit")
@@ -141,7 +150,27 @@ func (m *Manifest) fetchBuildplanRequirements() (buildplan.Ingredients, error) {
 	}
 	solveSpinner.Stop(locale.T("progress_success"))
 
-	return commit.BuildPlan().RequestedIngredients(), nil
+	return commit.BuildPlan(), nil
+}
+
+// checkLicenses returns the runtime artifacts that violate the project's license policy, if any.
+func (m *Manifest) checkLicenses(bp *buildplan.BuildPlan) ([]licenses.Violation, error) {
+	policy, err := licenses.LoadPolicy(m.project, m.cfg)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not load license policy")
+	}
+	if policy == nil {
+		return nil, nil
+	}
+
+	var artifacts buildplan.Artifacts
+	for _, a := range bp.Artifacts(buildplan.FilterRuntimeArtifacts()) {
+		if a.MimeType != types.XActiveStateBuilderMimeType {
+			artifacts = append(artifacts, a)
+		}
+	}
+
+	return policy.Check(artifacts), nil
 }
 
 func (m *Manifest) fetchVulnerabilities(reqs []buildscript.Requirement, bpReqs buildplan.Ingredients) (vulnerabilities, error) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/runbits/licenses"
 	"github.com/ActiveState/cli/pkg/buildplan"
 	"github.com/ActiveState/cli/pkg/buildscript"
 	platformModel "github.com/ActiveState/cli/pkg/platform/model"
@@ -20,6 +21,7 @@ type requirement struct {
 type requirements struct {
 	Requirements        []requirement                    `json:"requirements"`
 	UnknownRequirements []buildscript.UnknownRequirement `json:"unknown_requirements,omitempty"`
+	LicenseViolations   []licenses.Violation             `json:"license_violations,omitempty"`
 	expand              bool                             // Whether to show requirements by their full namespace
 }
 
@@ -102,6 +104,13 @@ func (o requirements) Print(out output.Outputer) {
 		})
 	}
 
+	if len(o.LicenseViolations) > 0 {
+		out.Notice("")
+		out.Notice(locale.Tl("manifest_license_violations", "[WARNING]The following packages violate the license policy:[/RESET]"))
+		for _, v := range o.LicenseViolations {
+			out.Notice("  • " + licenses.FormatViolation(v))
+		}
+	}
 }
 
 func (o requirements) MarshalStructured(f output.Format) interface{} {
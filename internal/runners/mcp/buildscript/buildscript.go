@@ -0,0 +1,106 @@
+package buildscript
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/primer"
+	buildscript_runbit "github.com/ActiveState/cli/internal/runbits/buildscript"
+	"github.com/ActiveState/cli/internal/runbits/rationalize"
+	"github.com/ActiveState/cli/pkg/buildscript"
+	"github.com/ActiveState/cli/pkg/localcommit"
+	"github.com/ActiveState/cli/pkg/platform/authentication"
+	"github.com/ActiveState/cli/pkg/platform/model"
+	bpModel "github.com/ActiveState/cli/pkg/platform/model/buildplanner"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/go-openapi/strfmt"
+)
+
+type BuildScriptRunner struct {
+	auth     *authentication.Auth
+	output   output.Outputer
+	project  *project.Project
+	svcModel *model.SvcModel
+}
+
+func New(p *primer.Values) *BuildScriptRunner {
+	return &BuildScriptRunner{
+		auth:     p.Auth(),
+		output:   p.Output(),
+		project:  p.Project(),
+		svcModel: p.SvcModel(),
+	}
+}
+
+type Params struct{}
+
+func NewParams() *Params {
+	return &Params{}
+}
+
+type buildScriptOutput struct {
+	Project     string `json:"project"`
+	CommitID    string `json:"commit_id"`
+	Path        string `json:"path,omitempty"` // only set if the project has a local build script
+	BuildScript string `json:"buildscript"`
+}
+
+// Run outputs the build script of the project, which is the local build script if the project has one, or otherwise
+// the build script of its local commit.
+func (runner *BuildScriptRunner) Run(params *Params) error {
+	if runner.project == nil {
+		return rationalize.ErrNoProject
+	}
+
+	commitID, err := localcommit.Get(runner.project.Dir())
+	if err != nil {
+		return errs.Wrap(err, "Unable to get local commit")
+	}
+
+	result := &buildScriptOutput{Project: runner.project.NamespaceString(), CommitID: commitID.String()}
+
+	script, local, err := ForProject(runner.auth, runner.svcModel, runner.project, commitID)
+	if err != nil {
+		return err
+	}
+	if local {
+		result.Path = filepath.Join(runner.project.Dir(), constants.BuildScriptFileName)
+	}
+
+	result.BuildScript, err = marshal(script)
+	if err != nil {
+		return err
+	}
+
+	runner.output.Print(output.Prepare(result.BuildScript, result))
+	return nil
+}
+
+// ForProject returns the local build script of the project if it has one, or otherwise the build script of the given
+// commit. The returned bool is whether the build script is the local one.
+func ForProject(auth *authentication.Auth, svcModel *model.SvcModel, proj *project.Project, commitID strfmt.UUID) (*buildscript.BuildScript, bool, error) {
+	script, err := buildscript_runbit.ScriptFromProject(proj)
+	switch {
+	case err == nil:
+		return script, true, nil
+	case errors.Is(err, buildscript_runbit.ErrBuildscriptNotExist):
+		script, err = bpModel.NewBuildPlannerModel(auth, svcModel).GetBuildScript(commitID.String())
+		if err != nil {
+			return nil, false, errs.Wrap(err, "Could not get build script for commit")
+		}
+		return script, false, nil
+	default:
+		return nil, false, errs.Wrap(err, "Could not read build script")
+	}
+}
+
+func marshal(script *buildscript.BuildScript) (string, error) {
+	b, err := script.Marshal()
+	if err != nil {
+		return "", errs.Wrap(err, "Could not marshal build script")
+	}
+	return string(b), nil
+}
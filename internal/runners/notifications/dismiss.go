@@ -0,0 +1,91 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/pkg/platform/model"
+)
+
+type SnoozeParams struct {
+	ID   string
+	Days int
+}
+
+type Snooze struct {
+	out      output.Outputer
+	cfg      Configurable
+	svcModel *model.SvcModel
+}
+
+func NewSnooze(prime primeable) *Snooze {
+	return &Snooze{
+		prime.Output(),
+		prime.Config(),
+		prime.SvcModel(),
+	}
+}
+
+func (s *Snooze) Run(params *SnoozeParams) error {
+	if params.Days < 1 {
+		return locale.NewInputError("err_notifications_snooze_days", "The number of days to snooze a notification for must be at least 1.")
+	}
+
+	notification, err := find(s.svcModel, params.ID)
+	if err != nil {
+		return errs.Wrap(err, "Could not find notification")
+	}
+
+	until := time.Now().Add(time.Duration(params.Days) * 24 * time.Hour)
+	snoozed := s.cfg.GetStringMap(constants.NotificationsSnoozedConfig)
+	snoozed[notification.ID] = until.Format(time.RFC3339)
+	if err := s.cfg.Set(constants.NotificationsSnoozedConfig, snoozed); err != nil {
+		return errs.Wrap(err, "Could not save snoozed notifications")
+	}
+
+	s.out.Print(output.Prepare(
+		locale.Tl("notifications_snoozed", "Snoozed notification '[ACTIONABLE]{{.V0}}[/RESET]' until {{.V1}}.", notification.ID, until.Format(time.DateTime)),
+		&notificationOutput{ID: notification.ID, Message: notification.Message, Status: StatusSnoozed, SnoozedUntil: until.Format(time.RFC3339)},
+	))
+	return nil
+}
+
+type AcknowledgeParams struct {
+	ID string
+}
+
+type Acknowledge struct {
+	out      output.Outputer
+	cfg      Configurable
+	svcModel *model.SvcModel
+}
+
+func NewAcknowledge(prime primeable) *Acknowledge {
+	return &Acknowledge{
+		prime.Output(),
+		prime.Config(),
+		prime.SvcModel(),
+	}
+}
+
+func (a *Acknowledge) Run(params *AcknowledgeParams) error {
+	notification, err := find(a.svcModel, params.ID)
+	if err != nil {
+		return errs.Wrap(err, "Could not find notification")
+	}
+
+	acknowledged := a.cfg.GetStringMap(constants.NotificationsAcknowledgedConfig)
+	acknowledged[notification.ID] = time.Now().Format(time.RFC3339)
+	if err := a.cfg.Set(constants.NotificationsAcknowledgedConfig, acknowledged); err != nil {
+		return errs.Wrap(err, "Could not save acknowledged notifications")
+	}
+
+	a.out.Print(output.Prepare(
+		locale.Tl("notifications_acknowledged", "Acknowledged notification '[ACTIONABLE]{{.V0}}[/RESET]'. It will not be shown again.", notification.ID),
+		&notificationOutput{ID: notification.ID, Message: notification.Message, Status: StatusAcknowledged},
+	))
+	return nil
+}
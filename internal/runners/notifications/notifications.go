@@ -0,0 +1,135 @@
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/graph"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/pkg/platform/model"
+)
+
+type primeable interface {
+	primer.Outputer
+	primer.Configurer
+	primer.SvcModeler
+}
+
+// Configurable covers the config that snoozed and acknowledged notifications are stored in
+type Configurable interface {
+	GetStringMap(key string) map[string]interface{}
+	Set(key string, value interface{}) error
+}
+
+// Notification statuses
+const (
+	StatusActive       = "active"
+	StatusSnoozed      = "snoozed"
+	StatusAcknowledged = "acknowledged"
+)
+
+type List struct {
+	out      output.Outputer
+	cfg      Configurable
+	svcModel *model.SvcModel
+}
+
+func NewList(prime primeable) *List {
+	return &List{
+		prime.Output(),
+		prime.Config(),
+		prime.SvcModel(),
+	}
+}
+
+type notificationOutput struct {
+	ID           string `json:"id"`
+	Message      string `json:"message"`
+	Status       string `json:"status"`
+	SnoozedUntil string `json:"snoozedUntil,omitempty"`
+}
+
+type notificationPlainOutput struct {
+	ID      string `locale:"id,[HEADING]ID[/RESET]"`
+	Message string `locale:"message,[HEADING]Message[/RESET]"`
+	Status  string `locale:"status,[HEADING]Status[/RESET]"`
+}
+
+func (l *List) Run() error {
+	notifications, err := fetch(l.svcModel)
+	if err != nil {
+		return errs.Wrap(err, "Could not fetch notifications")
+	}
+
+	snoozed := l.cfg.GetStringMap(constants.NotificationsSnoozedConfig)
+	acknowledged := l.cfg.GetStringMap(constants.NotificationsAcknowledgedConfig)
+
+	rows := []notificationOutput{}
+	plainRows := []notificationPlainOutput{}
+	for _, notification := range notifications {
+		row := notificationOutput{ID: notification.ID, Message: notification.Message, Status: StatusActive}
+		plainStatus := locale.Tl("notifications_status_active", "Active")
+		if _, ok := acknowledged[notification.ID]; ok {
+			row.Status = StatusAcknowledged
+			plainStatus = locale.Tl("notifications_status_acknowledged", "Acknowledged")
+		} else if until, ok := snoozedUntil(snoozed, notification.ID); ok {
+			row.Status = StatusSnoozed
+			row.SnoozedUntil = until.Format(time.RFC3339)
+			plainStatus = locale.Tl("notifications_status_snoozed", "Snoozed until {{.V0}}", until.Local().Format(time.DateTime))
+		}
+		rows = append(rows, row)
+		plainRows = append(plainRows, notificationPlainOutput{row.ID, row.Message, plainStatus})
+	}
+
+	var plainOutput interface{} = plainRows
+	if len(rows) == 0 {
+		plainOutput = locale.Tl("notifications_empty", "No notifications have been shown to you.")
+	}
+	l.out.Print(output.Prepare(plainOutput, rows))
+	return nil
+}
+
+// snoozedUntil returns until when the given notification is snoozed, if it is snoozed
+func snoozedUntil(snoozed map[string]interface{}, id string) (time.Time, bool) {
+	value, ok := snoozed[id].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil || !until.After(time.Now()) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+func fetch(svcModel *model.SvcModel) ([]*graph.NotificationInfo, error) {
+	notifications, err := svcModel.Notifications(context.Background())
+	if err != nil {
+		return nil, locale.WrapError(err, "err_notifications_fetch", "Could not retrieve notifications from the State Tool service.")
+	}
+	return notifications, nil
+}
+
+// find returns the notification with the given ID, which must have been shown to the user and must be dismissable
+func find(svcModel *model.SvcModel, id string) (*graph.NotificationInfo, error) {
+	notifications, err := fetch(svcModel)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not fetch notifications")
+	}
+
+	for _, notification := range notifications {
+		if notification.ID != id {
+			continue
+		}
+		if notification.Interrupt == graph.NotificationInterruptTypeExit {
+			return nil, locale.NewInputError("err_notification_not_dismissable", "The notification '[ACTIONABLE]{{.V0}}[/RESET]' stops commands from running, and cannot be snoozed or acknowledged.", id)
+		}
+		return notification, nil
+	}
+
+	return nil, locale.NewInputError("err_notification_not_found", "No notification with ID '[ACTIONABLE]{{.V0}}[/RESET]' has been shown to you. Run '[ACTIONABLE]state notifications[/RESET]' to list your notifications.", id)
+}
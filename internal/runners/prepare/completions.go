@@ -7,7 +7,9 @@ import (
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/subshell"
 	"github.com/ActiveState/cli/internal/subshell/bash"
+	"github.com/ActiveState/cli/internal/subshell/elvish"
 	"github.com/ActiveState/cli/internal/subshell/fish"
+	"github.com/ActiveState/cli/internal/subshell/nushell"
 	"github.com/ActiveState/cli/internal/subshell/zsh"
 )
 
@@ -51,6 +53,10 @@ func prepareCompletions(cmd *captain.Command, sub subshell.SubShell) error {
 		completions, err = cmd.GenBashCompletions()
 	case fish.Name:
 		completions, err = cmd.GenFishCompletions()
+	case nushell.Name:
+		completions, err = cmd.GenNushellCompletion()
+	case elvish.Name:
+		completions, err = cmd.GenElvishCompletion()
 	default:
 		return &ErrorNotSupported{
 			locale.NewInputError("err_shell_not_supported", "Completions are currently not supported for {{.V0}}.", shell),
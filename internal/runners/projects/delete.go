@@ -39,7 +39,7 @@ func (d *Delete) Run(params *DeleteParams) error {
 	}
 
 	defaultChoice := !d.prompt.IsInteractive()
-	confirm, err := d.prompt.Confirm("", locale.Tl("project_delete_confim", "Are you sure you want to delete the project {{.V0}}?", params.Project.String()), &defaultChoice, ptr.To(true))
+	confirm, err := d.prompt.Confirm(prompt.IDProjectsDelete, "", locale.Tl("project_delete_confim", "Are you sure you want to delete the project {{.V0}}?", params.Project.String()), &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
@@ -91,7 +91,7 @@ func (e *Edit) Run(params *EditParams) error {
 	editMsg += locale.Tl("edit_prompt_confirm", "Continue?")
 
 	defaultChoice := !e.prompt.IsInteractive()
-	edit, err := e.prompt.Confirm("", editMsg, &defaultChoice, ptr.To(true))
+	edit, err := e.prompt.Confirm(prompt.IDProjectsEdit, "", editMsg, &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
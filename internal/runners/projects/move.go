@@ -43,7 +43,7 @@ func (m *Move) Run(params *MoveParams) error {
 	}
 
 	defaultChoice := !m.prompt.IsInteractive()
-	move, err := m.prompt.Confirm("", locale.Tr("move_prompt", params.Namespace.String(), params.NewOwner, params.Namespace.Project), &defaultChoice, ptr.To(true))
+	move, err := m.prompt.Confirm(prompt.IDProjectsMove, "", locale.Tr("move_prompt", params.Namespace.String(), params.NewOwner, params.Namespace.Project), &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
@@ -4,54 +4,58 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/ActiveState/cli/internal/archiver"
 	"github.com/ActiveState/cli/internal/artifactcrypto"
 	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/language"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/logging"
 	"github.com/ActiveState/cli/internal/python/wheel"
 	"github.com/ActiveState/cli/internal/runbits/orgkey"
+	"github.com/ActiveState/cli/pkg/platform/api/graphql/request"
 	"github.com/ActiveState/cli/pkg/platform/model"
 )
 
 // generateEncryptedArtifact validates the --build inputs, fetches and validates
 // the org key, builds the wrapped, encrypted artifact, and points the publish
 // flow at it. It fails closed before producing any artifact if the key is
-// unavailable, and returns a cleanup function the caller must defer.
-func (r *Runner) generateEncryptedArtifact(params *Params) (cleanup func(), rerr error) {
+// unavailable. It returns the metadata the wheel was packed with and a cleanup
+// function the caller must defer.
+func (r *Runner) generateEncryptedArtifact(params *Params) (_ *wheel.Metadata, cleanup func(), rerr error) {
 	if params.Filepath != "" {
-		return nil, locale.NewInputError("err_publish_build_and_file", "The '[ACTIONABLE]--build[/RESET]' flag cannot be combined with a source archive filepath.")
+		return nil, nil, locale.NewInputError("err_publish_build_and_file", "The '[ACTIONABLE]--build[/RESET]' flag cannot be combined with a source archive filepath.")
 	}
 	if r.project == nil {
-		return nil, locale.NewInputError("err_publish_build_no_project", "The '[ACTIONABLE]--build[/RESET]' flag requires a project so the organization can be determined.")
+		return nil, nil, locale.NewInputError("err_publish_build_no_project", "The '[ACTIONABLE]--build[/RESET]' flag requires a project so the organization can be determined.")
 	}
 	if !fileutils.DirExists(params.Build) {
-		return nil, locale.NewInputError("err_publish_build_dir_not_found", "The '[ACTIONABLE]--build[/RESET]' source directory does not exist: [ACTIONABLE]{{.V0}}[/RESET]", params.Build)
+		return nil, nil, locale.NewInputError("err_publish_build_dir_not_found", "The '[ACTIONABLE]--build[/RESET]' source directory does not exist: [ACTIONABLE]{{.V0}}[/RESET]", params.Build)
 	}
 
 	meta, err := wheel.ResolveMetadata(params.Build, wheel.Metadata{Name: params.Name, Version: params.Version})
 	if err != nil {
-		return nil, locale.WrapInputError(err, "err_publish_build_metadata", "Could not determine the ingredient name and version: {{.V0}}", errs.JoinMessage(err))
+		return nil, nil, locale.WrapInputError(err, "err_publish_build_metadata", "Could not determine the ingredient metadata: {{.V0}}", errs.JoinMessage(err))
 	}
 
 	// Fetch and validate the org key before building anything: a private publish
 	// is encrypted-required, so fail closed before any byte could be uploaded.
 	provider := orgkey.New(r.cfg, r.project.Owner())
 	if !provider.Configured() {
-		return nil, locale.NewInputError("err_publish_orgkey_unconfigured", "No organization key service is configured, so this private ingredient cannot be encrypted.")
+		return nil, nil, locale.NewInputError("err_publish_orgkey_unconfigured", "No organization key service is configured, so this private ingredient cannot be encrypted.")
 	}
 	defer provider.Close()
 	key, keyID, err := provider.Key(context.Background())
 	if err != nil {
-		return nil, locale.WrapInputError(err, "err_publish_orgkey_unavailable", "Could not obtain the organization key, so nothing was uploaded: {{.V0}}", errs.JoinMessage(err))
+		return nil, nil, locale.WrapInputError(err, "err_publish_orgkey_unavailable", "Could not obtain the organization key, so nothing was uploaded: {{.V0}}", errs.JoinMessage(err))
 	}
 
 	archivePath, cleanup, err := buildWrappedArtifact(params.Build, *meta, key, keyID)
 	if err != nil {
-		return nil, errs.Wrap(err, "Could not build encrypted artifact")
+		return nil, nil, errs.Wrap(err, "Could not build encrypted artifact")
 	}
 
 	// TODO(ENG-1641): once the platform supports the genesis/timeless publish
@@ -65,7 +69,107 @@ func (r *Runner) generateEncryptedArtifact(params *Params) (cleanup func(), rerr
 	if params.Version == "" {
 		params.Version = meta.Version
 	}
-	return cleanup, nil
+	return meta, cleanup, nil
+}
+
+// pythonDependencies translates the wheel's required dependencies into runtime
+// dependencies on the platform's Python packages, so the solver resolves them
+// transitively for anyone requiring the ingredient. Optional dependencies are
+// not translated, as the platform has no notion of extras. Dependencies gated on
+// an environment marker or pinned to a direct URL cannot be expressed either, so
+// those are recorded in the wheel only.
+func pythonDependencies(reqs []wheel.Requirement) []request.PublishVariableDep {
+	ns := model.NewNamespacePackage(language.Python3.Requirement()).String()
+	var deps []request.PublishVariableDep
+	for _, req := range reqs {
+		if req.Marker != "" || req.URL != "" {
+			logging.Debug("Not declaring platform dependency for conditional or direct reference requirement: %s", req.String())
+			continue
+		}
+		deps = append(deps, request.PublishVariableDep{
+			Dependency: request.Dependency{
+				Name:                req.Name,
+				Namespace:           ns,
+				VersionRequirements: pythonSpecifierToConstraints(req.Specifier),
+				Type:                request.DependencyTypeRuntime,
+			},
+			Conditions: []request.Dependency{},
+		})
+	}
+	return deps
+}
+
+// pythonSpecifierToConstraints converts a PEP 440 version specifier into the
+// platform's comma separated version constraint syntax.
+func pythonSpecifierToConstraints(specifier string) string {
+	if specifier == "" {
+		return ">=0"
+	}
+	var parts []string
+	for _, spec := range strings.Split(specifier, ",") {
+		switch {
+		case strings.HasPrefix(spec, "~="):
+			// Compatible release: ~=1.4.2 is >=1.4.2,<1.5
+			version := strings.TrimPrefix(spec, "~=")
+			parts = append(parts, ">="+version)
+			if upper := compatibleUpperBound(version); upper != "" {
+				parts = append(parts, "<"+upper)
+			}
+		case strings.HasPrefix(spec, "==="):
+			parts = append(parts, "=="+strings.TrimPrefix(spec, "==="))
+		case strings.HasPrefix(spec, "!="):
+			parts = append(parts, "!"+strings.TrimPrefix(spec, "!="))
+		case strings.HasPrefix(spec, "==") && strings.HasSuffix(spec, ".*"):
+			// Prefix match: ==1.4.* is >=1.4,<1.5
+			version := strings.TrimSuffix(strings.TrimPrefix(spec, "=="), ".*")
+			parts = append(parts, ">="+version)
+			if upper := prefixUpperBound(version); upper != "" {
+				parts = append(parts, "<"+upper)
+			}
+		default:
+			parts = append(parts, spec)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// compatibleUpperBound returns the exclusive upper bound of a compatible release
+// clause, ie. the version with its last component dropped and the one before it
+// incremented. It returns an empty string if there is no such bound.
+func compatibleUpperBound(version string) string {
+	segments := strings.Split(version, ".")
+	if len(segments) < 2 {
+		return ""
+	}
+	return prefixUpperBound(strings.Join(segments[:len(segments)-1], "."))
+}
+
+// prefixUpperBound returns the exclusive upper bound of all versions starting
+// with the given prefix, ie. the prefix with its last component incremented.
+func prefixUpperBound(prefix string) string {
+	segments := strings.Split(prefix, ".")
+	last, err := strconv.Atoi(segments[len(segments)-1])
+	if err != nil {
+		return ""
+	}
+	segments[len(segments)-1] = strconv.Itoa(last + 1)
+	return strings.Join(segments, ".")
+}
+
+// mergeDependencies appends the derived dependencies to deps, skipping any that
+// are already declared so explicit declarations always take precedence.
+func mergeDependencies(deps []request.PublishVariableDep, derived []request.PublishVariableDep) []request.PublishVariableDep {
+	declared := make(map[string]struct{}, len(deps))
+	for _, d := range deps {
+		declared[d.Namespace+"/"+strings.ToLower(d.Name)] = struct{}{}
+	}
+	for _, d := range derived {
+		if _, ok := declared[d.Namespace+"/"+strings.ToLower(d.Name)]; ok {
+			continue
+		}
+		deps = append(deps, d)
+	}
+	return deps
 }
 
 // requireOrgNamespace ensures ns belongs to the project owner's private org, so
@@ -7,12 +7,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 	"testing"
 
 	"github.com/ActiveState/cli/internal/artifactcrypto"
 	"github.com/ActiveState/cli/internal/python/wheel"
+	"github.com/ActiveState/cli/pkg/platform/api/graphql/request"
 )
 
 func testKey() []byte {
@@ -173,3 +175,63 @@ func equalStrings(a, b []string) bool {
 	}
 	return true
 }
+
+func TestPythonDependencies(t *testing.T) {
+	var reqs []wheel.Requirement
+	for _, s := range []string{
+		"requests>=2.0,<3",
+		"attrs",
+		"urllib3~=1.26.5",
+		"idna==3.*",
+		"charset-normalizer!=3.0.0",
+		"pywin32; sys_platform == 'win32'",
+		"mylib @ https://example.com/mylib.whl",
+	} {
+		req, err := wheel.ParseRequirement(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reqs = append(reqs, req)
+	}
+
+	deps := pythonDependencies(reqs)
+	got := map[string]string{}
+	for _, d := range deps {
+		if d.Namespace != "language/python" {
+			t.Errorf("%s namespace = %q, want language/python", d.Name, d.Namespace)
+		}
+		if d.Type != request.DependencyTypeRuntime {
+			t.Errorf("%s type = %q, want runtime", d.Name, d.Type)
+		}
+		got[d.Name] = d.VersionRequirements
+	}
+	want := map[string]string{
+		"requests":           ">=2.0,<3",
+		"attrs":              ">=0",
+		"urllib3":            ">=1.26.5,<1.27",
+		"idna":               ">=3,<4",
+		"charset-normalizer": "!3.0.0",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dependencies = %v, want %v", got, want)
+	}
+}
+
+func TestMergeDependencies(t *testing.T) {
+	dep := func(ns, name, version string) request.PublishVariableDep {
+		return request.PublishVariableDep{Dependency: request.Dependency{Namespace: ns, Name: name, VersionRequirements: version}}
+	}
+	declared := []request.PublishVariableDep{dep("language/python", "Requests", ">=2.31")}
+	derived := []request.PublishVariableDep{dep("language/python", "requests", ">=2.0"), dep("language/python", "attrs", ">=0")}
+
+	merged := mergeDependencies(declared, derived)
+	if len(merged) != 2 {
+		t.Fatalf("merged = %+v, want 2 dependencies", merged)
+	}
+	if merged[0].VersionRequirements != ">=2.31" {
+		t.Errorf("explicit declaration was overridden: %+v", merged[0])
+	}
+	if merged[1].Name != "attrs" {
+		t.Errorf("derived dependency missing: %+v", merged[1])
+	}
+}
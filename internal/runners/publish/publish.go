@@ -18,6 +18,7 @@ import (
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/primer"
 	"github.com/ActiveState/cli/internal/prompt"
+	"github.com/ActiveState/cli/internal/python/wheel"
 	"github.com/ActiveState/cli/internal/rtutils/ptr"
 	"github.com/ActiveState/cli/pkg/platform/api/graphql/request"
 	"github.com/ActiveState/cli/pkg/platform/api/inventory/inventory_client/inventory_operations"
@@ -92,12 +93,14 @@ func (r *Runner) Run(params *Params) error {
 		return locale.NewInputError("err_auth_required")
 	}
 
+	var buildMeta *wheel.Metadata
 	if params.Build != "" {
-		cleanup, err := r.generateEncryptedArtifact(params) // note: this function also mutates params
+		meta, cleanup, err := r.generateEncryptedArtifact(params) // note: this function also mutates params
 		if err != nil {
 			return errs.Wrap(err, "Could not build private ingredient")
 		}
 		defer cleanup() // remove the temporary build directory
+		buildMeta = meta
 	}
 
 	if params.Filepath != "" {
@@ -223,6 +226,10 @@ func (r *Runner) Run(params *Params) error {
 	}
 
 	if params.Build != "" {
+		// Declare the wheel's own requirements so the solver pulls them in
+		// transitively, unless they were declared explicitly.
+		reqVars.Dependencies = mergeDependencies(reqVars.Dependencies, pythonDependencies(buildMeta.Dependencies))
+
 		// A --build publish must be ingested by the private-builder. Without a
 		// builder dependency the platform falls back to the noop-builder, which
 		// produces an empty artifact.
@@ -254,6 +261,7 @@ func (r *Runner) Run(params *Params) error {
 	}
 
 	cont, err := r.prompt.Confirm(
+		prompt.IDPublishConfirm,
 		"",
 		locale.Tl("uploadingredient_confirm", `Prepared the following ingredient:
 
@@ -479,7 +487,7 @@ func (r *Runner) OpenInEditor(pr *request.PublishVariables) error {
 	}
 
 	// Wait for confirmation
-	if _, err := r.prompt.Input("", locale.Tl("uploadingredient_edit_confirm", "Press enter when done editing"), ptr.To(""), nil); err != nil {
+	if _, err := r.prompt.Input(prompt.IDPublishEditDone, "", locale.Tl("uploadingredient_edit_confirm", "Press enter when done editing"), ptr.To(""), nil); err != nil {
 		return errs.Wrap(err, "Confirmation failed")
 	}
 
@@ -128,7 +128,7 @@ func (r *Push) Run(params PushParams) (rerr error) {
 
 	// Ask to create a copy if the user does not have org permissions
 	if intend&pushFromNoPermission > 0 && !params.Namespace.IsValid() {
-		createCopy, err := r.prompt.Confirm("", locale.T("push_prompt_not_authorized"), ptr.To(true), nil)
+		createCopy, err := r.prompt.Confirm(prompt.IDPushCreateCopy, "", locale.T("push_prompt_not_authorized"), ptr.To(true), nil)
 		if err != nil {
 			return errs.Wrap(err, "Not confirmed")
 		}
@@ -170,6 +170,7 @@ func (r *Push) Run(params PushParams) (rerr error) {
 		// If the user didn't necessarily intend to create the project we should ask them for confirmation
 		if intend&intendCreateProject == 0 {
 			createProject, err := r.prompt.Confirm(
+				prompt.IDPushCreateProject,
 				locale.Tl("create_project", "Create Project"),
 				locale.Tl("push_confirm_create_project", "You are about to create the project [NOTICE]{{.V0}}[/RESET]. Continue?", targetNamespace.String()),
 				ptr.To(true), nil)
@@ -325,7 +326,7 @@ func (r *Push) namespaceFromProject() (*project.Namespaced, error) {
 
 func (r *Push) promptNamespace() (*project.Namespaced, error) {
 	owner := r.auth.WhoAmI()
-	owner, err := r.prompt.Input("", locale.T("push_prompt_owner"), &owner, nil)
+	owner, err := r.prompt.Input(prompt.IDPushOwner, "", locale.T("push_prompt_owner"), &owner, nil)
 	if err != nil {
 		return nil, locale.WrapError(err, "err_push_get_owner", "Could not determine project owner")
 	}
@@ -341,7 +342,7 @@ func (r *Push) promptNamespace() (*project.Namespaced, error) {
 		logging.Debug("Error fetching language for commit: %v", err)
 	}
 
-	name, err = r.prompt.Input("", locale.Tl("push_prompt_name", "What would you like the name of this project to be?"), &name, nil)
+	name, err = r.prompt.Input(prompt.IDPushName, "", locale.Tl("push_prompt_name", "What would you like the name of this project to be?"), &name, nil)
 	if err != nil {
 		return nil, locale.WrapError(err, "err_push_get_name", "Could not determine project name")
 	}
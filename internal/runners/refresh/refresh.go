@@ -14,11 +14,14 @@ import (
 	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/runbits/buildscript"
 	"github.com/ActiveState/cli/internal/runbits/findproject"
+	"github.com/ActiveState/cli/internal/runbits/licenses"
 	"github.com/ActiveState/cli/internal/runbits/rationalize"
 	"github.com/ActiveState/cli/internal/runbits/runtime"
 	"github.com/ActiveState/cli/internal/runbits/runtime/trigger"
+	"github.com/ActiveState/cli/pkg/localcommit"
 	"github.com/ActiveState/cli/pkg/platform/authentication"
 	"github.com/ActiveState/cli/pkg/platform/model"
+	bpModel "github.com/ActiveState/cli/pkg/platform/model/buildplanner"
 	"github.com/ActiveState/cli/pkg/project"
 	"github.com/ActiveState/cli/pkg/projectfile"
 	"github.com/ActiveState/cli/pkg/runtime_helpers"
@@ -26,6 +29,7 @@ import (
 
 type Params struct {
 	Namespace *project.Namespaced
+	All       bool
 }
 
 type primeable interface {
@@ -66,6 +70,13 @@ func New(prime primeable) *Refresh {
 func (r *Refresh) Run(params *Params) error {
 	logging.Debug("Refresh %v", params.Namespace)
 
+	if params.All {
+		if params.Namespace.IsValid() {
+			return locale.NewInputError("err_refresh_all_namespace", "The '[ACTIONABLE]--all[/RESET]' flag cannot be combined with a project namespace.")
+		}
+		return r.runAll()
+	}
+
 	proj, err := findproject.FromInputByPriority("", params.Namespace, r.config, r.prompt)
 	if err != nil {
 		var errNoDefaultProject *projectfile.ErrorNoDefaultProject
@@ -75,33 +86,17 @@ func (r *Refresh) Run(params *Params) error {
 		return rationalize.ErrNoProject
 	}
 
-	r.prime.SetProject(proj)
-
 	r.out.Notice(locale.Tr("operating_message", proj.NamespaceString(), proj.Dir()))
 
-	needsUpdate, err := runtime_helpers.NeedsUpdate(proj, nil)
+	updated, execDir, err := r.refresh(proj)
 	if err != nil {
-		return errs.Wrap(err, "could not determine if runtime needs update")
-	}
-
-	if r.config.GetBool(constants.OptinBuildscriptsConfig) {
-		_, err := buildscript_runbit.ScriptFromProject(proj)
-		if errors.Is(err, buildscript_runbit.ErrBuildscriptNotExist) {
-			return locale.WrapInputError(err, locale.T("notice_needs_buildscript_reset"))
-		}
+		return errs.Wrap(err, "Could not refresh project")
 	}
-
-	if !needsUpdate {
+	if !updated {
 		r.out.Notice(locale.T("refresh_runtime_uptodate"))
 		return nil
 	}
 
-	rti, err := runtime_runbit.Update(r.prime, trigger.TriggerRefresh, runtime_runbit.WithoutHeaders(), runtime_runbit.WithIgnoreAsync())
-	if err != nil {
-		return locale.WrapError(err, "err_refresh_runtime_new", "Could not update runtime for this project.")
-	}
-
-	execDir := rti.Env(false).ExecutorsPath
 	r.out.Print(output.Prepare(
 		locale.Tr("refresh_project_statement", proj.NamespaceString(), proj.Dir(), execDir),
 		&struct {
@@ -116,3 +111,54 @@ func (r *Refresh) Run(params *Params) error {
 
 	return nil
 }
+
+// refresh updates the runtime of the given project if needed, and returns whether it did along with the path
+// to the runtime's executables.
+func (r *Refresh) refresh(proj *project.Project) (bool, string, error) {
+	r.prime.SetProject(proj)
+
+	needsUpdate, err := runtime_helpers.NeedsUpdate(proj, nil)
+	if err != nil {
+		return false, "", errs.Wrap(err, "could not determine if runtime needs update")
+	}
+
+	if r.config.GetBool(constants.OptinBuildscriptsConfig) {
+		_, err := buildscript_runbit.ScriptFromProject(proj)
+		if errors.Is(err, buildscript_runbit.ErrBuildscriptNotExist) {
+			return false, "", locale.WrapInputError(err, locale.T("notice_needs_buildscript_reset"))
+		}
+	}
+
+	if !needsUpdate {
+		return false, runtime_helpers.ExecutorPathFromProject(proj), nil
+	}
+
+	rtOpts := []runtime_runbit.SetOpt{runtime_runbit.WithoutHeaders(), runtime_runbit.WithIgnoreAsync()}
+
+	// Only solve ahead of the runtime update if there is a license policy to enforce.
+	policy, err := licenses.LoadPolicy(proj, r.config)
+	if err != nil {
+		return false, "", errs.Wrap(err, "Could not load license policy")
+	}
+	if policy != nil {
+		commitID, err := localcommit.Get(proj.Dir())
+		if err != nil {
+			return false, "", errs.Wrap(err, "Could not get local commit")
+		}
+		commit, err := bpModel.NewBuildPlannerModel(r.auth, r.svcModel).FetchCommit(commitID, proj.Owner(), proj.Name(), nil)
+		if err != nil {
+			return false, "", errs.Wrap(err, "Could not fetch commit")
+		}
+		if err := licenses.NewLicenseReport(r.prime).Report(commit.BuildPlan(), nil); err != nil {
+			return false, "", errs.Wrap(err, "Could not report license policy violations")
+		}
+		rtOpts = append(rtOpts, runtime_runbit.WithCommit(commit))
+	}
+
+	rti, err := runtime_runbit.Update(r.prime, trigger.TriggerRefresh, rtOpts...)
+	if err != nil {
+		return false, "", locale.WrapError(err, "err_refresh_runtime_new", "Could not update runtime for this project.")
+	}
+
+	return true, rti.Env(false).ExecutorsPath, nil
+}
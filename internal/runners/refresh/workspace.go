@@ -0,0 +1,107 @@
+package refresh
+
+import (
+	"strconv"
+
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/strutils"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/projectfile"
+	"github.com/ActiveState/cli/pkg/runtime"
+	"github.com/ActiveState/cli/pkg/runtime_helpers"
+)
+
+type memberOutput struct {
+	Member      string `json:"member" locale:"member,Member"`
+	Namespace   string `json:"namespace" locale:"namespace,Project"`
+	Status      string `json:"-" locale:"status,Status"`
+	Updated     bool   `json:"updated" opts:"hidePlain"`
+	Artifacts   int    `json:"artifacts" locale:"artifacts,Artifacts"`
+	Path        string `json:"path" opts:"hidePlain"`
+	Executables string `json:"executables" opts:"hidePlain"`
+}
+
+type workspaceOutput struct {
+	Workspace  string          `json:"workspace"`
+	Members    []*memberOutput `json:"members"`
+	Artifacts  int             `json:"artifacts"`
+	Shared     int             `json:"shared_artifacts"`
+	SavedBytes int64           `json:"saved_bytes"`
+}
+
+func (o *workspaceOutput) MarshalOutput(output.Format) interface{} {
+	return o.Members
+}
+
+func (o *workspaceOutput) MarshalStructured(output.Format) interface{} {
+	return o
+}
+
+// runAll refreshes the runtimes of all members of the workspace the working directory is in, and reports how
+// many of their artifacts are shared through the depot.
+func (r *Refresh) runAll() error {
+	wd, err := osutils.Getwd()
+	if err != nil {
+		return errs.Wrap(err, "Could not get working directory")
+	}
+	ws, err := projectfile.FindWorkspace(wd)
+	if err != nil {
+		return errs.Wrap(err, "Could not find workspace")
+	}
+	members, err := ws.Members()
+	if err != nil {
+		return errs.Wrap(err, "Could not get workspace members")
+	}
+
+	out := &workspaceOutput{Workspace: ws.Dir()}
+	runtimePaths := make([]string, len(members))
+	for i, dir := range members {
+		name := ws.MemberName(dir)
+		proj, err := project.FromPath(dir)
+		if err != nil {
+			return locale.WrapInputError(err, "err_refresh_member_project", "Could not load the project of workspace member [ACTIONABLE]{{.V0}}[/RESET].", name)
+		}
+
+		r.out.Notice(locale.Tr("operating_message", proj.NamespaceString(), proj.Dir()))
+		updated, execDir, err := r.refresh(proj)
+		if err != nil {
+			return locale.WrapError(err, "err_refresh_member", "Could not refresh workspace member [ACTIONABLE]{{.V0}}[/RESET].", name)
+		}
+
+		status := locale.Tl("refresh_member_uptodate", "Up to date")
+		if updated {
+			status = locale.Tl("refresh_member_updated", "Updated")
+		}
+		out.Members = append(out.Members, &memberOutput{
+			Member:      name,
+			Namespace:   proj.NamespaceString(),
+			Status:      status,
+			Updated:     updated,
+			Path:        proj.Dir(),
+			Executables: execDir,
+		})
+		runtimePaths[i] = runtime_helpers.TargetDirFromProject(proj)
+	}
+
+	sharing, err := runtime.DepotSharingFor(runtimePaths)
+	if err != nil {
+		return errs.Wrap(err, "Could not determine shared artifacts")
+	}
+	for i, m := range out.Members {
+		m.Artifacts = sharing.Artifacts[runtimePaths[i]]
+	}
+	out.Artifacts = sharing.Unique
+	out.Shared = sharing.Shared
+	out.SavedBytes = sharing.SavedBytes
+
+	r.out.Print(out)
+	r.out.Notice("")
+	r.out.Notice(locale.Tl("refresh_workspace_sharing",
+		"{{.V0}} unique artifacts across {{.V1}} projects, of which {{.V2}} are shared between projects, saving [ACTIONABLE]{{.V3}}[/RESET] of disk space.",
+		strconv.Itoa(sharing.Unique), strconv.Itoa(len(members)), strconv.Itoa(sharing.Shared), strutils.FormatSize(sharing.SavedBytes)))
+
+	return nil
+}
@@ -118,7 +118,7 @@ func (r *Reset) Run(params *Params) error {
 	r.out.Notice(locale.Tl("reset_commit", "Your project will be reset to [ACTIONABLE]{{.V0}}[/RESET]\n", commitID.String()))
 	if commitID != localCommitID {
 		defaultChoice := !r.prime.Prompt().IsInteractive()
-		confirm, err := r.prime.Prompt().Confirm("", locale.Tl("reset_confim", "Resetting is destructive. You will lose any changes that were not pushed. Are you sure you want to do this?"), &defaultChoice, ptr.To(true))
+		confirm, err := r.prime.Prompt().Confirm(prompt.IDResetConfirm, "", locale.Tl("reset_confim", "Resetting is destructive. You will lose any changes that were not pushed. Are you sure you want to do this?"), &defaultChoice, ptr.To(true))
 		if err != nil {
 			return errs.Wrap(err, "Not confirmed")
 		}
@@ -140,7 +140,7 @@ func (r *Revert) Run(params *Params) (rerr error) {
 	}
 
 	defaultChoice := !r.prime.Prompt().IsInteractive()
-	revert, err := r.prime.Prompt().Confirm("", locale.Tl("revert_confirm", "Continue?"), &defaultChoice, ptr.To(true))
+	revert, err := r.prime.Prompt().Confirm(prompt.IDRevertConfirm, "", locale.Tl("revert_confirm", "Continue?"), &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
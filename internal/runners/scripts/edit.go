@@ -233,11 +233,11 @@ func startNoninteractive(sw *scriptWatcher, scriptName string, output output.Out
 	return nil
 }
 
-func startInteractive(sw *scriptWatcher, scriptName string, output output.Outputer, cfg projectfile.ConfigGetter, proj *project.Project, prompt prompt.Prompter) error {
+func startInteractive(sw *scriptWatcher, scriptName string, output output.Outputer, cfg projectfile.ConfigGetter, proj *project.Project, prompter prompt.Prompter) error {
 	go sw.run(scriptName, output, cfg, proj)
 
 	for {
-		doneEditing, err := prompt.Confirm("", locale.T("prompt_done_editing"), ptr.To(true), nil)
+		doneEditing, err := prompter.Confirm(prompt.IDScriptsEditDone, "", locale.T("prompt_done_editing"), ptr.To(true), nil)
 		if err != nil {
 			return errs.Wrap(err, "Not confirmed")
 		}
@@ -21,19 +21,14 @@ func getSecret(proj *project.Project, namespace string, cfg keypairs.Configurabl
 	}
 	secretName := n[1]
 
+	if secret := proj.SecretByName(secretName, secretScope, cfg, auth); secret != nil {
+		return secret, nil
+	}
 	return proj.InitSecret(secretName, secretScope, cfg, auth), nil
 }
 
-func getSecretWithValue(proj *project.Project, name string, cfg keypairs.Configurable, auth *authentication.Auth) (*project.Secret, *string, error) {
-	secret, err := getSecret(proj, name, cfg, auth)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	val, err := secret.ValueOrNil()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return secret, val, nil
+// notPlatformError returns a directly usable localized error for operations that only apply to secrets stored by the
+// platform's secrets service.
+func notPlatformError(secret *project.Secret) error {
+	return locale.NewInputError("secrets_err_not_platform", "Secret [ACTIONABLE]{{.V0}}[/RESET] comes from the {{.V1}} provider, so its value must be managed there rather than with the State Tool.", secret.Name(), secret.Provider())
 }
@@ -52,11 +52,20 @@ func NewGet(p getPrimeable) *Get {
 // Run executes the get behavior.
 func (g *Get) Run(params GetRunParams) error {
 	g.out.Notice(locale.Tr("operating_message", g.proj.NamespaceString(), g.proj.Dir()))
-	if err := checkSecretsAccess(g.proj, g.auth); err != nil {
-		return locale.WrapError(err, "secrets_err_check_access")
+
+	secret, err := getSecret(g.proj, params.Name, g.cfg, g.auth)
+	if err != nil {
+		return locale.WrapError(err, "secrets_err_values")
+	}
+
+	// Secrets from other providers do not involve the platform, so they do not require access to its secrets.
+	if secret.IsPlatform() {
+		if err := checkSecretsAccess(g.proj, g.auth); err != nil {
+			return locale.WrapError(err, "secrets_err_check_access")
+		}
 	}
 
-	secret, valuePtr, err := getSecretWithValue(g.proj, params.Name, g.cfg, g.auth)
+	valuePtr, err := secret.ValueOrNil()
 	if err != nil {
 		return locale.WrapError(err, "secrets_err_values")
 	}
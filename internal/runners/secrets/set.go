@@ -46,14 +46,18 @@ func NewSet(p setPrimeable) *Set {
 // Run executes the set behavior.
 func (s *Set) Run(params SetRunParams) error {
 	s.out.Notice(locale.Tr("operating_message", s.proj.NamespaceString(), s.proj.Dir()))
-	if err := checkSecretsAccess(s.proj, s.auth); err != nil {
-		return locale.WrapError(err, "secrets_err_check_access")
-	}
 
 	secret, err := getSecret(s.proj, params.Name, s.cfg, s.auth)
 	if err != nil {
 		return locale.WrapError(err, "secrets_err_values")
 	}
+	if !secret.IsPlatform() {
+		return notPlatformError(secret)
+	}
+
+	if err := checkSecretsAccess(s.proj, s.auth); err != nil {
+		return locale.WrapError(err, "secrets_err_check_access")
+	}
 
 	org, err := model.FetchOrgByURLName(s.proj.Owner(), s.auth)
 	if err != nil {
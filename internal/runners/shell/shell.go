@@ -30,6 +30,7 @@ import (
 type Params struct {
 	Namespace       *project.Namespaced
 	ChangeDirectory bool
+	Workspace       bool
 }
 
 type primeable interface {
@@ -73,6 +74,13 @@ func New(prime primeable) *Shell {
 func (u *Shell) Run(params *Params) error {
 	logging.Debug("Shell %v", params.Namespace)
 
+	if params.Workspace {
+		if params.Namespace.IsValid() {
+			return locale.NewInputError("err_shell_workspace_namespace", "The '[ACTIONABLE]--workspace[/RESET]' flag cannot be combined with a project namespace.")
+		}
+		return u.runWorkspace(params)
+	}
+
 	proj, err := findproject.FromInputByPriority("", params.Namespace, u.config, u.prompt)
 	if err != nil {
 		var errNoDefaultProject *projectfile.ErrorNoDefaultProject
@@ -0,0 +1,86 @@
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/process"
+	"github.com/ActiveState/cli/internal/runbits/activation"
+	"github.com/ActiveState/cli/internal/runbits/runtime"
+	"github.com/ActiveState/cli/internal/runbits/runtime/trigger"
+	"github.com/ActiveState/cli/internal/virtualenvironment"
+	"github.com/ActiveState/cli/pkg/project"
+	"github.com/ActiveState/cli/pkg/projectfile"
+	rt "github.com/ActiveState/cli/pkg/runtime"
+)
+
+// runWorkspace starts a shell with the merged runtimes of all members of the workspace the working directory is
+// in. The member the working directory is in, or otherwise the first member, provides the project's scripts and
+// constants.
+func (u *Shell) runWorkspace(params *Params) error {
+	if process.IsActivated(u.config) {
+		activatedProjectNamespace := os.Getenv(constants.ActivatedStateNamespaceEnvVarName)
+		activatedProjectDir := os.Getenv(constants.ActivatedStateEnvVarName)
+		return locale.NewInputError("err_shell_already_active", "", activatedProjectNamespace, activatedProjectDir)
+	}
+
+	wd, err := osutils.Getwd()
+	if err != nil {
+		return errs.Wrap(err, "Could not get working directory")
+	}
+	ws, err := projectfile.FindWorkspace(wd)
+	if err != nil {
+		return errs.Wrap(err, "Could not find workspace")
+	}
+	members, err := ws.Members()
+	if err != nil {
+		return errs.Wrap(err, "Could not get workspace members")
+	}
+
+	var primary *project.Project
+	var runtimes []*rt.Runtime
+	var names []string
+	for _, dir := range members {
+		proj, err := project.FromPath(dir)
+		if err != nil {
+			return locale.WrapInputError(err, "err_shell_member_project", "Could not load the project of workspace member [ACTIONABLE]{{.V0}}[/RESET].", ws.MemberName(dir))
+		}
+		if primary == nil || isWithin(wd, dir) {
+			primary = proj
+		}
+
+		u.prime.SetProject(proj)
+		rti, err := runtime_runbit.Update(u.prime, trigger.TriggerShell, runtime_runbit.WithoutHeaders())
+		if err != nil {
+			return locale.WrapExternalError(err, "err_shell_member_runtime", "Could not update the runtime of workspace member [ACTIONABLE]{{.V0}}[/RESET].", ws.MemberName(dir))
+		}
+		runtimes = append(runtimes, rti)
+		names = append(names, ws.MemberName(dir))
+	}
+
+	u.prime.SetProject(primary)
+
+	u.out.Notice(locale.Tl("shell_workspace_statement",
+		"Opening shell for the workspace at [ACTIONABLE]{{.V0}}[/RESET], with the runtimes of: {{.V1}}.",
+		ws.Dir(), strings.Join(names, ", ")))
+
+	venv := virtualenvironment.NewMerged(runtimes...)
+	err = activation.ActivateAndWait(primary, venv, u.out, u.subshell, u.config, u.analytics, params.ChangeDirectory)
+	if err != nil {
+		return locale.WrapError(err, "err_shell_wait", "Could not start runtime shell/prompt.")
+	}
+
+	u.out.Notice(locale.T("info_deactivated", primary))
+
+	return nil
+}
+
+func isWithin(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,81 @@
+package show
+
+import (
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/runbits/rationalize"
+	"github.com/ActiveState/cli/pkg/project"
+)
+
+type constantOutput struct {
+	Name        string `json:"name" locale:"name,Name"`
+	Type        string `json:"type" locale:"type,Type"`
+	Value       string `json:"value" locale:"value,Value"`
+	Source      string `json:"source" locale:"source,Source"`
+	Description string `json:"description,omitempty" locale:"description,Description"`
+	Error       string `json:"error,omitempty" opts:"hidePlain"`
+}
+
+type constantsOutput struct {
+	Constants []*constantOutput
+}
+
+func (o *constantsOutput) MarshalOutput(output.Format) interface{} {
+	return o.Constants
+}
+
+func (o *constantsOutput) MarshalStructured(output.Format) interface{} {
+	return o.Constants
+}
+
+// Constants manages the show constants run execution context.
+type Constants struct {
+	project *project.Project
+	out     output.Outputer
+}
+
+// NewConstants returns a pointer to an instance of Constants.
+func NewConstants(prime primeable) *Constants {
+	return &Constants{prime.Project(), prime.Output()}
+}
+
+// Run lists the constants of the project, along with their resolved values and where those came from.
+func (c *Constants) Run() error {
+	if c.project == nil {
+		return rationalize.ErrNoProject
+	}
+
+	out := &constantsOutput{[]*constantOutput{}}
+	var invalid []string
+	for _, constant := range c.project.Constants() {
+		value, source, err := constant.Resolve()
+		row := &constantOutput{
+			Name:        constant.Name(),
+			Type:        constant.Type(),
+			Value:       value,
+			Source:      string(source),
+			Description: constant.Description(),
+		}
+		if err != nil {
+			row.Value = locale.Tl("show_constant_invalid", "[ERROR]invalid[/RESET]")
+			row.Error = errs.JoinMessage(err)
+			invalid = append(invalid, row.Error)
+		}
+		out.Constants = append(out.Constants, row)
+	}
+
+	if len(out.Constants) == 0 {
+		c.out.Notice(locale.Tl("show_constants_none", "The project does not define any constants."))
+		return nil
+	}
+
+	c.out.Print(out)
+
+	for _, msg := range invalid {
+		c.out.Notice("")
+		c.out.Notice(locale.Tl("show_constant_error", "[ERROR]x[/RESET] {{.V0}}", msg))
+	}
+
+	return nil
+}
@@ -35,6 +35,7 @@ type primeable interface {
 // Params tracks the info required for running Uninstall.
 type Params struct {
 	Packages captain.PackagesValue
+	DryRun   bool
 }
 
 type requirement struct {
@@ -136,6 +137,13 @@ func (u *Uninstall) Run(params Params) (rerr error) {
 	pg.Stop(locale.T("progress_success"))
 	pg = nil
 
+	if params.DryRun {
+		if err := reqop_runbit.DryRun(u.prime, script, oldCommit, locale.Tr("commit_message_removed", params.Packages.String()), reqs); err != nil {
+			return errs.Wrap(err, "Failed to solve changes")
+		}
+		return nil
+	}
+
 	// Update local checkout and source runtime changes
 	if err := reqop_runbit.UpdateAndReload(u.prime, script, oldCommit, locale.Tr("commit_message_removed", params.Packages.String()), trigger.TriggerUninstall); err != nil {
 		return errs.Wrap(err, "Failed to update local checkout")
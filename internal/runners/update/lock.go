@@ -132,7 +132,7 @@ func confirmLock(prom prompt.Prompter) error {
 	defaultChoice := !prom.IsInteractive()
 	msg := locale.T("confirm_update_locked_version_prompt")
 
-	confirmed, err := prom.Confirm(locale.T("confirm"), msg, &defaultChoice, ptr.To(true))
+	confirmed, err := prom.Confirm(prompt.IDUpdateLock, locale.T("confirm"), msg, &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
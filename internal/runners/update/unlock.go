@@ -74,7 +74,7 @@ func confirmUnlock(prom prompt.Prompter) error {
 	msg := locale.T("confirm_update_unlocked_version_prompt")
 
 	defaultChoice := !prom.IsInteractive()
-	confirmed, err := prom.Confirm(locale.T("confirm"), msg, &defaultChoice, ptr.To(true))
+	confirmed, err := prom.Confirm(prompt.IDUpdateUnlock, locale.T("confirm"), msg, &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
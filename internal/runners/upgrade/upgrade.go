@@ -12,6 +12,7 @@ import (
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/output"
 	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/rtutils/ptr"
 	"github.com/ActiveState/cli/internal/runbits/commits_runbit"
 	"github.com/ActiveState/cli/internal/runbits/rationalize"
@@ -283,7 +284,7 @@ func (u *Upgrade) renderUserFacing(changes []structuredChange, expand bool) erro
 	out.Print(tbl.Render())
 
 	out.Notice(" ") // Empty line (prompts use Notice)
-	confirm, err := u.prime.Prompt().Confirm("", locale.Tr("upgrade_confirm"), ptr.To(true), nil)
+	confirm, err := u.prime.Prompt().Confirm(prompt.IDUpgradeConfirm, "", locale.Tr("upgrade_confirm"), ptr.To(true), nil)
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
 	}
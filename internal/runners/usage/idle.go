@@ -0,0 +1,60 @@
+package usage
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/pkg/platform/model"
+)
+
+type IdleParams struct {
+	Days int
+}
+
+type Idle struct {
+	out      output.Outputer
+	svcModel *model.SvcModel
+}
+
+func NewIdle(prime primeable) *Idle {
+	return &Idle{
+		prime.Output(),
+		prime.SvcModel(),
+	}
+}
+
+type idleOutput struct {
+	Project  string `locale:"project,[HEADING]Project[/RESET]"`
+	Checkout string `locale:"checkout,[HEADING]Checkout[/RESET]"`
+	LastUsed string `locale:"last_used,[HEADING]Last Used[/RESET]"`
+}
+
+func (i *Idle) Run(params *IdleParams) error {
+	if params.Days < 1 {
+		return locale.NewInputError("err_usage_idle_days", "The number of days must be at least 1.")
+	}
+
+	idle, err := i.svcModel.IdleRuntimes(context.Background(), params.Days)
+	if err != nil {
+		return locale.WrapError(err, "err_usage_idle_fetch", "Could not retrieve idle runtimes from the State Tool service.")
+	}
+
+	if len(idle) == 0 {
+		i.out.Print(output.Prepare(
+			locale.Tl("usage_idle_empty", "No runtimes have been idle for {{.V0}} days.", strconv.Itoa(params.Days)),
+			idle,
+		))
+		return nil
+	}
+
+	rows := make([]idleOutput, 0, len(idle))
+	for _, rt := range idle {
+		rows = append(rows, idleOutput{rt.Namespace, rt.ProjectPath, formatTime(rt.LastUsed)})
+	}
+	i.out.Print(output.Prepare(rows, idle))
+	i.out.Notice("")
+	i.out.Notice(locale.Tl("usage_idle_clean", "To reclaim the disk space of an idle runtime, run '[ACTIONABLE]state clean cache <org/project>[/RESET]'."))
+	return nil
+}
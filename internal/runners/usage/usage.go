@@ -0,0 +1,75 @@
+package usage
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/primer"
+	"github.com/ActiveState/cli/pkg/platform/model"
+)
+
+type primeable interface {
+	primer.Outputer
+	primer.SvcModeler
+}
+
+type Usage struct {
+	out      output.Outputer
+	svcModel *model.SvcModel
+}
+
+func New(prime primeable) *Usage {
+	return &Usage{
+		prime.Output(),
+		prime.SvcModel(),
+	}
+}
+
+type usageOutput struct {
+	Project    string `locale:"project,[HEADING]Project[/RESET]"`
+	Executable string `locale:"executable,[HEADING]Executable[/RESET]"`
+	Uses       int    `locale:"uses,[HEADING]Uses[/RESET]"`
+	LastUsed   string `locale:"last_used,[HEADING]Last Used[/RESET]"`
+}
+
+func (u *Usage) Run() error {
+	usage, err := u.svcModel.RuntimeUsage(context.Background())
+	if err != nil {
+		return locale.WrapError(err, "err_usage_fetch", "Could not retrieve runtime usage from the State Tool service.")
+	}
+
+	rows := make([]usageOutput, 0, len(usage))
+	for _, entry := range usage {
+		project := entry.Namespace
+		if project == "" {
+			project = locale.Tl("usage_unknown_project", "Unknown")
+		}
+		lastUsed := formatTime(entry.LastUsed)
+		if entry.Running {
+			lastUsed = locale.Tl("usage_running", "In use")
+		}
+		rows = append(rows, usageOutput{project, filepath.Base(entry.Exec), entry.Count, lastUsed})
+	}
+
+	var plainOutput interface{} = rows
+	if len(rows) == 0 {
+		plainOutput = locale.Tl("usage_empty", "No runtime usage has been recorded yet.")
+	}
+	u.out.Print(output.Prepare(plainOutput, usage))
+	return nil
+}
+
+// formatTime formats an RFC3339 time reported by the State Tool service for display
+func formatTime(value string) string {
+	if value == "" {
+		return locale.Tl("usage_never", "Never")
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return t.Local().Format(time.DateTime)
+}
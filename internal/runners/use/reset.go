@@ -41,7 +41,7 @@ func (u *Reset) Run(params *ResetParams) error {
 	}
 
 	defaultChoice := !u.prompt.IsInteractive()
-	ok, err := u.prompt.Confirm(locale.T("confirm"),
+	ok, err := u.prompt.Confirm(prompt.IDUseReset, locale.T("confirm"),
 		locale.Tl("use_reset_confirm", "You are about to stop using your project runtime. Continue?"), &defaultChoice, ptr.To(true))
 	if err != nil {
 		return errs.Wrap(err, "Not confirmed")
@@ -0,0 +1,18 @@
+package providers
+
+import (
+	"os"
+
+	"github.com/ActiveState/cli/internal/locale"
+)
+
+// envProvider reads secrets from environment variables. References are variable names.
+type envProvider struct{}
+
+func (p *envProvider) Value(ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", locale.NewInputError("err_secrets_env_unset", "The environment variable [ACTIONABLE]{{.V0}}[/RESET] is not set.", ref)
+	}
+	return value, nil
+}
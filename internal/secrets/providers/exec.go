@@ -0,0 +1,84 @@
+package providers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"runtime"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/prompt"
+	"github.com/ActiveState/cli/internal/rtutils/ptr"
+)
+
+// execProvider obtains secrets from helper commands, such as `op read op://vault/item/field` or `pass show name`.
+// References are command lines, which are run by the system shell. The secret is what the command prints to stdout.
+//
+// As the command comes from the activestate.yaml, which may have been cloned from anywhere, it is only run once the
+// user trusted it for the project. Trust is recorded in the config, so that a changed command is asked about again.
+type execProvider struct {
+	cfg    Configurable
+	prompt prompt.Prompter
+	dir    string
+}
+
+func (p *execProvider) Value(ref string) (string, error) {
+	if err := p.trust(ref); err != nil {
+		return "", err
+	}
+
+	var out string
+	var err error
+	if runtime.GOOS == "windows" {
+		out, err = run(p.dir, "cmd", "/C", ref)
+	} else {
+		out, err = run(p.dir, "sh", "-c", ref)
+	}
+	if err != nil {
+		return "", err
+	}
+	return trimNewline(out), nil
+}
+
+// trust returns an error unless the user trusts running the given command in the project, asking them if they have
+// not been asked yet. Only an explicit yes is trust: declining, non-interactive use and --force all refuse to run it.
+func (p *execProvider) trust(command string) error {
+	hash := trustHash(p.dir, command)
+	trusted := strings.Split(p.cfg.GetString(constants.SecretsExecTrustedConfig), ",")
+	for _, h := range trusted {
+		if h == hash {
+			return nil
+		}
+	}
+
+	if p.prompt == nil {
+		return locale.NewInputError("err_secrets_exec_untrusted", "This secret is obtained by running [ACTIONABLE]{{.V0}}[/RESET], which you have not trusted yet. Run a command that uses the secret in an interactive terminal, such as '[ACTIONABLE]state run[/RESET]', to review and trust it.", command)
+	}
+
+	ok, err := p.prompt.Confirm(prompt.IDSecretExecTrust, locale.Tl("secrets_exec_trust_title", "Trust Secret Command"),
+		locale.Tl("secrets_exec_trust", "The project at [ACTIONABLE]{{.V0}}[/RESET] obtains a secret by running:\n\n  [ACTIONABLE]{{.V1}}[/RESET]\n\nOnly trust commands from projects you trust. Do you want to run it?", p.dir, command),
+		ptr.To(false), nil)
+	if err != nil {
+		return errs.Wrap(err, "Could not confirm trusting the secret command")
+	}
+	if !ok {
+		return locale.NewInputError("err_secrets_exec_not_trusted", "Not running [ACTIONABLE]{{.V0}}[/RESET] to obtain the secret, as you did not trust it.", command)
+	}
+
+	if trusted[0] == "" {
+		trusted = nil
+	}
+	if err := p.cfg.Set(constants.SecretsExecTrustedConfig, strings.Join(append(trusted, hash), ",")); err != nil {
+		return errs.Wrap(err, "Could not save the trusted secret command")
+	}
+	return nil
+}
+
+// trustHash identifies a command of the project in the given directory, without storing the command itself (which may
+// contain sensitive arguments) in the config.
+func trustHash(dir, command string) string {
+	h := sha256.Sum256([]byte(dir + "\x00" + command))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,101 @@
+package providers
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/locale"
+)
+
+// fileProvider reads secrets from local files, which are decrypted with age or GPG if they have an .age, .gpg or .asc
+// extension. References are of the form "<path>#<key>", where path is relative to the project and must not lead out of
+// it, so that a cloned project cannot read (or have age or GPG decrypt) arbitrary files of the user. Without a
+// key the secret is the entire (decrypted) file, otherwise the file is parsed as a YAML (or JSON) map and the secret
+// is the value of the given key.
+type fileProvider struct {
+	cfg Configurable
+	dir string
+}
+
+func (p *fileProvider) Value(ref string) (string, error) {
+	path, key := splitRef(ref)
+	path, err := p.resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := p.read(path)
+	if err != nil {
+		return "", err
+	}
+
+	if key == "" {
+		return trimNewline(content), nil
+	}
+	return valueOfKey(content, key, path)
+}
+
+// resolve returns the path of the given secrets file, which must be within the project, also after following symlinks.
+func (p *fileProvider) resolve(path string) (string, error) {
+	if !filepath.IsLocal(path) {
+		return "", locale.NewInputError("err_secrets_file_outside", "The secrets file [ACTIONABLE]{{.V0}}[/RESET] is not within the project. Secrets files must be referenced relative to the project directory.", path)
+	}
+	path = filepath.Join(p.dir, path)
+	if !fileutils.FileExists(path) {
+		return "", locale.NewInputError("err_secrets_file_not_found", "The secrets file [ACTIONABLE]{{.V0}}[/RESET] does not exist.", path)
+	}
+
+	inside, err := fileutils.PathContainsParent(path, p.dir)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not resolve secrets file")
+	}
+	if !inside {
+		return "", locale.NewInputError("err_secrets_file_outside_link", "The secrets file [ACTIONABLE]{{.V0}}[/RESET] links to a file outside of the project.", path)
+	}
+	return path, nil
+}
+
+func (p *fileProvider) read(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".age":
+		identity := p.cfg.GetString(constants.SecretsAgeIdentityConfig)
+		if identity == "" {
+			return "", locale.NewInputError("err_secrets_age_identity", "Decrypting [ACTIONABLE]{{.V0}}[/RESET] requires an age identity. Configure it with '[ACTIONABLE]state config set {{.V1}} <path-to-identity-file>[/RESET]'.", path, constants.SecretsAgeIdentityConfig)
+		}
+		return run(p.dir, "age", "--decrypt", "--identity", identity, path)
+	case ".gpg", ".asc":
+		return run(p.dir, "gpg", "--quiet", "--batch", "--decrypt", path)
+	}
+
+	b, err := fileutils.ReadFile(path)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not read secrets file")
+	}
+	return string(b), nil
+}
+
+func valueOfKey(content, key, path string) (string, error) {
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(content), &values); err != nil {
+		return "", locale.WrapInputError(err, "err_secrets_file_parse", "Could not parse [ACTIONABLE]{{.V0}}[/RESET] as a map of keys to secrets: {{.V1}}", path, err.Error())
+	}
+
+	value, ok := values[key]
+	if !ok {
+		return "", locale.NewInputError("err_secrets_file_key", "The secrets file [ACTIONABLE]{{.V0}}[/RESET] does not contain the key [ACTIONABLE]{{.V1}}[/RESET].", path, key)
+	}
+	switch v := value.(type) {
+	case map[string]interface{}, []interface{}:
+		return "", locale.NewInputError("err_secrets_file_key_scalar", "The value of [ACTIONABLE]{{.V0}}[/RESET] in [ACTIONABLE]{{.V1}}[/RESET] is not a string.", key, path)
+	case nil:
+		return "", nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
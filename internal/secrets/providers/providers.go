@@ -0,0 +1,101 @@
+// Package providers resolves the values of secrets that the activestate.yaml declares as coming from somewhere other
+// than the platform's secrets service: an environment variable, a local encrypted file, a helper command (eg. the
+// 1Password or pass CLI), or a Vault-compatible HTTP endpoint.
+package providers
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+	configMediator "github.com/ActiveState/cli/internal/mediators/config"
+	"github.com/ActiveState/cli/internal/prompt"
+)
+
+func init() {
+	configMediator.RegisterOption(constants.SecretsAgeIdentityConfig, configMediator.String, "")
+	configMediator.RegisterOption(constants.SecretsVaultAddressConfig, configMediator.String, "", "VAULT_ADDR")
+}
+
+// The providers a secret can come from.
+const (
+	Platform = "platform"
+	Env      = "env"
+	File     = "file"
+	Exec     = "exec"
+	Vault    = "vault"
+)
+
+// Names lists the supported providers.
+var Names = []string{Platform, Env, File, Exec, Vault}
+
+// Configurable is the subset of the config instance providers use.
+type Configurable interface {
+	GetString(key string) string
+	Set(key string, value interface{}) error
+}
+
+// Provider resolves secret values.
+type Provider interface {
+	// Value returns the value the given reference resolves to. What a reference looks like depends on the provider.
+	Value(ref string) (string, error)
+}
+
+// IsPlatform returns whether the named provider is the platform's secrets service, which is the default.
+func IsPlatform(name string) bool {
+	return name == "" || name == Platform
+}
+
+// New returns the named provider. Files are read from, and commands run in, projectDir. The prompter asks the user
+// whether to trust the commands of exec secrets, which fail if it is nil and the command is not trusted yet.
+func New(name string, cfg Configurable, prompter prompt.Prompter, projectDir string) (Provider, error) {
+	switch name {
+	case Env:
+		return &envProvider{}, nil
+	case File:
+		return &fileProvider{cfg, projectDir}, nil
+	case Exec:
+		return &execProvider{cfg, prompter, projectDir}, nil
+	case Vault:
+		return newVaultProvider(cfg), nil
+	}
+	return nil, locale.NewInputError("err_secrets_provider_unknown", "Unknown secrets provider: [ACTIONABLE]{{.V0}}[/RESET]. Valid providers are: {{.V1}}.", name, strings.Join(Names, ", "))
+}
+
+// splitRef splits a reference of the form "<location>#<key>" into its location and (optional) key.
+func splitRef(ref string) (string, string) {
+	location, key, _ := strings.Cut(ref, "#")
+	return location, key
+}
+
+// run runs the given command and returns what it wrote to stdout. Whatever it wrote to stderr is included in the error
+// if it fails.
+func run(dir string, name string, args ...string) (string, error) {
+	if _, err := exec.LookPath(name); err != nil {
+		return "", locale.WrapInputError(err, "err_secrets_provider_command_not_found", "Could not find [ACTIONABLE]{{.V0}}[/RESET] on your PATH, which is needed to obtain this secret.", name)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", locale.WrapExternalError(errs.Wrap(err, "Command failed"), "err_secrets_provider_command", "The command used to obtain this secret failed: {{.V0}}", msg)
+	}
+
+	return stdout.String(), nil
+}
+
+// trimNewline removes the line ending that commands and files typically end their output with, as it is never meant
+// to be part of a secret.
+func trimNewline(v string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(v, "\n"), "\r")
+}
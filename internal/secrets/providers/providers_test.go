@@ -0,0 +1,222 @@
+package providers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/prompt"
+)
+
+type fakeConfig map[string]string
+
+func (c fakeConfig) GetString(key string) string { return c[key] }
+
+func (c fakeConfig) Set(key string, value interface{}) error {
+	c[key] = value.(string)
+	return nil
+}
+
+// fakePrompter answers confirmations with the given answer, and counts how often it was asked.
+type fakePrompter struct {
+	prompt.Prompter
+	answer bool
+	asked  int
+}
+
+func (p *fakePrompter) Confirm(id, title, message string, defaultChoice *bool, forcedChoice *bool) (bool, error) {
+	p.asked++
+	return p.answer, nil
+}
+
+func TestNew(t *testing.T) {
+	for _, name := range []string{Env, File, Exec, Vault} {
+		p, err := New(name, fakeConfig{}, nil, t.TempDir())
+		require.NoError(t, err, name)
+		assert.NotNil(t, p, name)
+	}
+
+	_, err := New("keychain", fakeConfig{}, nil, t.TempDir())
+	assert.Error(t, err)
+
+	assert.True(t, IsPlatform(""))
+	assert.True(t, IsPlatform(Platform))
+	assert.False(t, IsPlatform(Env))
+}
+
+func TestEnv(t *testing.T) {
+	t.Setenv("ACTIVESTATE_TEST_SECRET", "s3cr3t")
+	p := &envProvider{}
+
+	v, err := p.Value("ACTIVESTATE_TEST_SECRET")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", v)
+
+	_, err = p.Value("ACTIVESTATE_TEST_SECRET_UNSET")
+	assert.Error(t, err)
+}
+
+func TestExec(t *testing.T) {
+	cfg := fakeConfig{}
+	prompter := &fakePrompter{answer: true}
+	p := &execProvider{cfg, prompter, t.TempDir()}
+
+	v, err := p.Value("echo s3cr3t")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", v)
+	assert.Equal(t, 1, prompter.asked)
+
+	v, err = p.Value("echo s3cr3t")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", v)
+	assert.Equal(t, 1, prompter.asked, "trusted commands are not asked about again")
+
+	_, err = p.Value("exit 3")
+	assert.Error(t, err)
+	assert.Equal(t, 2, prompter.asked)
+}
+
+func TestExec_Untrusted(t *testing.T) {
+	dir := t.TempDir()
+	marker := filepath.Join(dir, "ran")
+	command := "echo s3cr3t > ran"
+
+	p := &execProvider{fakeConfig{}, nil, dir}
+	_, err := p.Value(command)
+	assert.Error(t, err, "untrusted commands do not run without a prompter")
+
+	prompter := &fakePrompter{answer: false}
+	p = &execProvider{fakeConfig{}, prompter, dir}
+	_, err = p.Value(command)
+	assert.Error(t, err, "declined commands do not run")
+	assert.NoFileExists(t, marker)
+
+	// Trust is for a command of a project, so neither another command nor another project is trusted.
+	cfg := fakeConfig{}
+	p = &execProvider{cfg, &fakePrompter{answer: true}, dir}
+	_, err = p.Value("echo s3cr3t")
+	require.NoError(t, err)
+	_, err = (&execProvider{cfg, nil, dir}).Value(command)
+	assert.Error(t, err)
+	_, err = (&execProvider{cfg, nil, t.TempDir()}).Value("echo s3cr3t")
+	assert.Error(t, err)
+	assert.NoFileExists(t, marker)
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("s3cr3t\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secrets.yaml"), []byte("db: hunter2\nport: 5432\nnested:\n  a: b\n"), 0600))
+	p := &fileProvider{fakeConfig{}, dir}
+
+	v, err := p.Value("token")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", v)
+
+	v, err = p.Value("./secrets.yaml#db")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", v)
+
+	v, err = p.Value("secrets.yaml#port")
+	require.NoError(t, err)
+	assert.Equal(t, "5432", v)
+
+	_, err = p.Value("secrets.yaml#nested")
+	assert.Error(t, err, "maps are not secrets")
+	_, err = p.Value("secrets.yaml#missing")
+	assert.Error(t, err)
+	_, err = p.Value("missing.yaml")
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token.age"), []byte("encrypted"), 0600))
+	_, err = p.Value("token.age")
+	assert.Error(t, err, "age files require an identity")
+}
+
+func TestFile_Outside(t *testing.T) {
+	outside := t.TempDir()
+	secret := filepath.Join(outside, "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("s3cr3t"), 0600))
+	dir := filepath.Join(outside, "project")
+	require.NoError(t, os.Mkdir(dir, 0700))
+	p := &fileProvider{fakeConfig{}, dir}
+
+	for _, ref := range []string{secret, "../secret", "sub/../../secret"} {
+		_, err := p.Value(ref)
+		assert.Error(t, err, "files outside of the project are refused: %s", ref)
+	}
+
+	if runtime.GOOS == "windows" {
+		return // creating symlinks requires privileges
+	}
+	require.NoError(t, os.Symlink(secret, filepath.Join(dir, "link")))
+	_, err := p.Value("link")
+	assert.Error(t, err, "links to files outside of the project are refused")
+}
+
+func TestVault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "t0ken" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/myapp":
+			w.Write([]byte(`{"data":{"data":{"password":"kv2"},"metadata":{"version":1}}}`))
+		case "/v1/kv/myapp":
+			w.Write([]byte(`{"data":{"password":"kv1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	t.Setenv("VAULT_TOKEN", "t0ken")
+	p := newVaultProvider(fakeConfig{constants.SecretsVaultAddressConfig: srv.URL})
+
+	v, err := p.Value("secret/data/myapp#password")
+	require.NoError(t, err)
+	assert.Equal(t, "kv2", v)
+
+	v, err = p.Value("kv/myapp#password")
+	require.NoError(t, err)
+	assert.Equal(t, "kv1", v)
+
+	_, err = p.Value("kv/myapp#username")
+	assert.Error(t, err)
+	_, err = p.Value("kv/other#password")
+	assert.Error(t, err)
+	_, err = p.Value("kv/myapp")
+	assert.Error(t, err, "references require a key")
+
+	t.Setenv("VAULT_TOKEN", "wrong")
+	_, err = p.Value("kv/myapp#password")
+	assert.Error(t, err)
+}
+
+func TestVault_Address(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Redirect(w, r, "https://example.com/v1/kv/myapp", http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+	t.Setenv("VAULT_TOKEN", "t0ken")
+
+	_, err := newVaultProvider(fakeConfig{constants.SecretsVaultAddressConfig: srv.URL}).Value("kv/myapp#password")
+	assert.Error(t, err, "redirects are not followed")
+	assert.Equal(t, 1, requests)
+
+	for _, addr := range []string{"http://vault.example.com", "vault.example.com", "ftp://vault.example.com"} {
+		_, err := newVaultProvider(fakeConfig{constants.SecretsVaultAddressConfig: addr}).Value("kv/myapp#password")
+		assert.Error(t, err, "the token is only sent over HTTPS: %s", addr)
+	}
+	assert.Equal(t, 1, requests)
+}
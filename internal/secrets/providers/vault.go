@@ -0,0 +1,177 @@
+package providers
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ActiveState/cli/internal/config"
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/locale"
+)
+
+const (
+	vaultTokenEnvVarName     = "VAULT_TOKEN"
+	vaultNamespaceEnvVarName = "VAULT_NAMESPACE"
+	vaultTimeout             = 30 * time.Second
+	vaultMaxResponseBytes    = 1 << 20
+)
+
+// vaultProvider reads secrets from a Vault-compatible HTTP API. References are of the form "<path>#<key>", where path
+// is the path of the secret below /v1/ (eg. "secret/data/myapp" for a KV version 2 engine mounted at "secret"), and
+// key is the field of the secret to use. The token is read from VAULT_TOKEN, or ~/.vault-token as written by
+// `vault login`.
+//
+// As the token is sent to it, the address of the server is pinned to what the user (or an administrator) configured,
+// and must use HTTPS unless the server runs locally.
+type vaultProvider struct {
+	cfg    Configurable
+	client *http.Client
+}
+
+// originLookup is implemented by the config instance, and tells where the value of a config key comes from.
+type originLookup interface {
+	Lookup(key string) (interface{}, config.Origin)
+}
+
+func newVaultProvider(cfg Configurable) *vaultProvider {
+	return &vaultProvider{cfg, &http.Client{
+		Timeout: vaultTimeout,
+		// Redirects would forward the token to wherever the server points at.
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}}
+}
+
+func (p *vaultProvider) Value(ref string) (string, error) {
+	path, key := splitRef(ref)
+	if path == "" || key == "" {
+		return "", locale.NewInputError("err_secrets_vault_ref", "Vault secrets must be referenced as [ACTIONABLE]<path>#<key>[/RESET], got: {{.V0}}", ref)
+	}
+
+	addr, err := p.address()
+	if err != nil {
+		return "", err
+	}
+
+	token, err := vaultToken()
+	if err != nil {
+		return "", err
+	}
+
+	u := strings.TrimRight(addr, "/") + "/v1/" + strings.TrimLeft(path, "/")
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not build Vault request")
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if ns := os.Getenv(vaultNamespaceEnvVarName); ns != "" {
+		req.Header.Set("X-Vault-Namespace", ns)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", locale.WrapExternalError(err, "err_secrets_vault_request", "Could not reach Vault at [ACTIONABLE]{{.V0}}[/RESET].", addr)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", locale.NewInputError("err_secrets_vault_not_found", "Vault does not have a secret at [ACTIONABLE]{{.V0}}[/RESET].", path)
+	case resp.StatusCode == http.StatusForbidden:
+		return "", locale.NewInputError("err_secrets_vault_forbidden", "Vault denied access to [ACTIONABLE]{{.V0}}[/RESET]. Check that your token is valid and allowed to read it.", path)
+	case resp.StatusCode != http.StatusOK:
+		return "", locale.NewExternalError("err_secrets_vault_status", "Vault responded with status {{.V0}} for [ACTIONABLE]{{.V1}}[/RESET].", strconv.Itoa(resp.StatusCode), path)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, vaultMaxResponseBytes))
+	if err != nil {
+		return "", errs.Wrap(err, "Could not read Vault response")
+	}
+
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", errs.Wrap(err, "Could not parse Vault response")
+	}
+
+	// KV version 2 engines nest the secret's fields under data.data, with its metadata alongside it.
+	fields := secret.Data
+	if nested, ok := fields["data"].(map[string]interface{}); ok {
+		if _, ok := fields["metadata"]; ok {
+			fields = nested
+		}
+	}
+
+	value, ok := fields[key]
+	if !ok {
+		return "", locale.NewInputError("err_secrets_vault_key", "The Vault secret at [ACTIONABLE]{{.V0}}[/RESET] does not have the field [ACTIONABLE]{{.V1}}[/RESET].", path, key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", errs.Wrap(err, "Could not marshal Vault field")
+	}
+	return string(b), nil
+}
+
+// address returns the configured address of the Vault server, which the project cannot set.
+func (p *vaultProvider) address() (string, error) {
+	addr := p.cfg.GetString(constants.SecretsVaultAddressConfig)
+	if addr == "" {
+		return "", locale.NewInputError("err_secrets_vault_addr", "The Vault address is not configured. Set the [ACTIONABLE]VAULT_ADDR[/RESET] environment variable, or run '[ACTIONABLE]state config set {{.V0}} <address>[/RESET]'.", constants.SecretsVaultAddressConfig)
+	}
+	if l, ok := p.cfg.(originLookup); ok {
+		if _, origin := l.Lookup(constants.SecretsVaultAddressConfig); origin.Layer == config.LayerProject {
+			return "", locale.NewInputError("err_secrets_vault_addr_project", "The Vault address cannot be set by the project. Set the [ACTIONABLE]VAULT_ADDR[/RESET] environment variable, or run '[ACTIONABLE]state config set {{.V0}} <address>[/RESET]'.", constants.SecretsVaultAddressConfig)
+		}
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil || u.Host == "" {
+		return "", locale.NewInputError("err_secrets_vault_addr_invalid", "The Vault address [ACTIONABLE]{{.V0}}[/RESET] is not a valid URL.", addr)
+	}
+	if u.Scheme != "https" && !(u.Scheme == "http" && isLoopback(u.Hostname())) {
+		return "", locale.NewInputError("err_secrets_vault_addr_insecure", "The Vault address [ACTIONABLE]{{.V0}}[/RESET] must use HTTPS, so that your token is not sent in the clear.", addr)
+	}
+	return addr, nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func vaultToken() (string, error) {
+	if token := os.Getenv(vaultTokenEnvVarName); token != "" {
+		return token, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err == nil {
+		tokenFile := filepath.Join(home, ".vault-token")
+		if fileutils.FileExists(tokenFile) {
+			b, err := fileutils.ReadFile(tokenFile)
+			if err != nil {
+				return "", errs.Wrap(err, "Could not read Vault token")
+			}
+			return strings.TrimSpace(string(b)), nil
+		}
+	}
+
+	return "", locale.NewInputError("err_secrets_vault_token", "No Vault token was found. Set the [ACTIONABLE]VAULT_TOKEN[/RESET] environment variable, or log in with '[ACTIONABLE]vault login[/RESET]'.")
+}
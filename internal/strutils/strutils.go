@@ -2,6 +2,7 @@ package strutils
 
 import (
 	"bytes"
+	"fmt"
 	"regexp"
 	"strings"
 	"text/template"
@@ -40,3 +41,17 @@ func Summarize(v string, maxLen int) string {
 	v = strings.Replace(v, "\n", " ", -1)
 	return v
 }
+
+// FormatSize formats the given number of bytes in a human readable form, eg. 1.5 KiB.
+func FormatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,209 @@
+package elvish
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/osutils/user"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/subshell/sscommon"
+	"github.com/ActiveState/cli/pkg/project"
+)
+
+var escaper *osutils.ShellEscape
+
+func init() {
+	escaper = osutils.NewBackslashEscaper()
+}
+
+// SubShell covers the subshell.SubShell interface, reference that for documentation
+type SubShell struct {
+	binary string
+	rcFile *os.File
+	cmd    *exec.Cmd
+	env    map[string]string
+	errs   chan error
+}
+
+const Name string = "elvish"
+
+// Shell - see subshell.SubShell
+func (v *SubShell) Shell() string {
+	return Name
+}
+
+// Binary - see subshell.SubShell
+func (v *SubShell) Binary() string {
+	return v.binary
+}
+
+// SetBinary - see subshell.SubShell
+func (v *SubShell) SetBinary(binary string) {
+	v.binary = binary
+}
+
+// WriteUserEnv - see subshell.SubShell
+func (v *SubShell) WriteUserEnv(cfg sscommon.Configurable, env map[string]string, envType sscommon.RcIdentification, _ bool) error {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "RcFile failure")
+	}
+
+	return sscommon.WriteRcFile("elvishrc_append.elv", rcFile, envType, escapeEnv(env))
+}
+
+func (v *SubShell) CleanUserEnv(cfg sscommon.Configurable, envType sscommon.RcIdentification, _ bool) error {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "RcFile failure")
+	}
+
+	if err := sscommon.CleanRcFile(rcFile, envType); err != nil {
+		return errs.Wrap(err, "Failed to remove %s from rcFile", envType)
+	}
+
+	return nil
+}
+
+func (v *SubShell) RemoveLegacyInstallPath(cfg sscommon.Configurable) error {
+	// Elvish was never supported by the legacy install scripts
+	return nil
+}
+
+// WriteCompletionScript writes the completions to the rc file, as elvish has no directory it loads completions from.
+func (v *SubShell) WriteCompletionScript(completionScript string) error {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "RcFile failure")
+	}
+
+	err = sscommon.WriteRcData(completionScript, rcFile, sscommon.CompletionsID)
+	if err != nil {
+		logging.Debug("Could not write completions script to '%s': %v", rcFile, err)
+	}
+
+	return nil
+}
+
+// RcFile returns the path of rc.elv, preferring the legacy location if it is in use.
+func (v *SubShell) RcFile() (string, error) {
+	homeDir, err := user.HomeDir()
+	if err != nil {
+		return "", errs.Wrap(err, "IO failure")
+	}
+
+	if legacy := filepath.Join(homeDir, ".elvish", "rc.elv"); fileutils.FileExists(legacy) {
+		return legacy, nil
+	}
+
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "elvish", "rc.elv"), nil
+	}
+	if runtime.GOOS == "windows" {
+		if dir := os.Getenv("APPDATA"); dir != "" {
+			return filepath.Join(dir, "elvish", "rc.elv"), nil
+		}
+	}
+	return filepath.Join(homeDir, ".config", "elvish", "rc.elv"), nil
+}
+
+func (v *SubShell) EnsureRcFileExists() error {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "Could not determine rc file")
+	}
+
+	return fileutils.TouchFileUnlessExists(rcFile)
+}
+
+// SetupShellRcFile - subshell.SubShell
+func (v *SubShell) SetupShellRcFile(targetDir string, env map[string]string, namespace *project.Namespaced, cfg sscommon.Configurable) error {
+	return sscommon.SetupShellRcFile(filepath.Join(targetDir, "shell.elv"), "elvishrc_global.elv", escapeEnv(env), namespace, cfg)
+}
+
+// SetEnv - see subshell.SetEnv
+func (v *SubShell) SetEnv(env map[string]string) error {
+	v.env = env
+	return nil
+}
+
+// Quote - see subshell.Quote
+func (v *SubShell) Quote(value string) string {
+	return escaper.Quote(value)
+}
+
+// Activate - see subshell.SubShell
+func (v *SubShell) Activate(proj *project.Project, cfg sscommon.Configurable, out output.Outputer) error {
+	var shellArgs []string
+	var directEnv []string
+
+	// available project files require more intensive modification of shell envs
+	if proj != nil {
+		var err error
+		if v.rcFile, err = sscommon.SetupProjectRcFile(proj, "elvishrc.elv", ".elv", escapeEnv(v.env), out, cfg, false); err != nil {
+			return err
+		}
+
+		shellArgs = append(shellArgs, "-rc", v.rcFile.Name())
+	} else {
+		directEnv = sscommon.EnvSlice(v.env)
+	}
+
+	cmd := sscommon.NewCommand(v.Binary(), shellArgs, directEnv)
+	v.errs = sscommon.Start(cmd)
+	v.cmd = cmd
+	return nil
+}
+
+// Errors returns a channel for receiving errors related to active behavior
+func (v *SubShell) Errors() <-chan error {
+	return v.errs
+}
+
+// Deactivate - see subshell.SubShell
+func (v *SubShell) Deactivate() error {
+	if !v.IsActive() {
+		return nil
+	}
+
+	if err := sscommon.Stop(v.cmd); err != nil {
+		return err
+	}
+
+	v.cmd = nil
+	return nil
+}
+
+// Run - see subshell.SubShell
+func (v *SubShell) Run(filename string, args ...string) error {
+	return sscommon.RunFuncByBinary(v.Binary())(osutils.EnvMapToSlice(v.env), filename, args...)
+}
+
+// IsActive - see subshell.SubShell
+func (v *SubShell) IsActive() bool {
+	return v.cmd != nil && (v.cmd.ProcessState == nil || !v.cmd.ProcessState.Exited())
+}
+
+func (v *SubShell) IsAvailable() bool {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		logging.Error("Could not determine rcFile: %s", err)
+		return false
+	}
+	return fileutils.FileExists(rcFile)
+}
+
+// escapeEnv escapes all values so they can be used in double quoted elvish strings
+func escapeEnv(env map[string]string) map[string]string {
+	result := map[string]string{}
+	for k, v := range env {
+		result[k] = escaper.EscapeLineEnd(escaper.Escape(v))
+	}
+	return result
+}
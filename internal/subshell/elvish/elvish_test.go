@@ -0,0 +1,42 @@
+package elvish
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/subshell/sscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteUserEnv(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(constants.HomeEnvVarName, dir)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+
+	subs := &SubShell{}
+	rcFile, err := subs.RcFile()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "config", "elvish", "rc.elv"), rcFile)
+
+	env := map[string]string{"PATH": "/runtime/bin", "GREETING": `say "hi"`}
+	require.NoError(t, subs.WriteUserEnv(nil, env, sscommon.DefaultID, true))
+	require.NoError(t, subs.WriteCompletionScript("set edit:completion:arg-completer[state] = {|@words| }"))
+
+	b, err := os.ReadFile(rcFile)
+	require.NoError(t, err)
+	contents := string(b)
+	assert.Contains(t, contents, `set-env PATH "/runtime/bin:"(get-env PATH)`)
+	assert.Contains(t, contents, `set-env 'GREETING' "say \"hi\""`)
+	assert.Contains(t, contents, constants.RCAppendCompletionsStartLine)
+
+	// The legacy rc file is used when it exists.
+	legacy := filepath.Join(dir, ".elvish", "rc.elv")
+	require.NoError(t, os.MkdirAll(filepath.Dir(legacy), 0755))
+	require.NoError(t, os.WriteFile(legacy, nil, 0644))
+	rcFile, err = subs.RcFile()
+	require.NoError(t, err)
+	assert.Equal(t, legacy, rcFile)
+}
@@ -0,0 +1,241 @@
+package nushell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/osutils"
+	"github.com/ActiveState/cli/internal/osutils/user"
+	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/subshell/sscommon"
+	"github.com/ActiveState/cli/pkg/project"
+)
+
+var escaper *osutils.ShellEscape
+
+func init() {
+	escaper = osutils.NewBackslashEscaper()
+}
+
+// SubShell covers the subshell.SubShell interface, reference that for documentation
+type SubShell struct {
+	binary string
+	rcFile *os.File
+	cmd    *exec.Cmd
+	env    map[string]string
+	errs   chan error
+}
+
+// Name is the name of the nushell binary, which is how the shell is detected
+const Name string = "nu"
+
+// Shell - see subshell.SubShell
+func (v *SubShell) Shell() string {
+	return Name
+}
+
+// Binary - see subshell.SubShell
+func (v *SubShell) Binary() string {
+	return v.binary
+}
+
+// SetBinary - see subshell.SubShell
+func (v *SubShell) SetBinary(binary string) {
+	v.binary = binary
+}
+
+// WriteUserEnv - see subshell.SubShell
+func (v *SubShell) WriteUserEnv(cfg sscommon.Configurable, env map[string]string, envType sscommon.RcIdentification, _ bool) error {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "RcFile failure")
+	}
+
+	return sscommon.WriteRcFile("nushell_append.nu", rcFile, envType, escapeEnv(env))
+}
+
+func (v *SubShell) CleanUserEnv(cfg sscommon.Configurable, envType sscommon.RcIdentification, _ bool) error {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "RcFile failure")
+	}
+
+	if err := sscommon.CleanRcFile(rcFile, envType); err != nil {
+		return errs.Wrap(err, "Failed to remove %s from rcFile", envType)
+	}
+
+	return nil
+}
+
+func (v *SubShell) RemoveLegacyInstallPath(cfg sscommon.Configurable) error {
+	// Nushell was never supported by the legacy install scripts
+	return nil
+}
+
+// WriteCompletionScript writes the completions to nushell's vendor autoload directory, from which nushell loads
+// them on startup.
+func (v *SubShell) WriteCompletionScript(completionScript string) error {
+	dir, err := dataDir()
+	if err != nil {
+		return errs.Wrap(err, "Could not determine data directory")
+	}
+
+	fpath := filepath.Join(dir, "vendor", "autoload", constants.CommandName+".nu")
+	err = fileutils.WriteFile(fpath, []byte(completionScript))
+	if err != nil {
+		logging.Debug("Could not write completions script '%s', likely due to non-admin privileges", fpath)
+	}
+
+	return nil
+}
+
+func (v *SubShell) RcFile() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", errs.Wrap(err, "Could not determine config directory")
+	}
+
+	return filepath.Join(dir, "config.nu"), nil
+}
+
+func (v *SubShell) EnsureRcFileExists() error {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		return errs.Wrap(err, "Could not determine rc file")
+	}
+
+	return fileutils.TouchFileUnlessExists(rcFile)
+}
+
+// SetupShellRcFile - subshell.SubShell
+func (v *SubShell) SetupShellRcFile(targetDir string, env map[string]string, namespace *project.Namespaced, cfg sscommon.Configurable) error {
+	return sscommon.SetupShellRcFile(filepath.Join(targetDir, "shell.nu"), "nushell_global.nu", escapeEnv(env), namespace, cfg)
+}
+
+// SetEnv - see subshell.SetEnv
+func (v *SubShell) SetEnv(env map[string]string) error {
+	v.env = env
+	return nil
+}
+
+// Quote - see subshell.Quote
+func (v *SubShell) Quote(value string) string {
+	return escaper.Quote(value)
+}
+
+// Activate - see subshell.SubShell
+func (v *SubShell) Activate(proj *project.Project, cfg sscommon.Configurable, out output.Outputer) error {
+	var shellArgs []string
+	var directEnv []string
+
+	// available project files require more intensive modification of shell envs
+	if proj != nil {
+		var err error
+		if v.rcFile, err = sscommon.SetupProjectRcFile(proj, "nushell.nu", ".nu", escapeEnv(v.env), out, cfg, false); err != nil {
+			return err
+		}
+
+		// Nushell only sources files at parse time, so the path has to be given as a literal
+		shellArgs = append(shellArgs, "-e", fmt.Sprintf("source %s", escaper.Quote(v.rcFile.Name())))
+	} else {
+		directEnv = sscommon.EnvSlice(v.env)
+	}
+
+	cmd := sscommon.NewCommand(v.Binary(), shellArgs, directEnv)
+	v.errs = sscommon.Start(cmd)
+	v.cmd = cmd
+	return nil
+}
+
+// Errors returns a channel for receiving errors related to active behavior
+func (v *SubShell) Errors() <-chan error {
+	return v.errs
+}
+
+// Deactivate - see subshell.SubShell
+func (v *SubShell) Deactivate() error {
+	if !v.IsActive() {
+		return nil
+	}
+
+	if err := sscommon.Stop(v.cmd); err != nil {
+		return err
+	}
+
+	v.cmd = nil
+	return nil
+}
+
+// Run - see subshell.SubShell
+func (v *SubShell) Run(filename string, args ...string) error {
+	return sscommon.RunFuncByBinary(v.Binary())(osutils.EnvMapToSlice(v.env), filename, args...)
+}
+
+// IsActive - see subshell.SubShell
+func (v *SubShell) IsActive() bool {
+	return v.cmd != nil && (v.cmd.ProcessState == nil || !v.cmd.ProcessState.Exited())
+}
+
+func (v *SubShell) IsAvailable() bool {
+	rcFile, err := v.RcFile()
+	if err != nil {
+		logging.Error("Could not determine rcFile: %s", err)
+		return false
+	}
+	return fileutils.FileExists(rcFile)
+}
+
+// escapeEnv escapes all values so they can be used in double quoted nushell strings
+func escapeEnv(env map[string]string) map[string]string {
+	result := map[string]string{}
+	for k, v := range env {
+		result[k] = escaper.EscapeLineEnd(escaper.Escape(v))
+	}
+	return result
+}
+
+// configDir returns nushell's default config directory, ie. $nu.default-config-dir
+func configDir() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "nushell"), nil
+	}
+	return platformDir(".config")
+}
+
+// dataDir returns nushell's data directory, ie. $nu.data-dir
+func dataDir() (string, error) {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" && runtime.GOOS == "linux" {
+		return filepath.Join(dir, "nushell"), nil
+	}
+	return platformDir(filepath.Join(".local", "share"))
+}
+
+// platformDir returns the nushell directory within the platform's directory for application files, using the
+// given directory relative to the user's home directory on Linux.
+func platformDir(linuxDir string) (string, error) {
+	if runtime.GOOS == "windows" {
+		if dir := os.Getenv("APPDATA"); dir != "" {
+			return filepath.Join(dir, "nushell"), nil
+		}
+	}
+
+	homeDir, err := user.HomeDir()
+	if err != nil {
+		return "", errs.Wrap(err, "IO failure")
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		return filepath.Join(homeDir, "Library", "Application Support", "nushell"), nil
+	case "windows":
+		return filepath.Join(homeDir, "AppData", "Roaming", "nushell"), nil
+	}
+	return filepath.Join(homeDir, linuxDir, "nushell"), nil
+}
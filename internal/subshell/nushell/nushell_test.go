@@ -0,0 +1,38 @@
+package nushell
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/subshell/sscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteUserEnv(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	subs := &SubShell{}
+	rcFile, err := subs.RcFile()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "nushell", "config.nu"), rcFile)
+
+	env := map[string]string{"PATH": "/runtime/bin", "GREETING": `say "hi" \ bye`}
+	require.NoError(t, subs.WriteUserEnv(nil, env, sscommon.DefaultID, true))
+
+	b, err := os.ReadFile(rcFile)
+	require.NoError(t, err)
+	contents := string(b)
+	assert.Contains(t, contents, constants.RCAppendDefaultStartLine)
+	assert.Contains(t, contents, `$env.PATH = ($env.PATH | split row (char esep) | prepend ("/runtime/bin" | split row (char esep)) | uniq)`)
+	assert.Contains(t, contents, `$env."GREETING" = "say \"hi\" \\ bye"`)
+	assert.Contains(t, contents, "$env."+constants.ActivatedStateEnvVarName)
+
+	require.NoError(t, subs.CleanUserEnv(nil, sscommon.DefaultID, true))
+	b, err = os.ReadFile(rcFile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "GREETING")
+}
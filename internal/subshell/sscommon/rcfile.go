@@ -52,6 +52,16 @@ var (
 		constants.RCAppendAutostartStopLine,
 		"user_autostart_env",
 	}
+	CompletionsID RcIdentification = RcIdentification{
+		constants.RCAppendCompletionsStartLine,
+		constants.RCAppendCompletionsStopLine,
+		"user_completions",
+	}
+	HookID RcIdentification = RcIdentification{
+		constants.RCAppendHookStartLine,
+		constants.RCAppendHookStopLine,
+		"user_hook",
+	}
 )
 
 // Configurable defines an interface to store and get configuration data
@@ -360,6 +370,10 @@ func SetupProjectRcFile(prj *project.Project, templateName, ext string, env map[
 	t := template.New("rcfile")
 	t.Funcs(map[string]interface{}{
 		"splitLines": func(v string) []string { return strings.Split(v, "\n") },
+		"escapeBackslash": func(v string) string {
+			esc := osutils.NewBackslashEscaper()
+			return esc.EscapeLineEnd(esc.Escape(v))
+		},
 		"escapePwsh": func(v string) string {
 			// Conver unicode characters
 			result := ""
@@ -23,7 +23,9 @@ import (
 	"github.com/ActiveState/cli/internal/runbits/orgkey"
 	"github.com/ActiveState/cli/internal/subshell/bash"
 	"github.com/ActiveState/cli/internal/subshell/cmd"
+	"github.com/ActiveState/cli/internal/subshell/elvish"
 	"github.com/ActiveState/cli/internal/subshell/fish"
+	"github.com/ActiveState/cli/internal/subshell/nushell"
 	"github.com/ActiveState/cli/internal/subshell/pwsh"
 	"github.com/ActiveState/cli/internal/subshell/sscommon"
 	"github.com/ActiveState/cli/internal/subshell/tcsh"
@@ -105,6 +107,10 @@ func New(cfg sscommon.Configurable) SubShell {
 		subs = &tcsh.SubShell{}
 	case fish.Name:
 		subs = &fish.SubShell{}
+	case nushell.Name:
+		subs = &nushell.SubShell{}
+	case elvish.Name:
+		subs = &elvish.SubShell{}
 	case cmd.Name:
 		subs = &cmd.SubShell{}
 	case pwsh.Name:
@@ -221,7 +227,7 @@ func DetectShell(cfg sscommon.Configurable) (string, string) {
 	}
 
 	isKnownShell := false
-	for _, ssName := range []string{bash.Name, cmd.Name, fish.Name, tcsh.Name, zsh.Name, pwsh.Name} {
+	for _, ssName := range []string{bash.Name, cmd.Name, fish.Name, tcsh.Name, zsh.Name, pwsh.Name, nushell.Name, elvish.Name} {
 		if name == ssName {
 			isKnownShell = true
 			break
@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/ActiveState/cli/internal/subshell/bash"
+	"github.com/ActiveState/cli/internal/subshell/elvish"
 	"github.com/ActiveState/cli/internal/subshell/fish"
+	"github.com/ActiveState/cli/internal/subshell/nushell"
 	"github.com/ActiveState/cli/internal/subshell/tcsh"
 	"github.com/ActiveState/cli/internal/subshell/zsh"
 )
@@ -17,6 +19,8 @@ var supportedShells = []SubShell{
 	&zsh.SubShell{},
 	&tcsh.SubShell{},
 	&fish.SubShell{},
+	&nushell.SubShell{},
+	&elvish.SubShell{},
 }
 
 const (
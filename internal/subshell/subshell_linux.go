@@ -8,7 +8,9 @@ import (
 
 	"github.com/ActiveState/cli/internal/subshell/bash"
 	"github.com/ActiveState/cli/internal/subshell/cmd"
+	"github.com/ActiveState/cli/internal/subshell/elvish"
 	"github.com/ActiveState/cli/internal/subshell/fish"
+	"github.com/ActiveState/cli/internal/subshell/nushell"
 	"github.com/ActiveState/cli/internal/subshell/tcsh"
 	"github.com/ActiveState/cli/internal/subshell/zsh"
 )
@@ -18,6 +20,8 @@ var supportedShells = []SubShell{
 	&zsh.SubShell{},
 	&tcsh.SubShell{},
 	&fish.SubShell{},
+	&nushell.SubShell{},
+	&elvish.SubShell{},
 	&cmd.SubShell{},
 }
 
@@ -46,6 +46,7 @@ const (
 	HelloExample    = "hello_example"
 	Help            = "help"
 	History         = "history"
+	Hook            = "hook"
 	Import          = "import"
 	Info            = "info"
 	Init            = "init"
@@ -88,7 +89,9 @@ const (
 	Uninstall       = "uninstall"
 	Upgrade         = "upgrade"
 	Update          = "update"
+	Usage           = "usage"
 	Use             = "use"
+	Workspace       = "workspace"
 )
 
 // Suite extends a testify suite Suite, such that tests allowing for dynamic skipping of tests
@@ -1,6 +1,7 @@
 package virtualenvironment
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/ActiveState/cli/internal/constants"
 	"github.com/ActiveState/cli/internal/locale"
+	"github.com/ActiveState/cli/internal/logging"
 	"github.com/ActiveState/cli/internal/osutils"
 	"github.com/ActiveState/cli/pkg/project"
 )
@@ -17,13 +19,20 @@ import (
 // VirtualEnvironment represents our virtual environment, it pulls together and virtualizes the runtime environment
 type VirtualEnvironment struct {
 	activationID string
-	runtime      *runtime.Runtime
+	runtimes     []*runtime.Runtime
 }
 
 func New(runtime *runtime.Runtime) *VirtualEnvironment {
+	return NewMerged(runtime)
+}
+
+// NewMerged returns a virtual environment combining the given runtimes, as used when activating all members of
+// a workspace at once. Path lists (eg. PATH) are combined, with earlier runtimes taking precedence; for any other
+// variable the first runtime defining it wins.
+func NewMerged(runtimes ...*runtime.Runtime) *VirtualEnvironment {
 	return &VirtualEnvironment{
 		activationID: uuid.New().String(),
-		runtime:      runtime,
+		runtimes:     runtimes,
 	}
 }
 
@@ -32,12 +41,13 @@ func (v *VirtualEnvironment) GetEnv(inherit bool, useExecutors bool, projectDir,
 	envMap := make(map[string]string)
 
 	// Source runtime environment information
-
-	env := v.runtime.Env(inherit)
-	if useExecutors {
-		envMap = env.VariablesWithExecutors
-	} else {
-		envMap = env.Variables
+	for _, rt := range v.runtimes {
+		env := rt.Env(inherit)
+		if useExecutors {
+			mergeEnv(envMap, env.VariablesWithExecutors)
+		} else {
+			mergeEnv(envMap, env.Variables)
+		}
 	}
 
 	if projectDir != "" {
@@ -63,6 +73,52 @@ func (v *VirtualEnvironment) GetEnv(inherit bool, useExecutors bool, projectDir,
 	return envMap, nil
 }
 
+// mergeEnv merges the given environment into dst. Variables holding path lists are combined, dropping duplicate
+// entries, while any other variable keeps the value it already has in dst.
+func mergeEnv(dst, src map[string]string) {
+	for k, v := range src {
+		existing, ok := dst[k]
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		if existing == v {
+			continue
+		}
+		if !isPathList(k) {
+			logging.Debug("Environment variable %s is defined by multiple runtimes, keeping the first value", k)
+			continue
+		}
+		entries := strings.Split(existing, string(os.PathListSeparator))
+		seen := map[string]bool{}
+		for _, e := range entries {
+			seen[e] = true
+		}
+		for _, e := range strings.Split(v, string(os.PathListSeparator)) {
+			if !seen[e] {
+				seen[e] = true
+				entries = append(entries, e)
+			}
+		}
+		dst[k] = strings.Join(entries, string(os.PathListSeparator))
+	}
+}
+
+// pathListVariables are the variables holding path lists whose names do not end in PATH.
+var pathListVariables = map[string]bool{
+	"PATHEXT":         true,
+	"PERL5LIB":        true,
+	"XDG_CONFIG_DIRS": true,
+	"XDG_DATA_DIRS":   true,
+}
+
+// isPathList returns whether the given variable holds a list of paths. Values cannot tell, as on Unix
+// the path list separator is also part of every URL.
+func isPathList(name string) bool {
+	name = strings.ToUpper(name)
+	return strings.HasSuffix(name, "PATH") || pathListVariables[name]
+}
+
 // WorkingDirectory returns the working directory to use for the current environment
 func (v *VirtualEnvironment) WorkingDirectory() string {
 	wd, err := osutils.Getwd()
@@ -0,0 +1,33 @@
+package virtualenvironment
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeEnv(t *testing.T) {
+	sep := string(os.PathListSeparator)
+	env := map[string]string{}
+	mergeEnv(env, map[string]string{
+		"PATH":       strings.Join([]string{"/api/bin", "/usr/bin"}, sep),
+		"PYTHONPATH": "/api/lib",
+		"PYTHONHOME": "/api",
+		"INDEX_URL":  "https://api.example.com/simple",
+	})
+	mergeEnv(env, map[string]string{
+		"PATH":       strings.Join([]string{"/worker/bin", "/usr/bin"}, sep),
+		"PYTHONPATH": "/worker/lib",
+		"PYTHONHOME": "/worker",
+		"NODE_ENV":   "production",
+		"INDEX_URL":  "https://worker.example.com/simple",
+	})
+
+	assert.Equal(t, strings.Join([]string{"/api/bin", "/usr/bin", "/worker/bin"}, sep), env["PATH"])
+	assert.Equal(t, strings.Join([]string{"/api/lib", "/worker/lib"}, sep), env["PYTHONPATH"])
+	assert.Equal(t, "/api", env["PYTHONHOME"], "the first runtime's value wins for other variables")
+	assert.Equal(t, "production", env["NODE_ENV"])
+	assert.Equal(t, "https://api.example.com/simple", env["INDEX_URL"], "values containing the path list separator are not path lists")
+}
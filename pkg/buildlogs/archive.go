@@ -0,0 +1,376 @@
+// Package buildlogs maintains a local archive of artifact build logs. Logs are
+// stored once per artifact and indexed by the commits they were recorded for,
+// so failed builds can be investigated offline and compared across commits.
+package buildlogs
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/installation/storage"
+	"github.com/ActiveState/cli/internal/logging"
+	"github.com/ActiveState/cli/internal/osutils/lockfile"
+	"github.com/ActiveState/cli/internal/retryhttp"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	dirName   = "buildlogs"
+	indexFile = "index.json"
+	lockFile  = "index.lock"
+	logExt    = ".log"
+
+	// MaxAge is how long an entry is kept in the archive. Logs no longer referenced by any entry are removed.
+	MaxAge = 90 * 24 * time.Hour
+
+	lockTimeout = 30 * time.Second
+)
+
+// Entry describes a single archived build log for an artifact in a commit.
+type Entry struct {
+	ArtifactID   strfmt.UUID `json:"artifactID"`
+	CommitID     strfmt.UUID `json:"commitID"`
+	Name         string      `json:"name"`
+	Version      string      `json:"version,omitempty"`
+	Status       string      `json:"status"`
+	LogURL       string      `json:"logURL"`
+	ErrorMessage string      `json:"errorMessage,omitempty"`
+	ArchivedAt   time.Time   `json:"archivedAt"`
+}
+
+// NewEntry returns an archive entry for the given buildplan artifact.
+func NewEntry(commitID strfmt.UUID, artifact *buildplan.Artifact) Entry {
+	entry := Entry{
+		ArtifactID: artifact.ArtifactID,
+		CommitID:   commitID,
+		Name:       artifact.Name(),
+		Version:    artifact.Version(),
+		Status:     artifact.Status,
+		LogURL:     artifact.LogURL,
+	}
+	if len(artifact.Errors) > 0 {
+		entry.ErrorMessage = artifact.Errors[0]
+	}
+	return entry
+}
+
+// Failed returns whether the archived build failed.
+func (e Entry) Failed() bool {
+	return e.Status == types.ArtifactFailedPermanently || e.Status == types.ArtifactFailedTransiently
+}
+
+type index struct {
+	Entries []Entry `json:"entries"`
+}
+
+// Archive is a local, on-disk store of build logs. It can be written to by several State Tool
+// processes at once, so changes to the index are guarded by a lock file.
+type Archive struct {
+	dir string
+}
+
+// mutex guards the index within this process. File locks are held per process, so they cannot
+// guard against concurrent archives in the same process.
+var mutex sync.Mutex
+
+// New returns the archive in the State Tool's cache directory.
+func New() *Archive {
+	return NewWithDir(filepath.Join(storage.CachePath(), dirName))
+}
+
+// NewWithDir returns an archive rooted at the given directory.
+func NewWithDir(dir string) *Archive {
+	return &Archive{dir: dir}
+}
+
+// Dir returns the directory the archive is stored in.
+func (a *Archive) Dir() string {
+	return a.dir
+}
+
+// IndexPath returns the path of the index of the archive, which is rewritten whenever logs are archived.
+func (a *Archive) IndexPath() string {
+	return filepath.Join(a.dir, indexFile)
+}
+
+// Entries returns all archived entries, most recently archived first.
+func (a *Archive) Entries() ([]Entry, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	idx, err := a.readIndex()
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read build log index")
+	}
+	return idx.Entries, nil
+}
+
+// ForCommit returns the entries archived for the given commit.
+func (a *Archive) ForCommit(commitID strfmt.UUID) ([]Entry, error) {
+	entries, err := a.Entries()
+	if err != nil {
+		return nil, err
+	}
+	var result []Entry
+	for _, e := range entries {
+		if e.CommitID == commitID {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// Lookup returns the entry archived for the given artifact in the given commit. If the artifact
+// was not archived for that commit, the most recent entry for the artifact from any other commit is
+// returned instead, as the log of an artifact does not depend on the commit it is part of.
+func (a *Archive) Lookup(commitID, artifactID strfmt.UUID) (*Entry, error) {
+	entries, err := a.Entries()
+	if err != nil {
+		return nil, err
+	}
+	var fallback *Entry
+	for i, e := range entries {
+		if e.ArtifactID != artifactID {
+			continue
+		}
+		if e.CommitID == commitID {
+			return &entries[i], nil
+		}
+		if fallback == nil {
+			fallback = &entries[i]
+		}
+	}
+	return fallback, nil
+}
+
+// Put archives the given build log for the given entry, replacing any log previously archived for
+// the same artifact and commit. The log may either be the platform's NDJSON log format or plain text.
+func (a *Archive) Put(entry Entry, log io.Reader) error {
+	lines, err := ParseLog(log)
+	if err != nil {
+		return errs.Wrap(err, "Could not parse build log")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	unlock, err := a.lock()
+	if err != nil {
+		return errs.Wrap(err, "Could not lock build log archive")
+	}
+	defer unlock()
+
+	if err := writeFileAtomic(a.logPath(entry.ArtifactID), []byte(joinLines(lines))); err != nil {
+		return errs.Wrap(err, "Could not write build log")
+	}
+	return a.upsert(entry)
+}
+
+// Index records the given entry for an artifact whose log is already archived, eg. when the same
+// artifact is part of another commit.
+func (a *Archive) Index(entry Entry) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	unlock, err := a.lock()
+	if err != nil {
+		return errs.Wrap(err, "Could not lock build log archive")
+	}
+	defer unlock()
+
+	if !fileutils.FileExists(a.logPath(entry.ArtifactID)) {
+		return errs.New("No build log is archived for artifact %s", entry.ArtifactID)
+	}
+	return a.upsert(entry)
+}
+
+// Has returns whether a build log is archived for the given artifact.
+func (a *Archive) Has(artifactID strfmt.UUID) bool {
+	return fileutils.FileExists(a.logPath(artifactID))
+}
+
+// lock acquires the lock file of the archive, so that other processes cannot modify the index
+// until the returned function is called.
+func (a *Archive) lock() (func(), error) {
+	if err := fileutils.MkdirUnlessExists(a.dir); err != nil {
+		return nil, errs.Wrap(err, "Could not create archive directory")
+	}
+	pl, err := lockfile.NewPidLock(filepath.Join(a.dir, lockFile))
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not create lock file")
+	}
+	if err := pl.WaitForLock(lockTimeout); err != nil {
+		pl.Close()
+		return nil, errs.Wrap(err, "Could not acquire lock")
+	}
+	return func() {
+		if err := pl.Close(); err != nil {
+			logging.Warning("Could not release build log archive lock: %s", errs.JoinMessage(err))
+		}
+	}, nil
+}
+
+// upsert adds the given entry to the index, replacing any entry for the same artifact and commit,
+// and prunes entries older than MaxAge. The index is re-read while holding the lock, so entries
+// added by other processes are kept. The caller must hold the mutex and the lock.
+func (a *Archive) upsert(entry Entry) error {
+	idx, err := a.readIndex()
+	if err != nil {
+		return errs.Wrap(err, "Could not read build log index")
+	}
+	if entry.ArchivedAt.IsZero() {
+		entry.ArchivedAt = time.Now()
+	}
+	entries := []Entry{entry}
+	for _, e := range idx.Entries {
+		if e.ArtifactID == entry.ArtifactID && e.CommitID == entry.CommitID {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].ArchivedAt.After(entries[j].ArchivedAt) })
+	var pruned []strfmt.UUID
+	idx.Entries, pruned = prune(entries, time.Now().Add(-MaxAge))
+
+	if err := a.writeIndex(idx); err != nil {
+		return errs.Wrap(err, "Could not write build log index")
+	}
+
+	for _, artifactID := range pruned {
+		if err := os.Remove(a.logPath(artifactID)); err != nil && !os.IsNotExist(err) {
+			logging.Warning("Could not remove pruned build log: %s", errs.JoinMessage(err))
+		}
+	}
+	return nil
+}
+
+// prune drops the entries archived before the given time, and returns the remaining entries as well
+// as the artifacts whose logs are no longer referenced by any entry.
+func prune(entries []Entry, before time.Time) ([]Entry, []strfmt.UUID) {
+	kept := make([]Entry, 0, len(entries))
+	referenced := map[strfmt.UUID]bool{}
+	for _, e := range entries {
+		if e.ArchivedAt.Before(before) {
+			continue
+		}
+		kept = append(kept, e)
+		referenced[e.ArtifactID] = true
+	}
+
+	var pruned []strfmt.UUID
+	for _, e := range entries {
+		if !referenced[e.ArtifactID] {
+			referenced[e.ArtifactID] = true // only report each artifact once
+			pruned = append(pruned, e.ArtifactID)
+		}
+	}
+	return kept, pruned
+}
+
+// Fetch downloads the build log of the given entry from its log URL and archives it.
+func (a *Archive) Fetch(entry Entry) error {
+	if entry.LogURL == "" {
+		return errs.New("Artifact %s has no build log", entry.ArtifactID)
+	}
+
+	resp, err := retryhttp.DefaultClient.Get(entry.LogURL)
+	if err != nil {
+		return errs.Wrap(err, "Could not download build log")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errs.New("Could not download build log, status: %s", resp.Status)
+	}
+
+	if err := a.Put(entry, resp.Body); err != nil {
+		return errs.Wrap(err, "Could not archive build log")
+	}
+	return nil
+}
+
+// Lines returns the archived log lines for the given entry.
+func (a *Archive) Lines(entry Entry) ([]string, error) {
+	f, err := os.Open(a.logPath(entry.ArtifactID))
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not open archived build log")
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errs.Wrap(err, "Could not read archived build log")
+	}
+	return lines, nil
+}
+
+func (a *Archive) logPath(artifactID strfmt.UUID) string {
+	return filepath.Join(a.dir, artifactID.String()+logExt)
+}
+
+func (a *Archive) readIndex() (*index, error) {
+	idx := &index{}
+	path := a.IndexPath()
+	if !fileutils.TargetExists(path) {
+		return idx, nil
+	}
+	b, err := fileutils.ReadFile(path)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read index file")
+	}
+	if err := json.Unmarshal(b, idx); err != nil {
+		return nil, errs.Wrap(err, "Could not unmarshal index file")
+	}
+	return idx, nil
+}
+
+func (a *Archive) writeIndex(idx *index) error {
+	b, err := json.Marshal(idx)
+	if err != nil {
+		return errs.Wrap(err, "Could not marshal index")
+	}
+	return writeFileAtomic(a.IndexPath(), b)
+}
+
+// writeFileAtomic writes data to a sibling temp file and renames it onto path, so concurrent
+// readers never observe a partially written file.
+func writeFileAtomic(path string, data []byte) (rerr error) {
+	if err := fileutils.MkdirUnlessExists(filepath.Dir(path)); err != nil {
+		return errs.Wrap(err, "Could not create archive directory")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return errs.Wrap(err, "Could not create temp file")
+	}
+	defer func() {
+		if rerr != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errs.Wrap(err, "Could not write temp file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errs.Wrap(err, "Could not close temp file")
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errs.Wrap(err, "Could not move temp file into place")
+	}
+	return nil
+}
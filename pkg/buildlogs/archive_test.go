@@ -0,0 +1,161 @@
+package buildlogs
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ActiveState/cli/pkg/platform/api/buildplanner/types"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	commitA   = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+	commitB   = strfmt.UUID("00000000-0000-0000-0000-00000000000b")
+	artifact1 = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	artifact2 = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+)
+
+func TestParseLog(t *testing.T) {
+	log := `{"body": {"facility": "INFO", "msg": "configuring"}, "type": "artifact_progress"}
+{"body": {"facility": "ERROR", "msg": "Traceback:\n  line 1\n"}, "type": "artifact_progress"}
+plain text line
+{not json`
+	lines, err := ParseLog(strings.NewReader(log))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"configuring", "Traceback:", "  line 1", "plain text line", "{not json"}, lines)
+}
+
+func TestGrep(t *testing.T) {
+	lines := []string{"configuring", "error: missing header", "done", "Error: link failed"}
+	matches := Grep(lines, regexp.MustCompile(`(?i)error`))
+	assert.Equal(t, []Match{{2, "error: missing header"}, {4, "Error: link failed"}}, matches)
+}
+
+func TestArchive(t *testing.T) {
+	a := NewWithDir(t.TempDir())
+
+	entries, err := a.Entries()
+	require.NoError(t, err)
+	assert.Empty(t, entries, "a new archive is empty")
+
+	older := Entry{ArtifactID: artifact1, CommitID: commitA, Name: "zlib", Status: types.ArtifactFailedPermanently, ArchivedAt: time.Now().Add(-time.Hour)}
+	require.NoError(t, a.Put(older, strings.NewReader("first attempt\n")))
+	require.NoError(t, a.Put(Entry{ArtifactID: artifact2, CommitID: commitA, Name: "bzip2", Status: types.ArtifactSucceeded}, strings.NewReader("ok\n")))
+
+	entries, err = a.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, artifact2, entries[0].ArtifactID, "most recently archived comes first")
+	assert.True(t, entries[1].Failed())
+
+	// Re-archiving the same artifact for the same commit replaces it.
+	require.NoError(t, a.Put(Entry{ArtifactID: artifact1, CommitID: commitA, Name: "zlib", Status: types.ArtifactSucceeded}, strings.NewReader("second attempt\n")))
+	commitEntries, err := a.ForCommit(commitA)
+	require.NoError(t, err)
+	assert.Len(t, commitEntries, 2)
+
+	entry, err := a.Lookup(commitA, artifact1)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.False(t, entry.Failed())
+	lines, err := a.Lines(*entry)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"second attempt"}, lines)
+
+	// The same artifact in another commit resolves to the archived log, and can be indexed for it.
+	entry, err = a.Lookup(commitB, artifact1)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, commitA, entry.CommitID)
+	entry.CommitID = commitB
+	entry.ArchivedAt = time.Time{}
+	require.NoError(t, a.Index(*entry))
+	commitEntries, err = a.ForCommit(commitB)
+	require.NoError(t, err)
+	require.Len(t, commitEntries, 1)
+	lines, err = a.Lines(commitEntries[0])
+	require.NoError(t, err)
+	assert.Equal(t, []string{"second attempt"}, lines, "indexing does not touch the archived log")
+
+	assert.Error(t, a.Index(Entry{ArtifactID: strfmt.UUID("00000000-0000-0000-0000-000000000003"), CommitID: commitB}),
+		"artifacts without an archived log cannot be indexed")
+
+	entry, err = a.Lookup(commitA, strfmt.UUID("00000000-0000-0000-0000-000000000003"))
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestArchive_Fetch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/log.jsonl" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"body": {"msg": "compiling"}}` + "\n" + `{"body": {"msg": "error: boom"}}` + "\n"))
+	}))
+	defer srv.Close()
+
+	a := NewWithDir(t.TempDir())
+	entry := Entry{ArtifactID: artifact1, CommitID: commitA, LogURL: srv.URL + "/log.jsonl"}
+	require.NoError(t, a.Fetch(entry))
+
+	lines, err := a.Lines(entry)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"compiling", "error: boom"}, lines)
+
+	assert.Error(t, a.Fetch(Entry{ArtifactID: artifact2, CommitID: commitA}), "entries without a log URL cannot be fetched")
+}
+
+func TestArchive_Concurrent(t *testing.T) {
+	dir := t.TempDir()
+
+	// Separate archives of the same directory, like separate State Tool processes.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			artifactID := strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+			assert.NoError(t, NewWithDir(dir).Put(Entry{ArtifactID: artifactID, CommitID: commitA}, strings.NewReader("log\n")))
+		}(i)
+	}
+	wg.Wait()
+
+	entries, err := NewWithDir(dir).Entries()
+	require.NoError(t, err)
+	assert.Len(t, entries, 10, "no entries are lost")
+}
+
+func TestArchive_Prune(t *testing.T) {
+	a := NewWithDir(t.TempDir())
+
+	expired := time.Now().Add(-MaxAge - time.Hour)
+	require.NoError(t, a.Put(Entry{ArtifactID: artifact1, CommitID: commitA}, strings.NewReader("log\n")))
+	require.NoError(t, a.Put(Entry{ArtifactID: artifact2, CommitID: commitA, ArchivedAt: expired}, strings.NewReader("old\n")))
+
+	entries, err := a.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, artifact1, entries[0].ArtifactID)
+	assert.True(t, a.Has(artifact1))
+	assert.False(t, a.Has(artifact2), "logs of pruned entries are removed")
+
+	// Logs are shared across commits, so they are only pruned once no entry references them.
+	kept, pruned := prune([]Entry{
+		{ArtifactID: artifact1, CommitID: commitB, ArchivedAt: time.Now()},
+		{ArtifactID: artifact1, CommitID: commitA, ArchivedAt: expired},
+		{ArtifactID: artifact2, CommitID: commitA, ArchivedAt: expired},
+		{ArtifactID: artifact2, CommitID: commitB, ArchivedAt: expired},
+	}, time.Now().Add(-MaxAge))
+	require.Len(t, kept, 1)
+	assert.Equal(t, commitB, kept[0].CommitID)
+	assert.Equal(t, []strfmt.UUID{artifact2}, pruned)
+}
@@ -0,0 +1,71 @@
+package buildlogs
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+// maxLineSize is the longest log line we will read. Build logs regularly contain very long
+// compiler invocations, so this is well above bufio's default.
+const maxLineSize = 1024 * 1024
+
+// logLine is a single line of a platform build log, eg.
+// {"body": {"facility": "INFO", "msg": "..."}, "artifact_id": "...", "timestamp": "...", "type": "artifact_progress", "source": "build-wrapper", "pid": 19}
+type logLine struct {
+	Body struct {
+		Msg string `json:"msg"`
+	} `json:"body"`
+}
+
+// ParseLog reads a build log and returns its text lines. Lines in the platform's NDJSON log format
+// are reduced to their message, any other lines are kept as is.
+func ParseLog(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(text), "{") {
+			var line logLine
+			if err := json.Unmarshal([]byte(text), &line); err == nil {
+				// A message may span multiple lines, eg. when it contains a stack trace.
+				lines = append(lines, strings.Split(strings.TrimRight(line.Body.Msg, "\n"), "\n")...)
+				continue
+			}
+		}
+		lines = append(lines, text)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errs.Wrap(err, "Could not read log")
+	}
+	return lines, nil
+}
+
+func joinLines(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Match is a log line that matched a search.
+type Match struct {
+	Line int    `json:"line"` // 1-based line number
+	Text string `json:"text"`
+}
+
+// Grep returns the lines matching the given pattern.
+func Grep(lines []string, pattern *regexp.Regexp) []Match {
+	var matches []Match
+	for i, line := range lines {
+		if pattern.MatchString(line) {
+			matches = append(matches, Match{i + 1, line})
+		}
+	}
+	return matches
+}
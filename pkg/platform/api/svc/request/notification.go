@@ -1,20 +1,22 @@
 package request
 
 type NotificationRequest struct {
-	command string
-	flags   []string
+	command     string
+	flags       []string
+	projectPath string
 }
 
-func NewNotificationRequest(command string, flags []string) *NotificationRequest {
+func NewNotificationRequest(command string, flags []string, projectPath string) *NotificationRequest {
 	return &NotificationRequest{
-		command: command,
-		flags:   flags,
+		command:     command,
+		flags:       flags,
+		projectPath: projectPath,
 	}
 }
 
 func (m *NotificationRequest) Query() string {
-	return `query($command: String!, $flags: [String!]!) {
-		checkNotifications(command: $command, flags: $flags) {
+	return `query($command: String!, $flags: [String!]!, $projectPath: String) {
+		checkNotifications(command: $command, flags: $flags, projectPath: $projectPath) {
 			id
 			message
 			interrupt
@@ -25,7 +27,29 @@ func (m *NotificationRequest) Query() string {
 
 func (m *NotificationRequest) Vars() (map[string]interface{}, error) {
 	return map[string]interface{}{
-		"command": m.command,
-		"flags":   m.flags,
+		"command":     m.command,
+		"flags":       m.flags,
+		"projectPath": m.projectPath,
 	}, nil
 }
+
+type NotificationsListRequest struct{}
+
+func NewNotificationsListRequest() *NotificationsListRequest {
+	return &NotificationsListRequest{}
+}
+
+func (m *NotificationsListRequest) Query() string {
+	return `query {
+		notifications {
+			id
+			message
+			interrupt
+			placement
+		}
+	}`
+}
+
+func (m *NotificationsListRequest) Vars() (map[string]interface{}, error) {
+	return map[string]interface{}{}, nil
+}
@@ -0,0 +1,52 @@
+package request
+
+type RuntimeUsage struct{}
+
+func NewRuntimeUsage() *RuntimeUsage {
+	return &RuntimeUsage{}
+}
+
+func (r *RuntimeUsage) Query() string {
+	return `query {
+		runtimeUsage {
+			exec
+			source
+			namespace
+			commitID
+			runtimePath
+			count
+			firstUsed
+			lastUsed
+			running
+		}
+	}`
+}
+
+func (r *RuntimeUsage) Vars() (map[string]interface{}, error) {
+	return map[string]interface{}{}, nil
+}
+
+type IdleRuntimes struct {
+	days int
+}
+
+func NewIdleRuntimes(days int) *IdleRuntimes {
+	return &IdleRuntimes{days}
+}
+
+func (r *IdleRuntimes) Query() string {
+	return `query($days: Int!) {
+		idleRuntimes(days: $days) {
+			namespace
+			projectPath
+			runtimePath
+			lastUsed
+		}
+	}`
+}
+
+func (r *IdleRuntimes) Vars() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"days": r.days,
+	}, nil
+}
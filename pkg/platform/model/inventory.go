@@ -30,6 +30,7 @@ import (
 
 func init() {
 	configMediator.RegisterOption(constants.PreferredGlibcVersionConfig, configMediator.String, "")
+	configMediator.AllowInProject(constants.PreferredGlibcVersionConfig)
 }
 
 type Configurable interface {
@@ -124,11 +124,37 @@ func (m *SvcModel) ReportRuntimeUsage(ctx context.Context, pid int, exec, source
 	return nil
 }
 
-func (m *SvcModel) CheckNotifications(ctx context.Context, command string, flags []string) ([]*graph.NotificationInfo, error) {
+// RuntimeUsage returns how often and when the executables of runtimes were used
+func (m *SvcModel) RuntimeUsage(ctx context.Context) ([]*graph.RuntimeUsage, error) {
+	defer profile.Measure("svc:RuntimeUsage", time.Now())
+
+	r := request.NewRuntimeUsage()
+	resp := []*graph.RuntimeUsage{}
+	if err := m.request(ctx, r, &resp); err != nil {
+		return nil, errs.Wrap(err, "Error sending runtime usage request")
+	}
+
+	return resp, nil
+}
+
+// IdleRuntimes returns the runtimes of checked out projects that have not been used for the given number of days
+func (m *SvcModel) IdleRuntimes(ctx context.Context, days int) ([]*graph.IdleRuntime, error) {
+	defer profile.Measure("svc:IdleRuntimes", time.Now())
+
+	r := request.NewIdleRuntimes(days)
+	resp := []*graph.IdleRuntime{}
+	if err := m.request(ctx, r, &resp); err != nil {
+		return nil, errs.Wrap(err, "Error sending idle runtimes request")
+	}
+
+	return resp, nil
+}
+
+func (m *SvcModel) CheckNotifications(ctx context.Context, command string, flags []string, projectPath string) ([]*graph.NotificationInfo, error) {
 	logging.Debug("Checking for notifications")
 	defer profile.Measure("svc:CheckNotifications", time.Now())
 
-	r := request.NewNotificationRequest(command, flags)
+	r := request.NewNotificationRequest(command, flags, projectPath)
 	resp := []*graph.NotificationInfo{}
 	if err := m.request(ctx, r, &resp); err != nil {
 		return nil, errs.Wrap(err, "Error sending notifications request")
@@ -137,6 +163,19 @@ func (m *SvcModel) CheckNotifications(ctx context.Context, command string, flags
 	return resp, nil
 }
 
+// Notifications returns the notifications that have been shown to the user and are still within their date range
+func (m *SvcModel) Notifications(ctx context.Context) ([]*graph.NotificationInfo, error) {
+	defer profile.Measure("svc:Notifications", time.Now())
+
+	r := request.NewNotificationsListRequest()
+	resp := []*graph.NotificationInfo{}
+	if err := m.request(ctx, r, &resp); err != nil {
+		return nil, errs.Wrap(err, "Error sending notifications list request")
+	}
+
+	return resp, nil
+}
+
 func (m *SvcModel) ConfigChanged(ctx context.Context, key string) error {
 	defer profile.Measure("svc:ConfigChanged", time.Now())
 
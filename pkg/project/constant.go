@@ -0,0 +1,132 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/locale"
+)
+
+// The types a constant can have.
+const (
+	ConstantTypeString = "string"
+	ConstantTypeInt    = "int"
+	ConstantTypeBool   = "bool"
+	ConstantTypePath   = "path"
+	ConstantTypeEnum   = "enum"
+)
+
+// ConstantSource describes where the value of a constant came from.
+type ConstantSource string
+
+const (
+	// ConstantSourceEnv means the value came from the constant's environment variable
+	ConstantSourceEnv ConstantSource = "environment"
+	// ConstantSourceProject means the value came from the activestate.yaml
+	ConstantSourceProject ConstantSource = "project"
+	// ConstantSourceDefault means the value is the constant's default, as the activestate.yaml did not give it one
+	ConstantSourceDefault ConstantSource = "default"
+)
+
+// Type returns the type of the constant
+func (c *Constant) Type() string {
+	if c.constant.Type == "" {
+		return ConstantTypeString
+	}
+	return c.constant.Type
+}
+
+// Description returns the description of the constant
+func (c *Constant) Description() string { return c.constant.Description }
+
+// EnvVarName returns the name of the environment variable that overrides the value of the constant
+func (c *Constant) EnvVarName() string {
+	return constants.ConstantEnvVarPrefix + c.Name()
+}
+
+// Resolve returns the value of the constant along with where it came from. In order of precedence, the value comes
+// from the constant's environment variable, the activestate.yaml, or the constant's default. Values from the
+// activestate.yaml and defaults are expanded, after which the value is validated against the constant's type and
+// pattern. Booleans are normalized to "true" or "false", and relative paths are made relative to the project.
+func (c *Constant) Resolve() (string, ConstantSource, error) {
+	return c.resolve(func(s string) (string, error) {
+		return ExpandFromProject(s, c.project)
+	})
+}
+
+func (c *Constant) resolve(expand func(string) (string, error)) (string, ConstantSource, error) {
+	if value, ok := os.LookupEnv(c.EnvVarName()); ok {
+		value, err := c.validate(value, ConstantSourceEnv)
+		return value, ConstantSourceEnv, err
+	}
+
+	value, source := c.constant.Value, ConstantSourceProject
+	if value == "" && c.constant.Default != "" {
+		value, source = c.constant.Default, ConstantSourceDefault
+	}
+	value, err := expand(value)
+	if err != nil {
+		return "", source, errs.Wrap(err, "Could not expand constant %s", c.Name())
+	}
+	value, err = c.validate(value, source)
+	return value, source, err
+}
+
+func (c *Constant) validate(value string, source ConstantSource) (string, error) {
+	if c.constant.Pattern != "" {
+		rx, err := regexp.Compile("^(?:" + c.constant.Pattern + ")$")
+		if err != nil {
+			return "", locale.WrapInputError(err, "err_constant_pattern", "The pattern of constant [ACTIONABLE]{{.V0}}[/RESET] is not a valid regular expression: {{.V1}}", c.Name(), err.Error())
+		}
+		if !rx.MatchString(value) {
+			return "", locale.NewInputError("err_constant_pattern_mismatch", "The value of constant [ACTIONABLE]{{.V0}}[/RESET] ('{{.V1}}', from {{.V2}}) does not match its pattern: {{.V3}}", c.Name(), value, string(source), c.constant.Pattern)
+		}
+	}
+
+	switch c.Type() {
+	case ConstantTypeString:
+		return value, nil
+	case ConstantTypeInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return "", c.invalidValueError(value, source, locale.Tl("constant_type_int", "an integer"))
+		}
+		return value, nil
+	case ConstantTypeBool:
+		switch strings.ToLower(value) {
+		case "true", "yes", "on", "1":
+			return "true", nil
+		case "false", "no", "off", "0":
+			return "false", nil
+		}
+		return "", c.invalidValueError(value, source, locale.Tl("constant_type_bool", "a boolean"))
+	case ConstantTypePath:
+		if value == "" {
+			return "", c.invalidValueError(value, source, locale.Tl("constant_type_path", "a path"))
+		}
+		if !filepath.IsAbs(value) {
+			value = filepath.Join(c.project.Dir(), value)
+		}
+		return filepath.Clean(value), nil
+	case ConstantTypeEnum:
+		if len(c.constant.Values) == 0 {
+			return "", locale.NewInputError("err_constant_enum_no_values", "Constant [ACTIONABLE]{{.V0}}[/RESET] is an enum, but does not list its values.", c.Name())
+		}
+		for _, v := range c.constant.Values {
+			if v == value {
+				return value, nil
+			}
+		}
+		return "", c.invalidValueError(value, source, locale.Tl("constant_type_enum", "one of: {{.V0}}", strings.Join(c.constant.Values, ", ")))
+	default:
+		return "", locale.NewInputError("err_constant_type", "Constant [ACTIONABLE]{{.V0}}[/RESET] has an unknown type: [ACTIONABLE]{{.V1}}[/RESET]. Valid types are: string, int, bool, path and enum.", c.Name(), c.constant.Type)
+	}
+}
+
+func (c *Constant) invalidValueError(value string, source ConstantSource, expected string) error {
+	return locale.NewInputError("err_constant_invalid", "The value of constant [ACTIONABLE]{{.V0}}[/RESET] ('{{.V1}}', from {{.V2}}) is invalid, it must be {{.V3}}.", c.Name(), value, string(source), expected)
+}
@@ -0,0 +1,72 @@
+package project_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ActiveState/cli/pkg/project"
+)
+
+func TestConstantResolve(t *testing.T) {
+	prj := loadProject(t)
+
+	tests := []struct {
+		name   string
+		value  string
+		source project.ConstantSource
+	}{
+		{"constant", "value", project.ConstantSourceProject},
+		{"port", "8080", project.ConstantSourceDefault},
+		{"debug", "true", project.ConstantSourceProject},
+		{"data", filepath.Join(prj.Dir(), "data"), project.ConstantSourceProject},
+		{"mode", "dev", project.ConstantSourceProject},
+		{"tag", "v1", project.ConstantSourceProject},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := prj.ConstantByName(tt.name)
+			require.NotNil(t, c)
+			value, source, err := c.Resolve()
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+			assert.Equal(t, tt.source, source)
+		})
+	}
+
+	assert.Equal(t, project.ConstantTypeInt, prj.ConstantByName("port").Type())
+	assert.Equal(t, "The port to serve on", prj.ConstantByName("port").Description())
+}
+
+func TestConstantResolveEnv(t *testing.T) {
+	prj := loadProject(t)
+
+	t.Setenv("ACTIVESTATE_CONSTANT_port", "9090")
+	value, source, err := prj.ConstantByName("port").Resolve()
+	require.NoError(t, err)
+	assert.Equal(t, "9090", value)
+	assert.Equal(t, project.ConstantSourceEnv, source)
+
+	t.Setenv("ACTIVESTATE_CONSTANT_mode", "staging")
+	_, _, err = prj.ConstantByName("mode").Resolve()
+	assert.Error(t, err)
+
+	t.Setenv("ACTIVESTATE_CONSTANT_tag", "latest")
+	_, _, err = prj.ConstantByName("tag").Resolve()
+	assert.Error(t, err)
+}
+
+func TestConstantResolveInvalid(t *testing.T) {
+	prj := loadProject(t)
+
+	_, _, err := prj.ConstantByName("badport").Resolve()
+	assert.Error(t, err)
+
+	_, err = project.ExpandFromProject("$constants.badport", prj)
+	assert.Error(t, err, "Invalid constants fail their expansion")
+
+	_, err = project.ExpandFromProject("$constants.self", prj)
+	assert.Error(t, err, "Constants referring to themselves do not recurse infinitely")
+}
@@ -22,6 +22,8 @@ type Expansion struct {
 	Project      *Project
 	Script       *Script
 	BashifyPaths bool
+
+	depth int // the depth of the expansion currently being performed
 }
 
 func NewExpansion(p *Project) *Expansion {
@@ -63,6 +65,7 @@ func (ctx *Expansion) ApplyWithMaxDepth(s string, depth int) (string, error) {
 		var value string
 
 		if expanderFn, foundExpander := expanderRegistry[category]; foundExpander {
+			ctx.depth = depth
 			var err2 error
 			if value, err2 = expanderFn(variable, name, meta, isFunction, ctx); err2 != nil {
 				err = errs.Wrap(err2, "Could not expand %s.%s", category, name)
@@ -223,7 +226,13 @@ func ConstantExpander(_ string, name string, meta string, isFunction bool, ctx *
 	}
 	for _, v := range constrained {
 		if v.ID() == name {
-			return projectfile.MakeConstantsFromConstrainedEntities([]projectfile.ConstrainedEntity{v})[0].Value, nil
+			constant := &Constant{projectfile.MakeConstantsFromConstrainedEntities([]projectfile.ConstrainedEntity{v})[0], ctx.Project}
+			// Expand as part of the current expansion, so that constants referring to themselves are caught.
+			depth := ctx.depth
+			value, _, err := constant.resolve(func(s string) (string, error) {
+				return ctx.ApplyWithMaxDepth(s, depth+1)
+			})
+			return value, err
 		}
 	}
 	return "", nil
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ActiveState/cli/internal/constants"
 	"github.com/ActiveState/cli/internal/environment"
 	"github.com/ActiveState/cli/internal/fileutils"
 	"github.com/ActiveState/cli/internal/language"
@@ -118,6 +119,22 @@ func TestExpandProjectSecret(t *testing.T) {
 	assert.Equal(t, "$ proj-value", expanded, "Expanded simple constant")
 }
 
+func TestExpandProviderSecret(t *testing.T) {
+	pj := loadProject(t)
+	t.Setenv(constants.OptinUnstableEnvVarName, "true")
+	t.Setenv("ACTIVESTATE_TEST_ENV_SECRET", "env-value")
+
+	err := project.RegisterExpander("secrets", project.NewSecretQuietExpander(nil, nil, nil))
+	require.NoError(t, err)
+
+	expanded, err := project.ExpandFromProject("$ $secrets.project.env-secret", pj)
+	require.NoError(t, err)
+	assert.Equal(t, "$ env-value", expanded)
+
+	_, err = project.ExpandFromProject("$secrets.project.fromless-secret", pj)
+	assert.Error(t, err, "secrets from providers other than the platform require a from field")
+}
+
 func TestExpandProjectAlternateSyntax(t *testing.T) {
 	prj := loadProject(t)
 
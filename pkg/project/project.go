@@ -17,6 +17,7 @@ import (
 	"github.com/ActiveState/cli/internal/multilog"
 	"github.com/ActiveState/cli/internal/osutils"
 	"github.com/ActiveState/cli/internal/output"
+	"github.com/ActiveState/cli/internal/secrets/providers"
 	secretsapi "github.com/ActiveState/cli/pkg/platform/api/secrets"
 	"github.com/ActiveState/cli/pkg/platform/authentication"
 	"github.com/ActiveState/cli/pkg/projectfile"
@@ -260,6 +261,12 @@ func (p *Project) Cache() string { return p.projectfile.Cache }
 
 func (p *Project) IsPortable() bool { return p.projectfile.Portable }
 
+// LicensePolicy returns the license policy of the project, or nil if it does not define one
+func (p *Project) LicensePolicy() *projectfile.Licenses { return p.projectfile.Licenses }
+
+// Config returns the config values set by the project
+func (p *Project) Config() projectfile.Config { return p.projectfile.Config }
+
 // Namespace returns project namespace
 func (p *Project) Namespace() *Namespaced {
 	return &Namespaced{Owner: p.projectfile.Owner(), Project: p.projectfile.Name()}
@@ -349,9 +356,10 @@ type Constant struct {
 // Name returns constant name
 func (c *Constant) Name() string { return c.constant.Name }
 
-// Value returns constant value
+// Value returns the resolved value of the constant, see Resolve
 func (c *Constant) Value() (string, error) {
-	return ExpandFromProject(c.constant.Value, c.project)
+	value, _, err := c.Resolve()
+	return value, err
 }
 
 // SecretScope defines the scope of a secret
@@ -412,6 +420,17 @@ func (s *Secret) Name() string { return s.secret.Name }
 // Description returns secret description
 func (s *Secret) Description() string { return s.secret.Description }
 
+// Provider returns the name of the provider the value of the secret comes from
+func (s *Secret) Provider() string {
+	if s.secret.Provider == "" {
+		return providers.Platform
+	}
+	return s.secret.Provider
+}
+
+// IsPlatform returns whether the value of the secret comes from the platform's secrets service
+func (s *Secret) IsPlatform() bool { return providers.IsPlatform(s.secret.Provider) }
+
 // IsUser returns whether this secret is user scoped
 func (s *Secret) IsUser() bool { return s.scope == SecretScopeUser }
 
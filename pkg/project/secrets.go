@@ -8,10 +8,12 @@ import (
 	"github.com/ActiveState/cli/pkg/platform/authentication"
 
 	"github.com/ActiveState/cli/internal/access"
+	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/keypairs"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/secrets"
+	"github.com/ActiveState/cli/internal/secrets/providers"
 	"github.com/ActiveState/cli/pkg/platform/api/mono/mono_models"
 	secretsapi "github.com/ActiveState/cli/pkg/platform/api/secrets"
 	secretsModels "github.com/ActiveState/cli/pkg/platform/api/secrets/secrets_models"
@@ -240,6 +242,40 @@ func (e *SecretExpander) SecretsAccessed() []*SecretAccess {
 	return e.secretsAccessed
 }
 
+// fromProvider obtains the value of secrets that the project declares as coming from a provider other than the
+// platform's secrets service. It returns false for secrets that come from the platform, which are left to the caller.
+func (e *SecretExpander) fromProvider(category string, name string) (string, bool, error) {
+	scope := SecretScopeProject
+	if category == UserCategory {
+		scope = SecretScopeUser
+	}
+	secret := e.project.SecretByName(name, scope, e.cfg, e.auth)
+	if secret == nil || secret.IsPlatform() {
+		return "", false, nil
+	}
+
+	if knownValue, exists := e.cachedSecrets[category+name]; exists {
+		return knownValue, true, nil
+	}
+
+	if secret.secret.From == "" {
+		return "", true, locale.NewInputError("err_secrets_provider_from", "Secret [ACTIONABLE]{{.V0}}[/RESET] comes from the {{.V1}} provider, but does not say where to find its value. Add a '[ACTIONABLE]from[/RESET]' field to its definition.", name, secret.Provider())
+	}
+
+	provider, err := providers.New(secret.Provider(), e.cfg, e.prompt, e.project.Dir())
+	if err != nil {
+		return "", true, err
+	}
+
+	value, err := provider.Value(secret.secret.From)
+	if err != nil {
+		return "", true, errs.Wrap(err, "Could not obtain secret %s from the %s provider", name, secret.Provider())
+	}
+
+	e.cachedSecrets[category+name] = value
+	return value, true, nil
+}
+
 // SecretFunc defines what our expander functions will be returning
 type SecretFunc func(name string, project *Project) (string, error)
 
@@ -260,6 +296,10 @@ func (e *SecretExpander) Expand(_ string, category string, name string, isFuncti
 		e.projectFile = ctx.Project.Source()
 	}
 
+	if value, ok, err := e.fromProvider(category, name); ok {
+		return value, err
+	}
+
 	keypair, err := e.KeyPair()
 	if err != nil {
 		return "", err
@@ -307,6 +347,10 @@ func (e *SecretExpander) ExpandWithPrompt(_ string, category string, name string
 		e.projectFile = ctx.Project.Source()
 	}
 
+	if value, ok, err := e.fromProvider(category, name); ok {
+		return value, err
+	}
+
 	keypair, err := e.KeyPair()
 	if err != nil {
 		return "", err
@@ -336,7 +380,7 @@ func (e *SecretExpander) ExpandWithPrompt(_ string, category string, name string
 	}
 
 	ctx.Project.Outputer.Notice(locale.Tr("secret_value_prompt_summary", name, description, scope, locale.T("secret_prompt_"+scope)))
-	if value, err = e.prompt.InputSecret(locale.Tl("secret_expand", "Secret Expansion"), locale.Tr("secret_value_prompt", name)); err != nil {
+	if value, err = e.prompt.InputSecret(prompt.IDSecretValue, locale.Tl("secret_expand", "Secret Expansion"), locale.Tr("secret_value_prompt", name)); err != nil {
 		return "", locale.NewInputError("secrets_err_value_prompt", "The provided secret value is invalid.")
 	}
 
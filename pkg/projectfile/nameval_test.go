@@ -26,6 +26,10 @@ func TestParseShorthandValid(t *testing.T) {
 		require.NotEmpty(t, c.Value, "Value field of (shorthand) constant is not empty")
 	}
 
+	// The shorthand form cannot describe constants, so drop the description given in longhand before comparing.
+	require.Equal(t, "The environment settings used throughout our project", pl.Constants[2].Description)
+	pl.Constants[2].Description = ""
+
 	require.Equal(t, pl.Constants, ps.Constants, "Longhand constants slice is equal to shorthand constants slice")
 }
 
@@ -106,6 +106,8 @@ type Project struct {
 	Events        Events        `yaml:"events,omitempty"`
 	Scripts       Scripts       `yaml:"scripts,omitempty"`
 	Jobs          Jobs          `yaml:"jobs,omitempty"`
+	Licenses      *Licenses     `yaml:"licenses,omitempty"`
+	Config        Config        `yaml:"config,omitempty"`
 	Private       bool          `yaml:"private,omitempty"`
 	Cache         string        `yaml:"cache,omitempty"`
 	Portable      bool          `yaml:"portable,omitempty"`
@@ -115,6 +117,20 @@ type Project struct {
 	parsedVersion string
 }
 
+// Licenses covers the licenses section, which defines the license policy the runtime must comply with.
+// Each list holds SPDX license identifiers or expressions; identifiers may end in a '*' wildcard.
+type Licenses struct {
+	Level  string   `yaml:"level,omitempty"` // warn, prompt or fail
+	Allow  []string `yaml:"allow,omitempty"`
+	Deny   []string `yaml:"deny,omitempty"`
+	Review []string `yaml:"review,omitempty"`
+}
+
+// Config covers the config section, which sets State Tool config values for this project. Values
+// take precedence over the user's config, but not over environment variables or values locked by the
+// system config. Only the few options that are safe to take from an untrusted project are honoured.
+type Config map[string]interface{}
+
 // Build covers the build map, which can go under languages or packages
 // Build can hold variable keys, so we cannot predict what they are, hence why it is a map
 type Build map[string]string
@@ -123,6 +139,11 @@ type Build map[string]string
 // for type composition related to its yaml.Unmarshaler implementation.
 type ConstantFields struct {
 	Conditional Conditional `yaml:"if,omitempty"`
+	Type        string      `yaml:"type,omitempty"` // string (the default), int, bool, path or enum
+	Description string      `yaml:"description,omitempty"`
+	Default     string      `yaml:"default,omitempty"`
+	Pattern     string      `yaml:"pattern,omitempty"` // regular expression the value must fully match
+	Values      []string    `yaml:"values,omitempty"`  // the allowed values of an enum
 }
 
 // Constant covers the constant structure, which goes under Project
@@ -185,6 +206,8 @@ type Secret struct {
 	Name        string      `yaml:"name"`
 	Description string      `yaml:"description"`
 	Conditional Conditional `yaml:"if,omitempty"`
+	Provider    string      `yaml:"provider,omitempty"` // platform (the default), env, file, exec or vault
+	From        string      `yaml:"from,omitempty"`     // where the provider finds the value, in a provider specific format
 }
 
 var _ ConstrainedEntity = &Secret{}
@@ -0,0 +1,172 @@
+package projectfile
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/locale"
+	"gopkg.in/yaml.v2"
+)
+
+type ErrorNoWorkspace struct{ *locale.LocalizedError }
+
+// Workspace is a collection of projects living in the same repository, as defined by an
+// activestate.workspace.yaml file at its root. For example:
+//
+//	members:
+//	  - services/api
+//	  - services/worker
+//	  - tools/*
+//
+// Members are directories relative to the workspace file, each holding an activestate.yaml. Members may be
+// glob patterns, in which case every matching directory holding an activestate.yaml is a member.
+type Workspace struct {
+	MemberPatterns []string `yaml:"members"`
+
+	path string
+}
+
+// FindWorkspace returns the workspace whose file is in the given directory or one of its parents.
+func FindWorkspace(dir string) (*Workspace, error) {
+	path, err := fileutils.FindFileInPath(dir, constants.WorkspaceFileName)
+	if err != nil {
+		if errors.Is(err, fileutils.ErrorFileNotFound) {
+			return nil, &ErrorNoWorkspace{locale.NewInputError("err_no_workspace", "Could not find a workspace file ([ACTIONABLE]{{.V0}}[/RESET]) in [ACTIONABLE]{{.V1}}[/RESET] or any of its parent directories.", constants.WorkspaceFileName, dir)}
+		}
+		return nil, errs.Wrap(err, "Could not search for workspace file")
+	}
+	return ParseWorkspace(path)
+}
+
+// ParseWorkspace parses the workspace file at the given path.
+func ParseWorkspace(path string) (*Workspace, error) {
+	b, err := fileutils.ReadFile(path)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read workspace file")
+	}
+	w := &Workspace{}
+	if err := yaml.Unmarshal(b, w); err != nil {
+		return nil, locale.WrapInputError(err, "err_workspace_parse", "Could not parse the workspace file at [ACTIONABLE]{{.V0}}[/RESET]: {{.V1}}", path, err.Error())
+	}
+	if len(w.MemberPatterns) == 0 {
+		return nil, locale.NewInputError("err_workspace_no_members", "The workspace file at [ACTIONABLE]{{.V0}}[/RESET] does not list any members.", path)
+	}
+	w.path = path
+	return w, nil
+}
+
+// Path returns the path to the workspace file.
+func (w *Workspace) Path() string {
+	return w.path
+}
+
+// Dir returns the root directory of the workspace.
+func (w *Workspace) Dir() string {
+	return filepath.Dir(w.path)
+}
+
+// Members returns the absolute directories of the workspace's member projects, in the order they are listed.
+func (w *Workspace) Members() ([]string, error) {
+	var members []string
+	seen := map[string]bool{}
+	add := func(dir string) {
+		dir = filepath.Clean(dir)
+		if !seen[dir] {
+			seen[dir] = true
+			members = append(members, dir)
+		}
+	}
+
+	for _, pattern := range w.MemberPatterns {
+		path := filepath.Join(w.Dir(), filepath.FromSlash(pattern))
+		if !strings.ContainsAny(pattern, "*?[") {
+			if !fileutils.FileExists(filepath.Join(path, constants.ConfigFileName)) {
+				return nil, locale.NewInputError("err_workspace_member_not_found", "The workspace member [ACTIONABLE]{{.V0}}[/RESET] does not have an {{.V1}}.", pattern, constants.ConfigFileName)
+			}
+			add(path)
+			continue
+		}
+
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, locale.WrapInputError(err, "err_workspace_member_pattern", "Invalid workspace member pattern: [ACTIONABLE]{{.V0}}[/RESET]", pattern)
+		}
+		sort.Strings(matches)
+		for _, match := range matches {
+			if fileutils.FileExists(filepath.Join(match, constants.ConfigFileName)) {
+				add(match)
+			}
+		}
+	}
+
+	return members, nil
+}
+
+// MemberName returns the name a member is referred to by, which is its path relative to the workspace root.
+func (w *Workspace) MemberName(dir string) string {
+	rel, err := filepath.Rel(w.Dir(), dir)
+	if err != nil {
+		return dir
+	}
+	return filepath.ToSlash(rel)
+}
+
+// FindMember returns the directory of the member project identified by the given name, which is either its
+// path relative to the workspace root, the name of its directory, or the name of its project.
+func (w *Workspace) FindMember(name string) (string, error) {
+	members, err := w.Members()
+	if err != nil {
+		return "", errs.Wrap(err, "Could not get workspace members")
+	}
+
+	var matches []string
+	for _, dir := range members {
+		if w.MemberName(dir) == filepath.ToSlash(filepath.Clean(name)) {
+			return dir, nil
+		}
+		if filepath.Base(dir) == name {
+			matches = append(matches, dir)
+			continue
+		}
+		if pj, err := Parse(filepath.Join(dir, constants.ConfigFileName)); err == nil && strings.EqualFold(pj.Name(), name) {
+			matches = append(matches, dir)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		names := make([]string, len(members))
+		for i, dir := range members {
+			names[i] = w.MemberName(dir)
+		}
+		return "", locale.NewInputError("err_workspace_member_unknown", "The workspace has no member named [ACTIONABLE]{{.V0}}[/RESET]. Its members are: {{.V1}}.", name, strings.Join(names, ", "))
+	default:
+		names := make([]string, len(matches))
+		for i, dir := range matches {
+			names[i] = w.MemberName(dir)
+		}
+		return "", locale.NewInputError("err_workspace_member_ambiguous", "The name [ACTIONABLE]{{.V0}}[/RESET] matches several workspace members: {{.V1}}. Use the member's path instead.", name, strings.Join(names, ", "))
+	}
+}
+
+// HasMember returns whether the given directory is the directory of one of the workspace's members.
+func (w *Workspace) HasMember(dir string) bool {
+	members, err := w.Members()
+	if err != nil {
+		return false
+	}
+	dir = filepath.Clean(dir)
+	for _, m := range members {
+		if m == dir {
+			return true
+		}
+	}
+	return false
+}
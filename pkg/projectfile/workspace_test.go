@@ -0,0 +1,73 @@
+package projectfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeWorkspaceMember(t *testing.T, root, dir, namespace string) string {
+	path := filepath.Join(root, filepath.FromSlash(dir))
+	require.NoError(t, os.MkdirAll(path, 0755))
+	contents := "project: https://platform.activestate.com/" + namespace
+	require.NoError(t, os.WriteFile(filepath.Join(path, constants.ConfigFileName), []byte(contents), 0644))
+	return path
+}
+
+func TestWorkspace(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	api := writeWorkspaceMember(t, root, "services/api", "org/api-service")
+	worker := writeWorkspaceMember(t, root, "services/worker", "org/worker")
+	lint := writeWorkspaceMember(t, root, "tools/lint", "org/lint")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "tools", "docs"), 0755)) // not a project
+	require.NoError(t, os.WriteFile(filepath.Join(root, constants.WorkspaceFileName), []byte(`
+members:
+  - services/api
+  - services/worker
+  - tools/*
+  - services/api
+`), 0644))
+
+	ws, err := FindWorkspace(filepath.Join(worker, "src"))
+	require.NoError(t, err)
+	assert.Equal(t, root, ws.Dir())
+
+	members, err := ws.Members()
+	require.NoError(t, err)
+	assert.Equal(t, []string{api, worker, lint}, members)
+	assert.Equal(t, "services/api", ws.MemberName(api))
+	assert.True(t, ws.HasMember(lint))
+	assert.False(t, ws.HasMember(filepath.Join(root, "tools", "docs")))
+
+	for _, name := range []string{"services/api", "api", "api-service"} {
+		dir, err := ws.FindMember(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, api, dir, name)
+	}
+	_, err = ws.FindMember("docs")
+	assert.Error(t, err)
+}
+
+func TestWorkspaceErrors(t *testing.T) {
+	root := t.TempDir()
+
+	_, err := FindWorkspace(root)
+	var errNoWorkspace *ErrorNoWorkspace
+	assert.ErrorAs(t, err, &errNoWorkspace)
+
+	path := filepath.Join(root, constants.WorkspaceFileName)
+	require.NoError(t, os.WriteFile(path, []byte("members: []"), 0644))
+	_, err = ParseWorkspace(path)
+	assert.Error(t, err, "a workspace needs members")
+
+	require.NoError(t, os.WriteFile(path, []byte("members:\n  - missing\n"), 0644))
+	ws, err := ParseWorkspace(path)
+	require.NoError(t, err)
+	_, err = ws.Members()
+	assert.Error(t, err, "listed members must be projects")
+}
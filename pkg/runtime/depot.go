@@ -588,3 +588,69 @@ func (d *depot) removeStaleArtifacts() error {
 	}
 	return rerr
 }
+
+// DepotSharing describes how the artifacts of several runtimes are shared through the depot.
+type DepotSharing struct {
+	// Artifacts holds the number of depot artifacts deployed to each runtime, keyed by runtime path.
+	Artifacts map[string]int
+	// Unique is the number of distinct artifacts deployed to the runtimes.
+	Unique int
+	// Shared is the number of artifacts deployed to more than one of the runtimes.
+	Shared int
+	// SavedBytes is the disk space saved by linking shared artifacts from the depot rather than copying them
+	// into each runtime.
+	SavedBytes int64
+}
+
+// DepotSharingFor reports how the artifacts deployed to the given runtimes are shared between them.
+func DepotSharingFor(runtimePaths []string) (*DepotSharing, error) {
+	result := &DepotSharing{Artifacts: map[string]int{}}
+	if len(runtimePaths) == 0 {
+		return result, nil
+	}
+
+	d, err := newDepot(runtimePaths[0])
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read depot")
+	}
+
+	paths := map[string]string{}
+	for _, p := range runtimePaths {
+		paths[fileutils.ResolvePathIfPossible(p)] = p
+		result.Artifacts[p] = 0
+	}
+
+	for id, deploys := range d.config.Deployments {
+		users := map[string]bool{}
+		links := 0
+		for _, deploy := range deploys {
+			p, ok := paths[fileutils.ResolvePathIfPossible(deploy.Path)]
+			if !ok || users[p] {
+				continue
+			}
+			users[p] = true
+			result.Artifacts[p]++
+			if deploy.Type == deploymentTypeLink {
+				links++
+			}
+		}
+		if len(users) == 0 {
+			continue
+		}
+		result.Unique++
+		if len(users) < 2 {
+			continue
+		}
+		result.Shared++
+		if links < 2 {
+			continue
+		}
+		size, err := fileutils.GetDirSize(d.Path(id))
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not get artifact size on disk")
+		}
+		result.SavedBytes += size * int64(links-1)
+	}
+
+	return result, nil
+}
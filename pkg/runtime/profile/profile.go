@@ -0,0 +1,176 @@
+// Package profile records how long each phase of a runtime setup takes for each artifact, so that slow setups can be
+// explained. Profiles can be summarized per phase and per artifact, or written as a Chrome trace (as understood by
+// chrome://tracing and https://ui.perfetto.dev).
+package profile
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+// Phase is a step of the runtime setup.
+type Phase string
+
+// Worker phases are units of work that run in the worker pool, and contain the artifact phases.
+const (
+	PhaseObtain  Phase = "obtain"
+	PhaseInstall Phase = "install"
+)
+
+// Artifact phases are the steps an artifact goes through.
+const (
+	PhaseDownload     Phase = "download"
+	PhaseReadArchive  Phase = "read archive"
+	PhaseVerify       Phase = "verify"
+	PhaseUnpack       Phase = "unpack"
+	PhaseDecrypt      Phase = "decrypt"
+	PhaseDeploy       Phase = "deploy"
+	PhaseTransform    Phase = "file transforms"
+	PhaseEcosystemAdd Phase = "ecosystem add"
+	PhaseUninstall    Phase = "uninstall"
+)
+
+// Runtime phases apply to the runtime as a whole.
+const (
+	PhaseBuildWait      Phase = "wait for build"
+	PhaseEcosystemApply Phase = "ecosystem apply"
+	PhaseExecutors      Phase = "executors"
+	PhaseDepotSave      Phase = "save depot"
+)
+
+// MainLane is the lane of the spans that are not part of the worker pool.
+const MainLane = 0
+
+// Span is a phase of the runtime setup, for a specific artifact unless it applies to the runtime as a whole.
+type Span struct {
+	Phase      Phase       `json:"phase"`
+	ArtifactID strfmt.UUID `json:"artifact_id,omitempty"`
+	Name       string      `json:"name,omitempty"`
+	// Lane is the worker that the span ran on, counting from 1, or MainLane if it did not run in the worker pool
+	Lane int `json:"lane"`
+	// Start is relative to the start of the profile
+	Start    time.Duration `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Bytes    int           `json:"bytes,omitempty"`
+	Failed   bool          `json:"failed,omitempty"`
+}
+
+// Profile is the record of a single runtime setup.
+type Profile struct {
+	Start time.Time `json:"start"`
+	// Duration is the time the runtime setup took in total
+	Duration time.Duration `json:"duration"`
+	// Workers is the size of the worker pool
+	Workers int    `json:"workers"`
+	Spans   []Span `json:"spans"`
+	Failed  bool   `json:"failed,omitempty"`
+}
+
+// Recorder records a profile. A nil recorder records nothing, so that callers do not need to check whether profiling
+// is enabled.
+type Recorder struct {
+	mutex   sync.Mutex
+	profile *Profile
+	lanes   []bool // whether each worker lane is in use
+}
+
+// NewRecorder starts recording a profile of a runtime setup with the given worker pool size.
+func NewRecorder(workers int) *Recorder {
+	return &Recorder{
+		profile: &Profile{Start: time.Now(), Workers: workers, Spans: []Span{}},
+		lanes:   make([]bool, workers),
+	}
+}
+
+// Acquire reserves a worker lane for a unit of work from the worker pool. The spans of that work should be recorded
+// on the returned lane, and the lane released once the work is done.
+func (r *Recorder) Acquire() int {
+	if r == nil {
+		return MainLane
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for i, busy := range r.lanes {
+		if !busy {
+			r.lanes[i] = true
+			return i + 1
+		}
+	}
+	// More work than workers should not happen, but should it happen we want to see it rather than hide it.
+	r.lanes = append(r.lanes, true)
+	return len(r.lanes)
+}
+
+// Release frees a lane reserved by Acquire.
+func (r *Recorder) Release(lane int) {
+	if r == nil || lane == MainLane {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.lanes[lane-1] = false
+}
+
+// Begin starts a span, which ends when the returned function is called with the number of bytes the span processed
+// and the error it ended with, if any.
+func (r *Recorder) Begin(lane int, phase Phase, artifactID strfmt.UUID, name string) func(bytes int, err error) {
+	if r == nil {
+		return func(int, error) {}
+	}
+	start := time.Now()
+	return func(bytes int, err error) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.profile.Spans = append(r.profile.Spans, Span{
+			Phase:      phase,
+			ArtifactID: artifactID,
+			Name:       name,
+			Lane:       lane,
+			Start:      start.Sub(r.profile.Start),
+			Duration:   time.Since(start),
+			Bytes:      bytes,
+			Failed:     err != nil,
+		})
+	}
+}
+
+// Finish ends the profile and returns it.
+func (r *Recorder) Finish(err error) *Profile {
+	if r == nil {
+		return nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.profile.Duration = time.Since(r.profile.Start)
+	r.profile.Failed = err != nil
+	sort.SliceStable(r.profile.Spans, func(i, j int) bool { return r.profile.Spans[i].Start < r.profile.Spans[j].Start })
+	return r.profile
+}
+
+// Load reads a profile written by Save.
+func Load(r io.Reader) (*Profile, error) {
+	p := &Profile{}
+	if err := json.NewDecoder(r).Decode(p); err != nil {
+		return nil, errs.Wrap(err, "Could not decode profile")
+	}
+	return p, nil
+}
+
+// Save writes the profile, so that it can be reported on later.
+func (p *Profile) Save(w io.Writer) error {
+	if err := json.NewEncoder(w).Encode(p); err != nil {
+		return errs.Wrap(err, "Could not encode profile")
+	}
+	return nil
+}
+
+func isWorkerPhase(phase Phase) bool {
+	return phase == PhaseObtain || phase == PhaseInstall
+}
@@ -0,0 +1,143 @@
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	artifactA = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+	artifactB = strfmt.UUID("00000000-0000-0000-0000-00000000000b")
+)
+
+// testProfile has two artifacts obtained in parallel, and installed one after the other on the same lane.
+func testProfile() *Profile {
+	ms := time.Millisecond
+	return &Profile{
+		Duration: 100 * ms,
+		Workers:  2,
+		Spans: []Span{
+			{Phase: PhaseObtain, ArtifactID: artifactA, Name: "a", Lane: 1, Start: 0, Duration: 40 * ms},
+			{Phase: PhaseDownload, ArtifactID: artifactA, Name: "a", Lane: 1, Start: 0, Duration: 30 * ms, Bytes: 2048},
+			{Phase: PhaseUnpack, ArtifactID: artifactA, Name: "a", Lane: 1, Start: 30 * ms, Duration: 10 * ms},
+			{Phase: PhaseObtain, ArtifactID: artifactB, Name: "b", Lane: 2, Start: 0, Duration: 20 * ms},
+			{Phase: PhaseDownload, ArtifactID: artifactB, Name: "b", Lane: 2, Start: 0, Duration: 20 * ms, Bytes: 1024},
+			{Phase: PhaseInstall, ArtifactID: artifactB, Name: "b", Lane: 2, Start: 20 * ms, Duration: 20 * ms},
+			{Phase: PhaseInstall, ArtifactID: artifactA, Name: "a", Lane: 1, Start: 40 * ms, Duration: 20 * ms},
+			{Phase: PhaseExecutors, Lane: MainLane, Start: 60 * ms, Duration: 40 * ms},
+		},
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder(2)
+	first := r.Acquire()
+	second := r.Acquire()
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 2, second)
+
+	r.Release(first)
+	assert.Equal(t, first, r.Acquire(), "released lanes should be reused")
+
+	r.Begin(first, PhaseDownload, artifactA, "a")(1024, nil)
+	r.Begin(MainLane, PhaseExecutors, "", "")(0, errors.New("failure"))
+
+	p := r.Finish(nil)
+	require.Len(t, p.Spans, 2)
+	assert.Equal(t, Span{Phase: PhaseDownload, ArtifactID: artifactA, Name: "a", Lane: first, Start: p.Spans[0].Start, Duration: p.Spans[0].Duration, Bytes: 1024}, p.Spans[0])
+	assert.True(t, p.Spans[1].Failed)
+	assert.False(t, p.Failed)
+	assert.Equal(t, 2, p.Workers)
+}
+
+func TestNilRecorder(t *testing.T) {
+	var r *Recorder
+	lane := r.Acquire()
+	assert.Equal(t, MainLane, lane)
+	r.Begin(lane, PhaseDownload, artifactA, "a")(0, nil)
+	r.Release(lane)
+	assert.Nil(t, r.Finish(nil))
+}
+
+func TestSaveLoad(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, testProfile().Save(buf))
+	p, err := Load(buf)
+	require.NoError(t, err)
+	assert.Equal(t, testProfile().Spans, p.Spans)
+}
+
+func TestPhases(t *testing.T) {
+	phases := testProfile().Phases()
+	names := []Phase{}
+	for _, p := range phases {
+		names = append(names, p.Phase)
+	}
+	assert.Equal(t, []Phase{PhaseObtain, PhaseDownload, PhaseUnpack, PhaseInstall, PhaseExecutors}, names)
+
+	download := phases[1]
+	assert.Equal(t, 2, download.Count)
+	assert.Equal(t, 50*time.Millisecond, download.Total)
+	assert.Equal(t, 30*time.Millisecond, download.Max)
+	assert.Equal(t, 3072, download.Bytes)
+}
+
+func TestArtifacts(t *testing.T) {
+	artifacts := testProfile().Artifacts()
+	require.Len(t, artifacts, 2)
+
+	assert.Equal(t, "a", artifacts[0].Name, "slowest artifact should come first")
+	assert.Equal(t, 60*time.Millisecond, artifacts[0].Total)
+	assert.Equal(t, 2048, artifacts[0].Bytes)
+	assert.Equal(t, 10*time.Millisecond, artifacts[0].Phases[PhaseUnpack])
+
+	assert.Equal(t, "b", artifacts[1].Name)
+	assert.Equal(t, 40*time.Millisecond, artifacts[1].Total)
+}
+
+func TestConcurrency(t *testing.T) {
+	c := testProfile().Concurrency()
+	assert.Equal(t, 2, c.Workers)
+	assert.Equal(t, 2, c.Peak)
+	assert.InDelta(t, 1.0, c.Average, 0.001)
+}
+
+func TestWriteTrace(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, testProfile().WriteTrace(buf))
+
+	var trace struct {
+		TraceEvents []traceEvent `json:"traceEvents"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &trace))
+
+	var complete, counters int
+	threads := map[int]string{}
+	for _, ev := range trace.TraceEvents {
+		switch ev.Ph {
+		case "X":
+			complete++
+		case "C":
+			counters++
+		case "M":
+			if ev.Name == "thread_name" {
+				threads[ev.Tid] = ev.Args["name"].(string)
+			}
+		}
+		if ev.Name == "install a" {
+			assert.Equal(t, 40000.0, ev.Ts)
+			assert.Equal(t, 20000.0, ev.Dur)
+			assert.Equal(t, 1, ev.Tid)
+		}
+	}
+	assert.Equal(t, len(testProfile().Spans), complete)
+	assert.NotZero(t, counters)
+	assert.Equal(t, map[int]string{MainLane: "main", 1: "worker 1", 2: "worker 2"}, threads)
+}
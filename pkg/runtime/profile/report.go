@@ -0,0 +1,245 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+// phaseOrder is the order phases are reported in, which is the order they happen in.
+var phaseOrder = []Phase{
+	PhaseBuildWait,
+	PhaseObtain, PhaseDownload, PhaseReadArchive, PhaseVerify, PhaseUnpack, PhaseDecrypt,
+	PhaseUninstall,
+	PhaseInstall, PhaseDeploy, PhaseTransform, PhaseEcosystemAdd,
+	PhaseEcosystemApply, PhaseExecutors, PhaseDepotSave,
+}
+
+// PhaseSummary sums up the spans of a phase.
+type PhaseSummary struct {
+	Phase Phase         `json:"phase"`
+	Count int           `json:"count"`
+	Total time.Duration `json:"total"`
+	Max   time.Duration `json:"max"`
+	Bytes int           `json:"bytes,omitempty"`
+}
+
+// Phases sums up the spans of the profile by phase.
+func (p *Profile) Phases() []PhaseSummary {
+	byPhase := map[Phase]*PhaseSummary{}
+	for _, s := range p.Spans {
+		sum, ok := byPhase[s.Phase]
+		if !ok {
+			sum = &PhaseSummary{Phase: s.Phase}
+			byPhase[s.Phase] = sum
+		}
+		sum.Count++
+		sum.Total += s.Duration
+		sum.Bytes += s.Bytes
+		if s.Duration > sum.Max {
+			sum.Max = s.Duration
+		}
+	}
+
+	result := []PhaseSummary{}
+	for _, phase := range phaseOrder {
+		if sum, ok := byPhase[phase]; ok {
+			result = append(result, *sum)
+			delete(byPhase, phase)
+		}
+	}
+	// Phases we do not know the order of, eg. from profiles written by a newer version, go last.
+	rest := []PhaseSummary{}
+	for _, sum := range byPhase {
+		rest = append(rest, *sum)
+	}
+	sort.Slice(rest, func(i, j int) bool { return rest[i].Phase < rest[j].Phase })
+	return append(result, rest...)
+}
+
+// ArtifactSummary sums up the spans of an artifact.
+type ArtifactSummary struct {
+	ArtifactID strfmt.UUID             `json:"artifact_id"`
+	Name       string                  `json:"name"`
+	Phases     map[Phase]time.Duration `json:"phases"`
+	// Total is the time the artifact spent in the worker pool
+	Total time.Duration `json:"total"`
+	// Bytes is the size of the artifact as downloaded, or read from an archive
+	Bytes int `json:"bytes,omitempty"`
+}
+
+// Artifacts sums up the spans of the profile by artifact, slowest first.
+func (p *Profile) Artifacts() []ArtifactSummary {
+	byArtifact := map[strfmt.UUID]*ArtifactSummary{}
+	for _, s := range p.Spans {
+		if s.ArtifactID == "" {
+			continue
+		}
+		sum, ok := byArtifact[s.ArtifactID]
+		if !ok {
+			sum = &ArtifactSummary{ArtifactID: s.ArtifactID, Name: s.Name, Phases: map[Phase]time.Duration{}}
+			byArtifact[s.ArtifactID] = sum
+		}
+		sum.Phases[s.Phase] += s.Duration
+		if isWorkerPhase(s.Phase) {
+			sum.Total += s.Duration
+		}
+		if s.Phase == PhaseDownload || s.Phase == PhaseReadArchive {
+			sum.Bytes += s.Bytes
+		}
+	}
+
+	result := make([]ArtifactSummary, 0, len(byArtifact))
+	for _, sum := range byArtifact {
+		result = append(result, *sum)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// Concurrency describes how well the worker pool was used.
+type Concurrency struct {
+	Workers int `json:"workers"`
+	// Peak is the largest number of workers that were busy at the same time
+	Peak int `json:"peak"`
+	// Average is the average number of busy workers over the duration of the runtime setup
+	Average float64 `json:"average"`
+}
+
+// Concurrency reports how well the worker pool was used.
+func (p *Profile) Concurrency() Concurrency {
+	c := Concurrency{Workers: p.Workers}
+
+	var busy time.Duration
+	for _, change := range p.workerChanges() {
+		if change.active > c.Peak {
+			c.Peak = change.active
+		}
+	}
+	for _, s := range p.Spans {
+		if isWorkerPhase(s.Phase) {
+			busy += s.Duration
+		}
+	}
+	if p.Duration > 0 {
+		c.Average = float64(busy) / float64(p.Duration)
+	}
+
+	return c
+}
+
+type workerChange struct {
+	at     time.Duration
+	active int
+}
+
+// workerChanges returns the number of busy workers over time.
+func (p *Profile) workerChanges() []workerChange {
+	type edge struct {
+		at    time.Duration
+		delta int
+	}
+	edges := []edge{}
+	for _, s := range p.Spans {
+		if isWorkerPhase(s.Phase) {
+			edges = append(edges, edge{s.Start, 1}, edge{s.Start + s.Duration, -1})
+		}
+	}
+	// Process ends before starts at the same time, so that back to back work does not count as concurrent.
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].at != edges[j].at {
+			return edges[i].at < edges[j].at
+		}
+		return edges[i].delta < edges[j].delta
+	})
+
+	changes := make([]workerChange, 0, len(edges))
+	active := 0
+	for _, e := range edges {
+		active += e.delta
+		changes = append(changes, workerChange{e.at, active})
+	}
+	return changes
+}
+
+// traceEvent is an event in the Chrome trace event format.
+type traceEvent struct {
+	Name string                 `json:"name"`
+	Cat  string                 `json:"cat,omitempty"`
+	Ph   string                 `json:"ph"`
+	Ts   float64                `json:"ts"`
+	Dur  float64                `json:"dur,omitempty"`
+	Pid  int                    `json:"pid"`
+	Tid  int                    `json:"tid"`
+	Args map[string]interface{} `json:"args,omitempty"`
+}
+
+// WriteTrace writes the profile in the Chrome trace event format, with a row for each worker.
+func (p *Profile) WriteTrace(w io.Writer) error {
+	const pid = 1
+	micros := func(d time.Duration) float64 { return float64(d) / float64(time.Microsecond) }
+
+	evs := []traceEvent{
+		{Name: "process_name", Ph: "M", Pid: pid, Args: map[string]interface{}{"name": "runtime setup"}},
+		{Name: "thread_name", Ph: "M", Pid: pid, Tid: MainLane, Args: map[string]interface{}{"name": "main"}},
+	}
+	lanes := map[int]bool{}
+	for _, s := range p.Spans {
+		if s.Lane != MainLane && !lanes[s.Lane] {
+			lanes[s.Lane] = true
+			evs = append(evs, traceEvent{Name: "thread_name", Ph: "M", Pid: pid, Tid: s.Lane, Args: map[string]interface{}{"name": fmt.Sprintf("worker %d", s.Lane)}})
+		}
+	}
+
+	for _, s := range p.Spans {
+		name := string(s.Phase)
+		args := map[string]interface{}{}
+		if s.ArtifactID != "" {
+			if isWorkerPhase(s.Phase) {
+				name = fmt.Sprintf("%s %s", s.Phase, s.Name)
+			}
+			args["artifact_id"] = s.ArtifactID.String()
+			args["artifact"] = s.Name
+		} else if s.Name != "" {
+			args["name"] = s.Name
+		}
+		if s.Bytes > 0 {
+			args["bytes"] = s.Bytes
+		}
+		if s.Failed {
+			args["failed"] = true
+		}
+		evs = append(evs, traceEvent{
+			Name: name,
+			Cat:  string(s.Phase),
+			Ph:   "X",
+			Ts:   micros(s.Start),
+			Dur:  micros(s.Duration),
+			Pid:  pid,
+			Tid:  s.Lane,
+			Args: args,
+		})
+	}
+
+	for _, change := range p.workerChanges() {
+		evs = append(evs, traceEvent{Name: "busy workers", Ph: "C", Ts: micros(change.at), Pid: pid, Args: map[string]interface{}{"workers": change.active}})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(map[string]interface{}{"traceEvents": evs, "displayTimeUnit": "ms"}); err != nil {
+		return errs.Wrap(err, "Could not encode trace")
+	}
+	return nil
+}
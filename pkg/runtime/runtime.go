@@ -4,6 +4,7 @@ import (
 	"maps"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/ActiveState/cli/internal/errs"
 	"github.com/ActiveState/cli/internal/fileutils"
@@ -17,6 +18,7 @@ const (
 	configDir    = ".activestate"
 	hashFile     = "hash.txt"
 	buildLogFile = "build.log"
+	profileFile  = "profile.json"
 	executorDir  = "exec"
 )
 
@@ -181,10 +183,47 @@ func (r *Runtime) Path() string {
 	return r.path
 }
 
+// Ingredient identifies the ingredient an installed artifact was built from.
+type Ingredient struct {
+	Namespace string
+	Name      string
+	Version   string
+}
+
+// Ingredients returns the ingredients of the artifacts installed in this runtime, as recorded by the
+// depot at install time. Artifacts installed before the depot recorded this information are omitted.
+func (r *Runtime) Ingredients() []Ingredient {
+	var result []Ingredient
+	for id := range r.depot.List(r.path) {
+		_, info := r.depot.Exists(id)
+		if info == nil || info.Name == "" {
+			continue
+		}
+		result = append(result, Ingredient{info.Namespace, info.Name, info.Version})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
 func IsRuntimeDir(dir string) bool {
 	return fileutils.TargetExists(filepath.Join(dir, configDir, hashFile))
 }
 
+// HashPath returns the path of the file that is written whenever the runtime at the given directory has been set up.
+func HashPath(baseDir string) string {
+	return filepath.Join(baseDir, configDir, hashFile)
+}
+
 func ExecutorsPath(baseDir string) string {
 	return filepath.Join(baseDir, executorDir)
 }
+
+// ProfilePath returns the path of the profile of the last setup of the runtime at the given directory.
+func ProfilePath(baseDir string) string {
+	return filepath.Join(baseDir, configDir, profileFile)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -37,6 +38,7 @@ import (
 	"github.com/ActiveState/cli/pkg/runtime/internal/buildlog"
 	"github.com/ActiveState/cli/pkg/runtime/internal/camel"
 	"github.com/ActiveState/cli/pkg/runtime/internal/envdef"
+	"github.com/ActiveState/cli/pkg/runtime/profile"
 	"github.com/ActiveState/cli/pkg/sysinfo"
 )
 
@@ -116,6 +118,9 @@ type setup struct {
 	// was available to decrypt them.
 	skipMutex sync.Mutex
 	skipped   map[strfmt.UUID]struct{}
+
+	// profile records where the time of the setup goes.
+	profile *profile.Recorder
 }
 
 func newSetup(path string, bp *buildplan.BuildPlan, env *envdef.Collection, depot *depot, opts *Opts) (*setup, error) {
@@ -241,6 +246,11 @@ func (s *setup) skippedAny() bool {
 }
 
 func (s *setup) RunAndWait() (rerr error) {
+	s.profile = profile.NewRecorder(maxConcurrency)
+	defer func() {
+		s.saveProfile(s.profile.Finish(rerr))
+	}()
+
 	defer func() {
 		// Handle success / failure event
 		var name = "success"
@@ -278,6 +288,19 @@ func (s *setup) RunAndWait() (rerr error) {
 	return nil
 }
 
+// saveProfile saves the profile of the setup so that it can be reported on. Failing to do so is not worth failing the
+// setup over.
+func (s *setup) saveProfile(p *profile.Profile) {
+	var b bytes.Buffer
+	if err := p.Save(&b); err != nil {
+		multilog.Error("Could not save runtime profile: %v", errs.JoinMessage(err))
+		return
+	}
+	if err := fileutils.WriteFile(ProfilePath(s.path), b.Bytes()); err != nil {
+		logging.Warning("Could not write runtime profile: %v", errs.JoinMessage(err))
+	}
+}
+
 func (s *setup) update() error {
 	if err := fileutils.MkdirUnlessExists(filepath.Join(s.path, configDir)); err != nil {
 		return errs.Wrap(err, "Could not create runtime config dir")
@@ -304,7 +327,10 @@ func (s *setup) update() error {
 
 	// Wait for build to finish
 	if !s.buildplan.IsBuildReady() && len(s.toBuild) > 0 {
-		if err := blog.Wait(context.Background()); err != nil {
+		endWait := s.profile.Begin(profile.MainLane, profile.PhaseBuildWait, "", "")
+		err := blog.Wait(context.Background())
+		endWait(0, err)
+		if err != nil {
 			if !buildlogstream.IsStreamDenied(err) {
 				return errs.Wrap(err, "errors occurred during buildlog streaming")
 			}
@@ -415,11 +441,18 @@ func (s *setup) onArtifactBuildReady(blog *buildlog.BuildLog, artifact *buildpla
 }
 
 func (s *setup) obtain(artifact *buildplan.Artifact) (rerr error) {
+	lane := s.profile.Acquire()
+	defer s.profile.Release(lane)
+	endObtain := s.profile.Begin(lane, profile.PhaseObtain, artifact.ArtifactID, artifact.Name())
+	defer func() { endObtain(0, rerr) }()
+
 	var b []byte
 	if s.opts.FromArchive == nil {
 		// Download artifact
 		var err error
+		end := s.profile.Begin(lane, profile.PhaseDownload, artifact.ArtifactID, artifact.Name())
 		b, err = s.download(artifact)
+		end(len(b), err)
 		if err != nil {
 			return errs.Wrap(err, "download failed")
 		}
@@ -428,19 +461,24 @@ func (s *setup) obtain(artifact *buildplan.Artifact) (rerr error) {
 		var err error
 		name := artifact.ArtifactID.String() + s.opts.FromArchive.ArtifactExt
 		artifactFile := filepath.Join(s.opts.FromArchive.Dir, name)
+		end := s.profile.Begin(lane, profile.PhaseReadArchive, artifact.ArtifactID, artifact.Name())
 		b, err = fileutils.ReadFile(artifactFile)
+		end(len(b), err)
 		if err != nil {
 			return errs.Wrap(err, "read from archive failed")
 		}
 	}
 
 	// Verify checksum.
-	if err := s.verifyArtifact(artifact, b); err != nil {
+	end := s.profile.Begin(lane, profile.PhaseVerify, artifact.ArtifactID, artifact.Name())
+	err := s.verifyArtifact(artifact, b)
+	end(len(b), err)
+	if err != nil {
 		return errs.Wrap(err, "Artifact checksum validation failed")
 	}
 
 	// Unpack artifact
-	if err := s.unpack(artifact, b); err != nil {
+	if err := s.unpack(artifact, b, lane); err != nil {
 		return errs.Wrap(err, "unpack failed")
 	}
 
@@ -503,7 +541,7 @@ func (s *setup) verifyArtifact(artifact *buildplan.Artifact, b []byte) error {
 	return nil
 }
 
-func (s *setup) unpack(artifact *buildplan.Artifact, b []byte) (rerr error) {
+func (s *setup) unpack(artifact *buildplan.Artifact, b []byte, lane int) (rerr error) {
 	defer func() {
 		if rerr != nil {
 			if err := s.fireEvent(events.ArtifactUnpackFailure{artifact.ArtifactID, rerr}); err != nil {
@@ -534,7 +572,10 @@ func (s *setup) unpack(artifact *buildplan.Artifact, b []byte) (rerr error) {
 		},
 	}, bytes.NewReader(b))
 	unpackPath := s.depot.Path(artifact.ArtifactID)
-	if err := ua.Unarchive(proxy, unpackPath); err != nil {
+	endUnpack := s.profile.Begin(lane, profile.PhaseUnpack, artifact.ArtifactID, artifact.Name())
+	err := ua.Unarchive(proxy, unpackPath)
+	endUnpack(len(b), err)
+	if err != nil {
 		if err2 := os.RemoveAll(unpackPath); err2 != nil {
 			return errs.Pack(err, errs.Wrap(err2, "unable to remove partially-unpacked directory"))
 		}
@@ -542,7 +583,11 @@ func (s *setup) unpack(artifact *buildplan.Artifact, b []byte) (rerr error) {
 	}
 
 	// Decrypt and extract an encrypted private-ingredient payload, if present.
+	endDecrypt := s.profile.Begin(lane, profile.PhaseDecrypt, artifact.ArtifactID, artifact.Name())
 	outcome, err := s.decryptPayload(artifact.Name(), unpackPath)
+	if outcome != decryptNotEncrypted || err != nil {
+		endDecrypt(0, err)
+	}
 	if err != nil {
 		if err2 := os.RemoveAll(unpackPath); err2 != nil {
 			return errs.Pack(err, errs.Wrap(err2, "unable to remove partially-unpacked directory"))
@@ -877,10 +922,17 @@ func (s *setup) install(artifact *buildplan.Artifact) (rerr error) {
 		return errs.Wrap(err, "Could not handle ArtifactInstallStarted event")
 	}
 
+	lane := s.profile.Acquire()
+	defer s.profile.Release(lane)
+	endInstall := s.profile.Begin(lane, profile.PhaseInstall, id, artifact.Name())
+	defer func() { endInstall(0, rerr) }()
+
 	artifactDepotPath := s.depot.Path(id)
 
 	if ecosys := filterEcosystemMatchingArtifact(artifact, s.ecosystems); ecosys != nil {
+		end := s.profile.Begin(lane, profile.PhaseEcosystemAdd, id, artifact.Name())
 		files, err := ecosys.Add(artifact, artifactDepotPath)
+		end(0, err)
 		if err != nil {
 			return errs.Wrap(err, "Ecosystem unable to add artifact")
 		}
@@ -902,18 +954,24 @@ func (s *setup) install(artifact *buildplan.Artifact) (rerr error) {
 	}
 
 	var deploy *deployment
+	endDeploy := s.profile.Begin(lane, profile.PhaseDeploy, id, artifact.Name())
 	if envDef.NeedsTransforms() || !s.supportsHardLinks || s.opts.Portable {
 		deploy, err = s.depot.DeployViaCopy(id, envDef.InstallDir, s.path)
+		endDeploy(0, err)
 		if err != nil {
 			return errs.Wrap(err, "Could not deploy artifact via copy")
 		}
 		if envDef.NeedsTransforms() {
-			if err := envDef.ApplyFileTransforms(s.path); err != nil {
+			end := s.profile.Begin(lane, profile.PhaseTransform, id, artifact.Name())
+			err := envDef.ApplyFileTransforms(s.path)
+			end(0, err)
+			if err != nil {
 				return errs.Wrap(err, "Could not apply env transforms")
 			}
 		}
 	} else {
 		deploy, err = s.depot.DeployViaLink(id, envDef.InstallDir, s.path)
+		endDeploy(0, err)
 		if err != nil {
 			return errs.Wrap(err, "Could not deploy artifact via link")
 		}
@@ -943,6 +1001,9 @@ func (s *setup) uninstall(id strfmt.UUID) (rerr error) {
 		return errs.Wrap(err, "Could not handle ArtifactUninstallStarted event")
 	}
 
+	endUninstall := s.profile.Begin(profile.MainLane, profile.PhaseUninstall, id, "")
+	defer func() { endUninstall(0, rerr) }()
+
 	artifactDepotPath := s.depot.Path(id)
 
 	envDef, err := s.env.Load(artifactDepotPath)
@@ -1005,18 +1066,27 @@ func (s *setup) postProcess() (rerr error) {
 
 	// Tell applicable ecosystems to apply changes.
 	for _, e := range s.ecosystems {
-		if err := e.Apply(); err != nil {
+		end := s.profile.Begin(profile.MainLane, profile.PhaseEcosystemApply, "", fmt.Sprintf("%T", e))
+		err := e.Apply()
+		end(0, err)
+		if err != nil {
 			return errs.Wrap(err, "Could not apply ecosystem changes")
 		}
 	}
 
 	// Update executors
-	if err := s.updateExecutors(); err != nil {
+	end := s.profile.Begin(profile.MainLane, profile.PhaseExecutors, "", "")
+	err := s.updateExecutors()
+	end(0, err)
+	if err != nil {
 		return errs.Wrap(err, "Could not update executors")
 	}
 
 	// Save depot changes
-	if err := s.depot.Save(); err != nil {
+	end = s.profile.Begin(profile.MainLane, profile.PhaseDepotSave, "", "")
+	err = s.depot.Save()
+	end(0, err)
+	if err != nil {
 		return errs.Wrap(err, "Could not save depot")
 	}
 
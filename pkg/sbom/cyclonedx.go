@@ -0,0 +1,193 @@
+package sbom
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/pkg/spdx"
+)
+
+// The types below describe the subset of the CycloneDX 1.5 JSON format (https://cyclonedx.org/docs/1.5/json/)
+// that we populate.
+
+type cdxDocument struct {
+	BOMFormat       string             `json:"bomFormat"`
+	SpecVersion     string             `json:"specVersion"`
+	SerialNumber    string             `json:"serialNumber"`
+	Version         int                `json:"version"`
+	Metadata        cdxMetadata        `json:"metadata"`
+	Components      []cdxComponent     `json:"components"`
+	Dependencies    []cdxDependency    `json:"dependencies"`
+	Vulnerabilities []cdxVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type cdxMetadata struct {
+	Timestamp  string        `json:"timestamp"`
+	Tools      cdxTools      `json:"tools"`
+	Component  cdxComponent  `json:"component"`
+	Properties []cdxProperty `json:"properties,omitempty"`
+}
+
+type cdxTools struct {
+	Components []cdxComponent `json:"components"`
+}
+
+type cdxComponent struct {
+	Type       string        `json:"type"`
+	BOMRef     string        `json:"bom-ref,omitempty"`
+	Name       string        `json:"name"`
+	Version    string        `json:"version,omitempty"`
+	Scope      string        `json:"scope,omitempty"`
+	PURL       string        `json:"purl,omitempty"`
+	Licenses   []cdxLicense  `json:"licenses,omitempty"`
+	Properties []cdxProperty `json:"properties,omitempty"`
+}
+
+// cdxLicense is either an SPDX license expression, or a license that is not described by one.
+type cdxLicense struct {
+	Expression string          `json:"expression,omitempty"`
+	License    *cdxLicenseName `json:"license,omitempty"`
+}
+
+type cdxLicenseName struct {
+	Name string `json:"name"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cdxDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+type cdxVulnerability struct {
+	ID      string      `json:"id"`
+	Source  *cdxSource  `json:"source,omitempty"`
+	Ratings []cdxRating `json:"ratings,omitempty"`
+	Affects []cdxAffect `json:"affects"`
+}
+
+type cdxSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type cdxRating struct {
+	Severity string `json:"severity"`
+}
+
+type cdxAffect struct {
+	Ref string `json:"ref"`
+}
+
+const cdxRootRef = "root"
+
+func (s *SBOM) cycloneDX() *cdxDocument {
+	doc := &cdxDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + s.SerialNumber.String(),
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: s.Timestamp.Format(time.RFC3339),
+			Tools: cdxTools{Components: []cdxComponent{{
+				Type:    "application",
+				Name:    constants.CommandName,
+				Version: constants.VersionNumber,
+			}}},
+			Component: cdxComponent{
+				Type:    "application",
+				BOMRef:  cdxRootRef,
+				Name:    s.Project,
+				Version: s.CommitID.String(),
+			},
+			Properties: []cdxProperty{
+				{"activestate:commit", s.CommitID.String()},
+				{"activestate:platform", s.PlatformID.String()},
+			},
+		},
+		Components:   []cdxComponent{},
+		Dependencies: []cdxDependency{},
+	}
+	if s.PlatformName != "" {
+		doc.Metadata.Properties = append(doc.Metadata.Properties, cdxProperty{"activestate:platform:name", s.PlatformName})
+	}
+
+	root := cdxDependency{Ref: cdxRootRef, DependsOn: []string{}}
+	for _, c := range s.requested() {
+		root.DependsOn = append(root.DependsOn, c.ID.String())
+	}
+	doc.Dependencies = append(doc.Dependencies, root)
+
+	for _, c := range s.Components {
+		component := cdxComponent{
+			Type:    "library",
+			BOMRef:  c.ID.String(),
+			Name:    c.Name,
+			Version: c.Version,
+			Scope:   "required",
+			PURL:    c.PURL(),
+			Properties: []cdxProperty{
+				{"activestate:namespace", c.Namespace},
+				{"activestate:requested", boolString(c.Requested)},
+			},
+		}
+		if expr, ok := licenseExpression(c.Licenses); ok {
+			component.Licenses = []cdxLicense{{Expression: expr}}
+		} else {
+			for _, name := range spdx.Unique(c.Licenses) {
+				component.Licenses = append(component.Licenses, cdxLicense{License: &cdxLicenseName{name}})
+			}
+		}
+		doc.Components = append(doc.Components, component)
+
+		dep := cdxDependency{Ref: c.ID.String(), DependsOn: []string{}}
+		for _, id := range c.Dependencies {
+			dep.DependsOn = append(dep.DependsOn, id.String())
+		}
+		doc.Dependencies = append(doc.Dependencies, dep)
+
+		for _, v := range c.Vulnerabilities {
+			vuln := cdxVulnerability{
+				ID:      v.ID,
+				Affects: []cdxAffect{{c.ID.String()}},
+			}
+			if v.Severity != "" {
+				vuln.Ratings = []cdxRating{{cdxSeverity(v.Severity)}}
+			}
+			vuln.Source = vulnerabilitySource(v.ID)
+			doc.Vulnerabilities = append(doc.Vulnerabilities, vuln)
+		}
+	}
+
+	return doc
+}
+
+func vulnerabilitySource(id string) *cdxSource {
+	if strings.HasPrefix(id, "CVE-") {
+		return &cdxSource{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/" + id}
+	}
+	return nil
+}
+
+// cdxSeverity maps a platform severity to one of the severities defined by CycloneDX.
+func cdxSeverity(severity string) string {
+	switch severity {
+	case "critical", "high", "medium", "low", "info", "none":
+		return severity
+	case "moderate":
+		return "medium"
+	}
+	return "unknown"
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
@@ -0,0 +1,23 @@
+package sbom
+
+import (
+	"github.com/ActiveState/cli/pkg/spdx"
+)
+
+// licenseExpression combines the given licenses into a single SPDX license expression. Platform
+// licenses are free-form, so it returns false if any of them is not a valid SPDX expression, as
+// SBOM validators reject expressions with unknown licenses.
+func licenseExpression(licenses []string) (string, bool) {
+	parts := spdx.Unique(licenses)
+	if len(parts) == 0 {
+		return "", false
+	}
+	for i, l := range parts {
+		expr, ok := spdx.Normalize(l)
+		if !ok {
+			return "", false
+		}
+		parts[i] = expr
+	}
+	return spdx.Join(parts), true
+}
@@ -0,0 +1,57 @@
+package sbom
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// purlTypes maps the language of package namespaces (language/<language>) to their package URL type.
+var purlTypes = map[string]string{
+	"python":     "pypi",
+	"perl":       "cpan",
+	"ruby":       "gem",
+	"javascript": "npm",
+	"nodejs":     "npm",
+	"golang":     "golang",
+	"go":         "golang",
+}
+
+var pypiNameRe = regexp.MustCompile(`[-_.]+`)
+
+// purl returns the package URL (https://github.com/package-url/purl-spec) for an ingredient. Packages
+// of known languages get their ecosystem's type, everything else is described as a generic package
+// qualified by its platform namespace.
+func purl(namespace, name, version string) string {
+	purlType := "generic"
+	var segments []string
+	if lang, ok := strings.CutPrefix(namespace, "language/"); ok && purlTypes[lang] != "" {
+		purlType = purlTypes[lang]
+	} else if namespace != "" {
+		segments = strings.Split(namespace, "/")
+	}
+
+	switch purlType {
+	case "pypi":
+		name = strings.ToLower(pypiNameRe.ReplaceAllString(name, "-"))
+	case "cpan":
+		name = strings.ReplaceAll(name, "::", "-")
+	case "golang":
+		// Go module paths contain the namespace, eg. github.com/pkg/errors.
+		if i := strings.LastIndex(name, "/"); i != -1 {
+			segments = strings.Split(name[:i], "/")
+			name = name[i+1:]
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("pkg:" + purlType + "/")
+	for _, s := range segments {
+		b.WriteString(url.PathEscape(s) + "/")
+	}
+	b.WriteString(url.PathEscape(name))
+	if version != "" {
+		b.WriteString("@" + url.PathEscape(version))
+	}
+	return b.String()
+}
@@ -0,0 +1,204 @@
+// Package sbom generates software bills of materials from buildplans. The runtime closure of a
+// single platform is described in either the CycloneDX or the SPDX JSON format.
+package sbom
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/pkg/buildplan"
+	vulnModel "github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/model"
+	"github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/request"
+	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+type Format string
+
+const (
+	CycloneDXJSON Format = "cyclonedx-json"
+	SPDXJSON      Format = "spdx-json"
+)
+
+// Formats lists the supported SBOM formats.
+var Formats = []Format{CycloneDXJSON, SPDXJSON}
+
+// ErrUnsupportedFormat indicates an SBOM format that is not one of Formats.
+var ErrUnsupportedFormat = errs.New("unsupported SBOM format")
+
+// Component is a single ingredient that is part of the runtime.
+type Component struct {
+	ID          strfmt.UUID // the ingredient ID
+	Name        string
+	Namespace   string
+	Version     string
+	Licenses    []string
+	DownloadURL string
+
+	// Requested is true if the component is a requirement of the project, rather than a
+	// transitive dependency.
+	Requested bool
+
+	// Dependencies holds the IDs of the components this component depends on at runtime.
+	Dependencies []strfmt.UUID
+
+	Vulnerabilities []Vulnerability
+}
+
+// PURL returns the package URL of the component.
+func (c *Component) PURL() string {
+	return purl(c.Namespace, c.Name, c.Version)
+}
+
+type Vulnerability struct {
+	ID       string // eg. CVE-2023-1234
+	Severity string
+}
+
+// SBOM describes the runtime of a project commit for a single platform.
+type SBOM struct {
+	Project      string // eg. owner/project
+	CommitID     strfmt.UUID
+	PlatformID   strfmt.UUID
+	PlatformName string
+	Timestamp    time.Time
+	SerialNumber uuid.UUID
+	Components   []*Component
+}
+
+// New returns the SBOM of the runtime closure of the given buildplan for the given platform.
+func New(project string, commitID strfmt.UUID, platformID strfmt.UUID, platformName string, bp *buildplan.BuildPlan) *SBOM {
+	requested := map[strfmt.UUID]struct{}{}
+	for _, i := range bp.RequestedIngredients() {
+		requested[i.IngredientID] = struct{}{}
+	}
+
+	components := map[strfmt.UUID]*Component{}
+	dependencies := map[strfmt.UUID]map[strfmt.UUID]struct{}{}
+	artifacts := bp.Artifacts(buildplan.FilterPlatformArtifacts(platformID), buildplan.FilterRuntimeArtifacts())
+	for _, artifact := range artifacts {
+		for _, ingredient := range artifact.Ingredients {
+			if _, ok := components[ingredient.IngredientID]; !ok {
+				_, isRequested := requested[ingredient.IngredientID]
+				components[ingredient.IngredientID] = &Component{
+					ID:          ingredient.IngredientID,
+					Name:        ingredient.Name,
+					Namespace:   ingredient.Namespace,
+					Version:     ingredient.Version,
+					Licenses:    ingredient.Licenses,
+					DownloadURL: ingredient.Url.String(),
+					Requested:   isRequested,
+				}
+				dependencies[ingredient.IngredientID] = map[strfmt.UUID]struct{}{}
+			}
+			for _, dep := range artifact.RuntimeDependencies(false, nil) {
+				for _, depIngredient := range dep.Ingredients {
+					if depIngredient.IngredientID != ingredient.IngredientID {
+						dependencies[ingredient.IngredientID][depIngredient.IngredientID] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	s := &SBOM{
+		Project:      project,
+		CommitID:     commitID,
+		PlatformID:   platformID,
+		PlatformName: platformName,
+		Timestamp:    time.Now().UTC(),
+		SerialNumber: uuid.New(),
+	}
+	for id, c := range components {
+		for depID := range dependencies[id] {
+			if _, ok := components[depID]; ok {
+				c.Dependencies = append(c.Dependencies, depID)
+			}
+		}
+		sort.Slice(c.Dependencies, func(i, j int) bool { return c.Dependencies[i] < c.Dependencies[j] })
+		s.Components = append(s.Components, c)
+	}
+	sort.Slice(s.Components, func(i, j int) bool {
+		a, b := s.Components[i], s.Components[j]
+		if a.Name != b.Name {
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		}
+		return a.ID < b.ID
+	})
+	return s
+}
+
+// Ingredients returns the ingredients of the components, to look up their vulnerabilities with.
+func (s *SBOM) Ingredients() []*request.Ingredient {
+	ingredients := make([]*request.Ingredient, 0, len(s.Components))
+	for _, c := range s.Components {
+		ingredients = append(ingredients, &request.Ingredient{Namespace: c.Namespace, Name: c.Name, Version: c.Version})
+	}
+	return ingredients
+}
+
+// AddVulnerabilities attaches the given vulnerability data to the components of the same
+// namespace, name and version.
+func (s *SBOM) AddVulnerabilities(vulns []*model.VulnerabilityIngredient) {
+	for _, v := range vulns {
+		if v.Vulnerabilities == nil {
+			continue
+		}
+		for _, c := range s.Components {
+			if c.Namespace != v.PrimaryNamespace || !strings.EqualFold(c.Name, v.Name) || c.Version != v.Version {
+				continue
+			}
+			for _, severity := range []struct {
+				name string
+				ids  []string
+			}{
+				{vulnModel.SeverityCritical, v.Vulnerabilities.Critical},
+				{vulnModel.SeverityHigh, v.Vulnerabilities.High},
+				{vulnModel.SeverityMedium, v.Vulnerabilities.Medium},
+				{vulnModel.SeverityLow, v.Vulnerabilities.Low},
+			} {
+				for _, id := range severity.ids {
+					c.Vulnerabilities = append(c.Vulnerabilities, Vulnerability{ID: id, Severity: severity.name})
+				}
+			}
+		}
+	}
+}
+
+// Document returns the SBOM in the given format, ready to be marshalled to JSON.
+func (s *SBOM) Document(format Format) (interface{}, error) {
+	switch format {
+	case CycloneDXJSON:
+		return s.cycloneDX(), nil
+	case SPDXJSON:
+		return s.spdx(), nil
+	}
+	return nil, errs.Wrap(ErrUnsupportedFormat, "format: %s", format)
+}
+
+// Marshal returns the SBOM in the given format.
+func (s *SBOM) Marshal(format Format) ([]byte, error) {
+	doc, err := s.Document(format)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not marshal SBOM")
+	}
+	return b, nil
+}
+
+func (s *SBOM) requested() []*Component {
+	var result []*Component
+	for _, c := range s.Components {
+		if c.Requested {
+			result = append(result, c)
+		}
+	}
+	return result
+}
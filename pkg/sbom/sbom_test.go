@@ -0,0 +1,167 @@
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ActiveState/cli/pkg/platform/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSBOM() *SBOM {
+	return &SBOM{
+		Project:      "ActiveState/test",
+		CommitID:     "00000000-0000-0000-0000-0000000000c1",
+		PlatformID:   "00000000-0000-0000-0000-0000000000f1",
+		PlatformName: "Linux",
+		Timestamp:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		SerialNumber: uuid.MustParse("11111111-2222-3333-4444-555555555555"),
+		Components: []*Component{
+			{
+				ID:        "00000000-0000-0000-0000-000000000001",
+				Name:      "python",
+				Namespace: "language",
+				Version:   "3.10.13",
+				Licenses:  []string{"Python Software Foundation License"},
+			},
+			{
+				ID:           "00000000-0000-0000-0000-000000000002",
+				Name:         "requests",
+				Namespace:    "language/python",
+				Version:      "2.31.0",
+				Licenses:     []string{"Apache-2.0"},
+				Requested:    true,
+				Dependencies: []strfmt.UUID{"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000003"},
+			},
+			{
+				ID:           "00000000-0000-0000-0000-000000000003",
+				Name:         "urllib3",
+				Namespace:    "language/python",
+				Version:      "1.26.0",
+				Licenses:     []string{"MIT", "MIT OR Apache-2.0"},
+				Dependencies: []strfmt.UUID{"00000000-0000-0000-0000-000000000001"},
+			},
+		},
+	}
+}
+
+func TestPURL(t *testing.T) {
+	tests := []struct {
+		namespace, name, version string
+		want                     string
+	}{
+		{"language/python", "Typing_Extensions", "4.0.0", "pkg:pypi/typing-extensions@4.0.0"},
+		{"language/perl", "JSON::PP", "4.16", "pkg:cpan/JSON-PP@4.16"},
+		{"language/ruby", "rake", "13.0.6", "pkg:gem/rake@13.0.6"},
+		{"language/golang", "github.com/pkg/errors", "v0.9.1", "pkg:golang/github.com/pkg/errors@v0.9.1"},
+		{"language", "python", "3.10.13", "pkg:generic/language/python@3.10.13"},
+		{"shared", "zlib", "1.3", "pkg:generic/shared/zlib@1.3"},
+		{"private/ActiveState", "my pkg", "", "pkg:generic/private/ActiveState/my%20pkg"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, purl(tt.namespace, tt.name, tt.version))
+	}
+}
+
+func TestLicenseExpression(t *testing.T) {
+	tests := []struct {
+		licenses []string
+		want     string
+		valid    bool
+	}{
+		{nil, "", false},
+		{[]string{"MIT", "MIT"}, "MIT", true},
+		{[]string{"MIT", "MIT OR Apache-2.0"}, "MIT AND (MIT OR Apache-2.0)", true},
+		{[]string{"mit or apache-2.0"}, "MIT OR Apache-2.0", true},
+		{[]string{"(GPL-2.0-or-later WITH Classpath-exception-2.0) AND LicenseRef-Custom"}, "(GPL-2.0-or-later WITH Classpath-exception-2.0) AND LicenseRef-Custom", true},
+		{[]string{"GPL-2.0+"}, "GPL-2.0+", true},
+		{[]string{"MIT", "Python Software Foundation License"}, "", false},
+		{[]string{"BSD"}, "", false},
+		{[]string{"MIT AND"}, "", false},
+		{[]string{"(MIT"}, "", false},
+		{[]string{"GPL-2.0-only WITH Unknown-exception"}, "", false},
+	}
+	for _, tt := range tests {
+		got, valid := licenseExpression(tt.licenses)
+		assert.Equal(t, tt.valid, valid, "%v", tt.licenses)
+		assert.Equal(t, tt.want, got, "%v", tt.licenses)
+	}
+}
+
+func TestAddVulnerabilities(t *testing.T) {
+	s := testSBOM()
+	s.AddVulnerabilities([]*model.VulnerabilityIngredient{
+		{Name: "urllib3", PrimaryNamespace: "language/python", Version: "1.26.0", Vulnerabilities: &model.Vulnerabilities{High: []string{"CVE-2023-43804"}}},
+		{Name: "urllib3", PrimaryNamespace: "language/python", Version: "2.0.0", Vulnerabilities: &model.Vulnerabilities{Low: []string{"CVE-2000-0001"}}},
+		{Name: "urllib3", PrimaryNamespace: "language/perl", Version: "1.26.0", Vulnerabilities: &model.Vulnerabilities{Low: []string{"CVE-2000-0002"}}},
+	})
+	assert.Empty(t, s.Components[1].Vulnerabilities)
+	require.Len(t, s.Components[2].Vulnerabilities, 1)
+	assert.Equal(t, Vulnerability{ID: "CVE-2023-43804", Severity: "high"}, s.Components[2].Vulnerabilities[0])
+}
+
+func TestCycloneDX(t *testing.T) {
+	s := testSBOM()
+	s.Components[2].Vulnerabilities = []Vulnerability{{ID: "CVE-2023-43804", Severity: "moderate"}}
+
+	b, err := s.Marshal(CycloneDXJSON)
+	require.NoError(t, err)
+	doc := &cdxDocument{}
+	require.NoError(t, json.Unmarshal(b, doc))
+
+	assert.Equal(t, "CycloneDX", doc.BOMFormat)
+	assert.Equal(t, "urn:uuid:11111111-2222-3333-4444-555555555555", doc.SerialNumber)
+	assert.Equal(t, "2024-01-02T03:04:05Z", doc.Metadata.Timestamp)
+	require.Len(t, doc.Components, 3)
+	assert.Equal(t, "pkg:pypi/requests@2.31.0", doc.Components[1].PURL)
+	assert.Contains(t, doc.Components[1].Properties, cdxProperty{"activestate:requested", "true"})
+	assert.Contains(t, doc.Components[2].Properties, cdxProperty{"activestate:requested", "false"})
+	assert.Equal(t, []cdxLicense{{Expression: "MIT AND (MIT OR Apache-2.0)"}}, doc.Components[2].Licenses)
+	assert.Equal(t, []cdxLicense{{License: &cdxLicenseName{"Python Software Foundation License"}}}, doc.Components[0].Licenses,
+		"licenses that are not SPDX expressions are named instead")
+
+	require.Len(t, doc.Dependencies, 4)
+	assert.Equal(t, cdxDependency{cdxRootRef, []string{"00000000-0000-0000-0000-000000000002"}}, doc.Dependencies[0], "root depends on requested components only")
+	assert.Equal(t, []string{"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000003"}, doc.Dependencies[2].DependsOn)
+
+	require.Len(t, doc.Vulnerabilities, 1)
+	assert.Equal(t, []cdxRating{{"medium"}}, doc.Vulnerabilities[0].Ratings)
+	assert.Equal(t, []cdxAffect{{"00000000-0000-0000-0000-000000000003"}}, doc.Vulnerabilities[0].Affects)
+}
+
+func TestSPDX(t *testing.T) {
+	s := testSBOM()
+
+	b, err := s.Marshal(SPDXJSON)
+	require.NoError(t, err)
+	doc := &spdxDocument{}
+	require.NoError(t, json.Unmarshal(b, doc))
+
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	require.Len(t, doc.Packages, 4, "the project and its components")
+	assert.Equal(t, spdxRootID, doc.Packages[0].SPDXID)
+	assert.Equal(t, "SPDXRef-Package-00000000-0000-0000-0000-000000000002", doc.Packages[2].SPDXID)
+	assert.Equal(t, "Apache-2.0", doc.Packages[2].LicenseDeclared)
+	assert.Equal(t, spdxNoAssert, doc.Packages[1].LicenseDeclared, "licenses that are not SPDX expressions are not declared")
+	assert.Contains(t, doc.Packages[1].LicenseComments, "Python Software Foundation License")
+	assert.Equal(t, spdxNoAssert, doc.Packages[2].DownloadLocation)
+	assert.Equal(t, "pkg:pypi/requests@2.31.0", doc.Packages[2].ExternalRefs[0].Locator)
+
+	assert.Equal(t, []spdxRelationship{
+		{spdxDocumentID, "DESCRIBES", spdxRootID},
+		{spdxRootID, "DEPENDS_ON", "SPDXRef-Package-00000000-0000-0000-0000-000000000002"},
+		{"SPDXRef-Package-00000000-0000-0000-0000-000000000002", "DEPENDS_ON", "SPDXRef-Package-00000000-0000-0000-0000-000000000001"},
+		{"SPDXRef-Package-00000000-0000-0000-0000-000000000002", "DEPENDS_ON", "SPDXRef-Package-00000000-0000-0000-0000-000000000003"},
+		{"SPDXRef-Package-00000000-0000-0000-0000-000000000003", "DEPENDS_ON", "SPDXRef-Package-00000000-0000-0000-0000-000000000001"},
+	}, doc.Relationships)
+}
+
+func TestMarshal_UnsupportedFormat(t *testing.T) {
+	_, err := testSBOM().Marshal("xml")
+	assert.True(t, errors.Is(err, ErrUnsupportedFormat))
+}
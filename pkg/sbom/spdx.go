@@ -0,0 +1,155 @@
+package sbom
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/pkg/spdx"
+)
+
+// The types below describe the subset of the SPDX 2.3 JSON format (https://spdx.github.io/spdx-spec/v2.3/)
+// that we populate.
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+	Comment  string   `json:"comment,omitempty"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	LicenseComments  string            `json:"licenseComments,omitempty"`
+	CopyrightText    string            `json:"copyrightText"`
+	PrimaryPurpose   string            `json:"primaryPackagePurpose,omitempty"`
+	Comment          string            `json:"comment,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	Category string `json:"referenceCategory"`
+	Type     string `json:"referenceType"`
+	Locator  string `json:"referenceLocator"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+type spdxRelationship struct {
+	Element string `json:"spdxElementId"`
+	Type    string `json:"relationshipType"`
+	Related string `json:"relatedSpdxElement"`
+}
+
+const (
+	spdxDocumentID = "SPDXRef-DOCUMENT"
+	spdxRootID     = "SPDXRef-Project"
+	spdxNoAssert   = "NOASSERTION"
+)
+
+func (s *SBOM) spdx() *spdxDocument {
+	doc := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              fmt.Sprintf("%s@%s", s.Project, s.CommitID),
+		DocumentNamespace: fmt.Sprintf("https://%s/spdx/%s/%s-%s", constants.PlatformURL, s.Project, s.CommitID, s.SerialNumber),
+		CreationInfo: spdxCreationInfo{
+			Created:  s.Timestamp.Format(time.RFC3339),
+			Creators: []string{"Organization: ActiveState", fmt.Sprintf("Tool: %s-%s", constants.CommandName, constants.VersionNumber)},
+			Comment:  fmt.Sprintf("Runtime of commit %s for platform %s", s.CommitID, s.platformDescription()),
+		},
+		Packages: []spdxPackage{{
+			SPDXID:           spdxRootID,
+			Name:             s.Project,
+			VersionInfo:      s.CommitID.String(),
+			DownloadLocation: spdxNoAssert,
+			LicenseConcluded: spdxNoAssert,
+			LicenseDeclared:  spdxNoAssert,
+			CopyrightText:    spdxNoAssert,
+			PrimaryPurpose:   "APPLICATION",
+		}},
+		Relationships: []spdxRelationship{{spdxDocumentID, "DESCRIBES", spdxRootID}},
+	}
+
+	for _, c := range s.requested() {
+		doc.Relationships = append(doc.Relationships, spdxRelationship{spdxRootID, "DEPENDS_ON", spdxPackageID(c)})
+	}
+
+	for _, c := range s.Components {
+		pkg := spdxPackage{
+			SPDXID:           spdxPackageID(c),
+			Name:             c.Name,
+			VersionInfo:      c.Version,
+			DownloadLocation: spdxNoAssert,
+			LicenseConcluded: spdxNoAssert,
+			LicenseDeclared:  spdxNoAssert,
+			CopyrightText:    spdxNoAssert,
+			PrimaryPurpose:   "LIBRARY",
+			Comment:          "Transitive dependency",
+			ExternalRefs: []spdxExternalRef{{
+				Category: "PACKAGE-MANAGER",
+				Type:     "purl",
+				Locator:  c.PURL(),
+			}},
+		}
+		if c.Requested {
+			pkg.Comment = "Requested by the project"
+		}
+		if c.DownloadURL != "" {
+			pkg.DownloadLocation = c.DownloadURL
+		}
+		if expr, ok := licenseExpression(c.Licenses); ok {
+			pkg.LicenseDeclared = expr
+		} else if names := spdx.Unique(c.Licenses); len(names) > 0 {
+			// Licenses that are not valid SPDX expressions cannot be declared, so are only mentioned.
+			pkg.LicenseComments = "Declared licenses: " + strings.Join(names, "; ")
+		}
+		for _, v := range c.Vulnerabilities {
+			ref := spdxExternalRef{
+				Category: "SECURITY",
+				Type:     "advisory",
+				Locator:  v.ID,
+				Comment:  v.Severity,
+			}
+			if source := vulnerabilitySource(v.ID); source != nil {
+				ref.Locator = source.URL
+			}
+			pkg.ExternalRefs = append(pkg.ExternalRefs, ref)
+		}
+		doc.Packages = append(doc.Packages, pkg)
+
+		for _, id := range c.Dependencies {
+			doc.Relationships = append(doc.Relationships, spdxRelationship{spdxPackageID(c), "DEPENDS_ON", "SPDXRef-Package-" + id.String()})
+		}
+	}
+
+	return doc
+}
+
+func (s *SBOM) platformDescription() string {
+	if s.PlatformName == "" {
+		return s.PlatformID.String()
+	}
+	return fmt.Sprintf("%s (%s)", s.PlatformName, s.PlatformID)
+}
+
+func spdxPackageID(c *Component) string {
+	return "SPDXRef-Package-" + c.ID.String()
+}
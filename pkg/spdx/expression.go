@@ -0,0 +1,198 @@
+// Package spdx parses and normalizes SPDX license expressions
+// (https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/).
+package spdx
+
+import (
+	"strings"
+
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+// ErrInvalidExpression indicates a license string that is not a valid SPDX expression.
+var ErrInvalidExpression = errs.New("invalid license expression")
+
+// Expression is a parsed SPDX license expression. It is either a single license, optionally with an
+// exception (eg. "GPL-2.0-only WITH Classpath-exception-2.0"), or an AND/OR of its operands.
+type Expression struct {
+	License   string
+	Exception string
+	Operator  string // "AND" or "OR", empty for a single license
+	Operands  []*Expression
+}
+
+// Parse parses the given SPDX license expression, in which AND binds tighter than OR. Identifiers are
+// not validated, as license information is often free-form; use Normalize for that.
+func Parse(expr string) (*Expression, error) {
+	p := &parser{tokens: strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expr))}
+	e, err := p.or()
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not parse %q", expr)
+	}
+	if p.pos != len(p.tokens) {
+		return nil, errs.Wrap(ErrInvalidExpression, "unexpected %q in %q", p.tokens[p.pos], expr)
+	}
+	return e, nil
+}
+
+// Normalize validates the given SPDX license expression, and returns it with identifiers and
+// operators in their canonical case. It returns false if the expression is invalid or uses
+// identifiers we do not recognise, as SBOM validators reject those.
+func Normalize(expr string) (string, bool) {
+	e, err := Parse(expr)
+	if err != nil {
+		return "", false
+	}
+	if !e.normalize() {
+		return "", false
+	}
+	return e.String(), true
+}
+
+func (e *Expression) normalize() bool {
+	if e.Operator != "" {
+		for _, op := range e.Operands {
+			if !op.normalize() {
+				return false
+			}
+		}
+		return true
+	}
+
+	var ok bool
+	if e.License, ok = LicenseID(e.License); !ok {
+		return false
+	}
+	if e.Exception != "" {
+		if e.Exception, ok = ExceptionID(e.Exception); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the expression, with parentheses around operands that are not a single license.
+func (e *Expression) String() string {
+	if e.Operator == "" {
+		if e.Exception != "" {
+			return e.License + " WITH " + e.Exception
+		}
+		return e.License
+	}
+	parts := make([]string, len(e.Operands))
+	for i, op := range e.Operands {
+		parts[i] = op.String()
+		if op.Operator != "" || op.Exception != "" {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " "+e.Operator+" ")
+}
+
+// Join combines the given license expressions into one that requires all of them.
+func Join(licenses []string) string {
+	if len(licenses) == 1 {
+		return licenses[0]
+	}
+	parts := make([]string, len(licenses))
+	for i, l := range licenses {
+		parts[i] = l
+		if strings.Contains(l, " ") {
+			parts[i] = "(" + l + ")"
+		}
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// Unique returns the given free-form licenses without duplicates or blanks.
+func Unique(licenses []string) []string {
+	var result []string
+	seen := map[string]struct{}{}
+	for _, l := range licenses {
+		l = strings.TrimSpace(l)
+		if _, ok := seen[l]; ok || l == "" {
+			continue
+		}
+		seen[l] = struct{}{}
+		result = append(result, l)
+	}
+	return result
+}
+
+// parser is a recursive descent parser of SPDX license expressions.
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) or() (*Expression, error) {
+	return p.binary("OR", p.and)
+}
+
+func (p *parser) and() (*Expression, error) {
+	return p.binary("AND", p.primary)
+}
+
+func (p *parser) binary(operator string, operand func() (*Expression, error)) (*Expression, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	e := &Expression{Operator: operator, Operands: []*Expression{first}}
+	for strings.EqualFold(p.peek(), operator) {
+		p.pos++
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		e.Operands = append(e.Operands, next)
+	}
+	if len(e.Operands) == 1 {
+		return first, nil
+	}
+	return e, nil
+}
+
+func (p *parser) primary() (*Expression, error) {
+	token := p.peek()
+	switch {
+	case token == "":
+		return nil, errs.Wrap(ErrInvalidExpression, "unexpected end of expression")
+	case token == "(":
+		p.pos++
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, errs.Wrap(ErrInvalidExpression, "missing closing parenthesis")
+		}
+		p.pos++
+		return e, nil
+	case token == ")" || isOperator(token):
+		return nil, errs.Wrap(ErrInvalidExpression, "unexpected %q", token)
+	}
+
+	p.pos++
+	e := &Expression{License: token}
+	if strings.EqualFold(p.peek(), "WITH") {
+		p.pos++
+		exception := p.peek()
+		if exception == "" || exception == "(" || exception == ")" || isOperator(exception) {
+			return nil, errs.Wrap(ErrInvalidExpression, "missing exception after WITH")
+		}
+		p.pos++
+		e.Exception = exception
+	}
+	return e, nil
+}
+
+func isOperator(token string) bool {
+	return strings.EqualFold(token, "AND") || strings.EqualFold(token, "OR") || strings.EqualFold(token, "WITH")
+}
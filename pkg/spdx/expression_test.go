@@ -0,0 +1,65 @@
+package spdx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    string
+		wantErr bool
+	}{
+		{"MIT", "MIT", false},
+		{"MIT OR Apache-2.0", "MIT OR Apache-2.0", false},
+		{"(MIT OR Apache-2.0) AND BSD-3-Clause", "(MIT OR Apache-2.0) AND BSD-3-Clause", false},
+		{"MIT OR Apache-2.0 AND BSD-3-Clause", "MIT OR (Apache-2.0 AND BSD-3-Clause)", false},
+		{"GPL-2.0-only with Classpath-exception-2.0", "GPL-2.0-only WITH Classpath-exception-2.0", false},
+		{"Python Software Foundation License", "", true},
+		{"MIT AND", "", true},
+		{"(MIT OR Apache-2.0", "", true},
+		{"MIT )", "", true},
+		{"GPL-2.0-only WITH", "", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			e, err := Parse(tt.expr)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidExpression)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, e.String())
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		expr  string
+		want  string
+		valid bool
+	}{
+		{"mit or apache-2.0", "MIT OR Apache-2.0", true},
+		{"(GPL-2.0-or-later WITH Classpath-exception-2.0) AND LicenseRef-Custom", "(GPL-2.0-or-later WITH Classpath-exception-2.0) AND LicenseRef-Custom", true},
+		{"gpl-2.0+", "GPL-2.0+", true},
+		{"BSD", "", false},
+		{"GPL-2.0-only WITH Unknown-exception", "", false},
+		{"(MIT", "", false},
+	}
+	for _, tt := range tests {
+		got, valid := Normalize(tt.expr)
+		assert.Equal(t, tt.valid, valid, tt.expr)
+		assert.Equal(t, tt.want, got, tt.expr)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	assert.Equal(t, "MIT", Join([]string{"MIT"}))
+	assert.Equal(t, "MIT AND (MIT OR Apache-2.0)", Join([]string{"MIT", "MIT OR Apache-2.0"}))
+	assert.Equal(t, []string{"MIT", "BSD"}, Unique([]string{" MIT", "MIT", "", "BSD"}))
+}
@@ -0,0 +1,72 @@
+package spdx
+
+import (
+	"regexp"
+	"strings"
+)
+
+// licenseIDs holds the SPDX license list identifiers (https://spdx.org/licenses/) we recognise,
+// keyed by their lower case form as identifiers are matched case insensitively.
+var licenseIDs = map[string]string{}
+
+// exceptionIDs holds the SPDX license exception identifiers we recognise, keyed like licenseIDs.
+var exceptionIDs = map[string]string{}
+
+// licenseRefRe matches user defined license references, which are valid in any expression.
+var licenseRefRe = regexp.MustCompile(`^(DocumentRef-[A-Za-z0-9.\-]+:)?LicenseRef-[A-Za-z0-9.\-]+$`)
+
+func init() {
+	for _, id := range []string{
+		"0BSD", "AFL-2.1", "AFL-3.0", "AGPL-1.0-only", "AGPL-1.0-or-later", "AGPL-3.0-only", "AGPL-3.0-or-later",
+		"Apache-1.0", "Apache-1.1", "Apache-2.0", "APSL-2.0", "Artistic-1.0", "Artistic-1.0-Perl", "Artistic-2.0",
+		"BlueOak-1.0.0", "BSD-1-Clause", "BSD-2-Clause", "BSD-2-Clause-Patent", "BSD-3-Clause", "BSD-3-Clause-Clear",
+		"BSD-3-Clause-LBNL", "BSD-4-Clause", "BSL-1.0", "bzip2-1.0.6", "CC-BY-3.0", "CC-BY-4.0", "CC-BY-SA-3.0",
+		"CC-BY-SA-4.0", "CC0-1.0", "CDDL-1.0", "CDDL-1.1", "CECILL-2.1", "CNRI-Python", "CPL-1.0", "curl",
+		"ECL-2.0", "EFL-2.0", "EPL-1.0", "EPL-2.0", "EUPL-1.1", "EUPL-1.2", "FSFAP", "FSFUL", "FSFULLR", "FTL",
+		"GFDL-1.3-only", "GFDL-1.3-or-later", "GPL-1.0-only", "GPL-1.0-or-later", "GPL-2.0-only", "GPL-2.0-or-later",
+		"GPL-3.0-only", "GPL-3.0-or-later", "HPND", "ICU", "IJG", "ImageMagick", "Info-ZIP", "IPA", "ISC",
+		"LGPL-2.0-only", "LGPL-2.0-or-later", "LGPL-2.1-only", "LGPL-2.1-or-later", "LGPL-3.0-only",
+		"LGPL-3.0-or-later", "Libpng", "libpng-2.0", "libtiff", "LPL-1.02", "LPPL-1.3c", "MirOS", "MIT", "MIT-0",
+		"MIT-CMU", "MIT-Modern-Variant", "MPL-1.0", "MPL-1.1", "MPL-2.0", "MPL-2.0-no-copyleft-exception", "MS-PL",
+		"MS-RL", "NCSA", "Net-SNMP", "NTP", "OFL-1.1", "OLDAP-2.8", "OpenSSL", "OSL-3.0", "PHP-3.0", "PHP-3.01",
+		"PostgreSQL", "PSF-2.0", "Python-2.0", "Python-2.0.1", "Qhull", "Ruby", "SGI-B-2.0", "SMLNJ", "Sleepycat",
+		"SSPL-1.0", "TCL", "Unicode-3.0", "Unicode-DFS-2015", "Unicode-DFS-2016", "Unlicense", "UPL-1.0", "Vim",
+		"W3C", "WTFPL", "X11", "XFree86-1.1", "Zlib", "zlib-acknowledgement", "ZPL-2.0", "ZPL-2.1",
+		// Deprecated, but still valid, identifiers that are common in package metadata.
+		"AGPL-3.0", "GPL-2.0", "GPL-2.0+", "GPL-3.0", "GPL-3.0+", "LGPL-2.0", "LGPL-2.1", "LGPL-2.1+", "LGPL-3.0",
+		"LGPL-3.0+",
+	} {
+		licenseIDs[strings.ToLower(id)] = id
+	}
+	for _, id := range []string{
+		"Autoconf-exception-3.0", "Bison-exception-2.2", "Classpath-exception-2.0", "GCC-exception-2.0",
+		"GCC-exception-3.1", "LLVM-exception", "OpenJDK-assembly-exception-1.0", "openvpn-openssl-exception",
+		"Qt-LGPL-exception-1.1", "Universal-FOSS-exception-1.0",
+	} {
+		exceptionIDs[strings.ToLower(id)] = id
+	}
+}
+
+// LicenseID returns the canonical form of the given license identifier, which may have a trailing
+// "+" to mean "or any later version". It returns false if the identifier is not one we recognise.
+func LicenseID(token string) (string, bool) {
+	if licenseRefRe.MatchString(token) {
+		return token, true
+	}
+	if id, ok := licenseIDs[strings.ToLower(token)]; ok {
+		return id, true
+	}
+	if base, ok := strings.CutSuffix(token, "+"); ok {
+		if id, ok := licenseIDs[strings.ToLower(base)]; ok {
+			return id + "+", true
+		}
+	}
+	return "", false
+}
+
+// ExceptionID returns the canonical form of the given license exception identifier. It returns
+// false if the identifier is not one we recognise.
+func ExceptionID(token string) (string, bool) {
+	id, ok := exceptionIDs[strings.ToLower(token)]
+	return id, ok
+}
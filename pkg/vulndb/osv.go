@@ -0,0 +1,134 @@
+package vulndb
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/ActiveState/cli/internal/errs"
+)
+
+// Record is a vulnerability in the OSV format (https://ossf.github.io/osv-schema/). Only the fields
+// needed for matching and reporting are retained.
+type Record struct {
+	ID               string            `json:"id"`
+	Aliases          []string          `json:"aliases,omitempty"`
+	Summary          string            `json:"summary,omitempty"`
+	Withdrawn        string            `json:"withdrawn,omitempty"`
+	Severity         []Severity        `json:"severity,omitempty"`
+	Affected         []Affected        `json:"affected"`
+	DatabaseSpecific *DatabaseSpecific `json:"database_specific,omitempty"`
+}
+
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type DatabaseSpecific struct {
+	Severity string `json:"severity,omitempty"`
+}
+
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+type Range struct {
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// CveID returns the CVE identifier of the vulnerability, falling back to its OSV identifier if it has
+// no CVE alias.
+func (r *Record) CveID() string {
+	if strings.HasPrefix(r.ID, "CVE-") {
+		return r.ID
+	}
+	for _, alias := range r.Aliases {
+		if strings.HasPrefix(alias, "CVE-") {
+			return alias
+		}
+	}
+	return r.ID
+}
+
+// parseRecords parses a vulnerability snapshot. Supported are a single OSV record, a JSON array of
+// records, an OSV API response ({"vulns": [...]}) and a zip archive of OSV records, which is the
+// format of the OSV ecosystem exports.
+func parseRecords(data []byte) ([]*Record, error) {
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return parseZip(data)
+	}
+
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		var records []*Record
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, errs.Wrap(err, "Could not unmarshal OSV records")
+		}
+		return records, nil
+	case bytes.HasPrefix(data, []byte("{")):
+		var response struct {
+			Vulns []*Record `json:"vulns"`
+		}
+		if err := json.Unmarshal(data, &response); err == nil && response.Vulns != nil {
+			return response.Vulns, nil
+		}
+		record := &Record{}
+		if err := json.Unmarshal(data, record); err != nil {
+			return nil, errs.Wrap(err, "Could not unmarshal OSV record")
+		}
+		if record.ID == "" {
+			return nil, errs.New("Not an OSV record")
+		}
+		return []*Record{record}, nil
+	}
+
+	return nil, errs.New("Unrecognized vulnerability snapshot format")
+}
+
+func parseZip(data []byte) ([]*Record, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read zip archive")
+	}
+
+	var records []*Record
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !strings.HasSuffix(f.Name, ".json") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not open %s", f.Name)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not read %s", f.Name)
+		}
+		parsed, err := parseRecords(b)
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not parse %s", f.Name)
+		}
+		records = append(records, parsed...)
+	}
+	return records, nil
+}
@@ -0,0 +1,128 @@
+package vulndb
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	vulnModel "github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/model"
+)
+
+// severity returns the severity level of a record (one of the vulnerability model's severities). It
+// prefers the severity assigned by the database (eg. GitHub advisories), and otherwise derives it from
+// the CVSS score. Vulnerabilities of unknown severity are reported as medium, so that they are
+// neither dropped nor overstated.
+func (r *Record) severity() string {
+	if r.DatabaseSpecific != nil {
+		switch strings.ToUpper(r.DatabaseSpecific.Severity) {
+		case "CRITICAL":
+			return vulnModel.SeverityCritical
+		case "HIGH":
+			return vulnModel.SeverityHigh
+		case "MODERATE", "MEDIUM":
+			return vulnModel.SeverityMedium
+		case "LOW":
+			return vulnModel.SeverityLow
+		}
+	}
+
+	for _, s := range r.Severity {
+		score, err := strconv.ParseFloat(s.Score, 64)
+		if err != nil && s.Type == "CVSS_V3" {
+			score, err = cvss3BaseScore(s.Score)
+		}
+		if err != nil {
+			continue
+		}
+		switch {
+		case score >= 9.0:
+			return vulnModel.SeverityCritical
+		case score >= 7.0:
+			return vulnModel.SeverityHigh
+		case score >= 4.0:
+			return vulnModel.SeverityMedium
+		default:
+			return vulnModel.SeverityLow
+		}
+	}
+
+	return vulnModel.SeverityMedium
+}
+
+var cvss3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+// cvss3BaseScore calculates the base score of a CVSS v3 vector, eg.
+// CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H (https://www.first.org/cvss/v3.1/specification-document).
+func cvss3BaseScore(vector string) (float64, error) {
+	metrics := map[string]string{}
+	for _, part := range strings.Split(vector, "/") {
+		if k, v, ok := strings.Cut(part, ":"); ok {
+			metrics[k] = v
+		}
+	}
+	if !strings.HasPrefix(metrics["CVSS"], "3") {
+		return 0, errInvalidVector
+	}
+
+	values := map[string]float64{}
+	for metric, weights := range cvss3Weights {
+		w, ok := weights[metrics[metric]]
+		if !ok {
+			return 0, errInvalidVector
+		}
+		values[metric] = w
+	}
+
+	changed := metrics["S"] == "C"
+	if !changed && metrics["S"] != "U" {
+		return 0, errInvalidVector
+	}
+	var pr float64
+	switch metrics["PR"] {
+	case "N":
+		pr = 0.85
+	case "L":
+		pr = 0.62
+		if changed {
+			pr = 0.68
+		}
+	case "H":
+		pr = 0.27
+		if changed {
+			pr = 0.5
+		}
+	default:
+		return 0, errInvalidVector
+	}
+
+	iss := 1 - (1-values["C"])*(1-values["I"])*(1-values["A"])
+	impact := 6.42 * iss
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0, nil
+	}
+	exploitability := 8.22 * values["AV"] * values["AC"] * pr * values["UI"]
+	if changed {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+	}
+	return roundUp(math.Min(impact+exploitability, 10)), nil
+}
+
+// roundUp returns the smallest number with one decimal that is equal to or higher than its input, as
+// specified by CVSS v3.1.
+func roundUp(f float64) float64 {
+	i := int(math.Round(f * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+	return (math.Floor(float64(i)/10000) + 1) / 10
+}
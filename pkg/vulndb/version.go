@@ -0,0 +1,171 @@
+package vulndb
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// affects returns whether the given version is affected according to the version list and ranges of
+// an OSV affected entry. GIT ranges cannot be evaluated without the source repository and are ignored.
+func affects(a Affected, version string) bool {
+	for _, v := range a.Versions {
+		if CompareVersions(v, version) == 0 {
+			return true
+		}
+	}
+
+	for _, r := range a.Ranges {
+		if r.Type != "ECOSYSTEM" && r.Type != "SEMVER" {
+			continue
+		}
+		if inRange(r.Events, version) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inRange implements the OSV range evaluation: a version is affected if the closest preceding event
+// (in version order) introduced the vulnerability, rather than fixed it.
+func inRange(events []Event, version string) bool {
+	affected := false
+	var latest string // the version of the event currently determining the outcome
+	for _, e := range events {
+		switch {
+		case e.Introduced != "":
+			if (e.Introduced == "0" || CompareVersions(version, e.Introduced) >= 0) && precedes(latest, e.Introduced) {
+				affected, latest = true, e.Introduced
+			}
+		case e.Fixed != "":
+			if CompareVersions(version, e.Fixed) >= 0 && precedes(latest, e.Fixed) {
+				affected, latest = false, e.Fixed
+			}
+		case e.LastAffected != "":
+			if CompareVersions(version, e.LastAffected) > 0 && precedes(latest, e.LastAffected) {
+				affected, latest = false, e.LastAffected
+			}
+		case e.Limit != "":
+			if CompareVersions(version, e.Limit) >= 0 {
+				return false
+			}
+		}
+	}
+	return affected
+}
+
+func precedes(a, b string) bool {
+	return a == "" || a == "0" || CompareVersions(a, b) <= 0
+}
+
+// CompareVersions compares two version strings, returning -1, 0 or 1. It understands the common
+// conventions of semantic versions and PEP 440: numeric segments are compared numerically, and
+// pre-release tags (dev, alpha, beta, rc) sort before the release they precede, while post-releases
+// sort after it.
+func CompareVersions(a, b string) int {
+	ta, tb := tokenizeVersion(a), tokenizeVersion(b)
+	for i := 0; i < len(ta) || i < len(tb); i++ {
+		var x, y string
+		if i < len(ta) {
+			x = ta[i]
+		}
+		if i < len(tb) {
+			y = tb[i]
+		}
+		if c := compareTokens(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func tokenizeVersion(v string) []string {
+	v = strings.ToLower(strings.TrimSpace(v))
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.Index(v, "+"); i != -1 {
+		v = v[:i] // build metadata does not affect precedence
+	}
+
+	var tokens []string
+	var current strings.Builder
+	isDigit := false
+	for _, r := range v {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		if current.Len() > 0 && unicode.IsDigit(r) != isDigit {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+		isDigit = unicode.IsDigit(r)
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// tagRanks orders the textual tags relative to a release, which has rank 0.
+var tagRanks = map[string]int{
+	"dev":     -4,
+	"a":       -3,
+	"alpha":   -3,
+	"b":       -2,
+	"beta":    -2,
+	"c":       -1,
+	"rc":      -1,
+	"pre":     -1,
+	"preview": -1,
+	"post":    1,
+	"p":       1,
+}
+
+// rank returns the rank of a token compared to the end of a version: numbers extend a version and
+// rank above it, pre-release tags rank below it.
+func rank(token string) int {
+	if token == "" {
+		return 0
+	}
+	if _, err := strconv.Atoi(token); err == nil {
+		return 2
+	}
+	if r, ok := tagRanks[token]; ok {
+		return r
+	}
+	return -1 // unknown tags are treated as pre-releases, eg. 1.0.0-foo < 1.0.0
+}
+
+func compareTokens(x, y string) int {
+	// A missing segment is equivalent to zero when compared to a number, eg. 1.0 == 1.0.0.
+	if x == "" && rank(y) == 2 {
+		x = "0"
+	}
+	if y == "" && rank(x) == 2 {
+		y = "0"
+	}
+	nx, errX := strconv.Atoi(x)
+	ny, errY := strconv.Atoi(y)
+	if errX == nil && errY == nil {
+		return compareInts(nx, ny)
+	}
+	if rx, ry := rank(x), rank(y); rx != ry {
+		return compareInts(rx, ry)
+	}
+	return strings.Compare(x, y)
+}
+
+func compareInts(x, y int) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
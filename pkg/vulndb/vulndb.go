@@ -0,0 +1,318 @@
+// Package vulndb implements a local vulnerability database, so that ingredients can be checked for
+// known vulnerabilities without access to the platform. The database is a snapshot of OSV records
+// (https://osv.dev) that is synced ahead of time.
+package vulndb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/errs"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/httputil"
+	"github.com/ActiveState/cli/internal/installation/storage"
+	medmodel "github.com/ActiveState/cli/pkg/platform/api/mediator/model"
+	vulnModel "github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/model"
+	"github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/request"
+	"github.com/ActiveState/cli/pkg/platform/model"
+)
+
+const dbFile = "osv.json"
+
+// osvExportURL is the location of the OSV export of all vulnerabilities of an ecosystem.
+const osvExportURL = "https://osv-vulnerabilities.storage.googleapis.com/%s/all.zip"
+
+// ErrNotSynced indicates that the database has not been synced yet.
+var ErrNotSynced = errs.New("vulnerability database has not been synced")
+
+var errInvalidVector = errors.New("invalid CVSS vector")
+
+// ecosystems maps the language of package namespaces (language/<language>) to their OSV ecosystem.
+var ecosystems = map[string]string{
+	"python":     "PyPI",
+	"ruby":       "RubyGems",
+	"javascript": "npm",
+	"nodejs":     "npm",
+	"golang":     "Go",
+	"go":         "Go",
+}
+
+// DefaultSources returns the OSV exports of all ecosystems the platform provides packages for.
+func DefaultSources() []string {
+	seen := map[string]bool{}
+	var sources []string
+	for _, ecosystem := range ecosystems {
+		if !seen[ecosystem] {
+			seen[ecosystem] = true
+			sources = append(sources, fmt.Sprintf(osvExportURL, ecosystem))
+		}
+	}
+	sort.Strings(sources)
+	return sources
+}
+
+type Configurable interface {
+	GetString(key string) string
+}
+
+// Dir returns the directory the database is stored in.
+func Dir(cfg Configurable) string {
+	if dir := cfg.GetString(constants.SecurityOfflineDBConfig); dir != "" {
+		return dir
+	}
+	return filepath.Join(storage.CachePath(), "vulndb")
+}
+
+type DB struct {
+	SyncedAt time.Time `json:"synced_at"`
+	Sources  []string  `json:"sources"`
+	Records  []*Record `json:"vulnerabilities"`
+
+	index map[string][]*Record
+}
+
+// Open reads the database from the given directory.
+func Open(dir string) (*DB, error) {
+	path := filepath.Join(dir, dbFile)
+	if !fileutils.TargetExists(path) {
+		return nil, ErrNotSynced
+	}
+	b, err := fileutils.ReadFile(path)
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read vulnerability database")
+	}
+	db := &DB{}
+	if err := json.Unmarshal(b, db); err != nil {
+		return nil, errs.Wrap(err, "Could not unmarshal vulnerability database")
+	}
+	db.buildIndex()
+	return db, nil
+}
+
+// Sync downloads (or reads, in case of local paths) the given vulnerability snapshots and combines
+// them into a new database. Records of ecosystems the platform does not provide are dropped.
+func Sync(sources []string) (*DB, error) {
+	supported := map[string]bool{}
+	for _, ecosystem := range ecosystems {
+		supported[ecosystem] = true
+	}
+
+	db := &DB{SyncedAt: time.Now().UTC(), Sources: sources}
+	seen := map[string]bool{}
+	for _, source := range sources {
+		data, err := fetch(source)
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not fetch %s", source)
+		}
+		records, err := parseRecords(data)
+		if err != nil {
+			return nil, errs.Wrap(err, "Could not parse %s", source)
+		}
+		for _, r := range records {
+			if seen[r.ID] || r.Withdrawn != "" {
+				continue
+			}
+			var affected []Affected
+			for _, a := range r.Affected {
+				if supported[baseEcosystem(a.Package.Ecosystem)] {
+					affected = append(affected, a)
+				}
+			}
+			if len(affected) == 0 {
+				continue
+			}
+			r.Affected = affected
+			seen[r.ID] = true
+			db.Records = append(db.Records, r)
+		}
+	}
+	db.buildIndex()
+	return db, nil
+}
+
+func fetch(source string) ([]byte, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		return httputil.GetDirect(source)
+	}
+	b, err := os.ReadFile(strings.TrimPrefix(source, "file://"))
+	if err != nil {
+		return nil, errs.Wrap(err, "Could not read file")
+	}
+	return b, nil
+}
+
+// Save writes the database to the given directory.
+func (db *DB) Save(dir string) error {
+	b, err := json.Marshal(db)
+	if err != nil {
+		return errs.Wrap(err, "Could not marshal vulnerability database")
+	}
+	if err := fileutils.MkdirUnlessExists(dir); err != nil {
+		return errs.Wrap(err, "Could not create vulnerability database directory")
+	}
+	if err := fileutils.WriteFile(filepath.Join(dir, dbFile), b); err != nil {
+		return errs.Wrap(err, "Could not write vulnerability database")
+	}
+	return nil
+}
+
+func (db *DB) buildIndex() {
+	db.index = map[string][]*Record{}
+	for _, r := range db.Records {
+		for _, a := range r.Affected {
+			key := indexKey(baseEcosystem(a.Package.Ecosystem), a.Package.Name)
+			db.index[key] = append(db.index[key], r)
+		}
+	}
+}
+
+// Match returns the vulnerabilities affecting the given version of an ingredient. Records describing
+// the same CVE (eg. a GitHub advisory and a PyPA advisory) are reported once.
+func (db *DB) Match(namespace, name, version string) []*Record {
+	ecosystem := ecosystemOf(namespace)
+	if ecosystem == "" {
+		return nil
+	}
+
+	var matches []*Record
+	seen := map[string]int{}
+	for _, r := range db.index[indexKey(ecosystem, name)] {
+		if !r.affects(ecosystem, name, version) {
+			continue
+		}
+		if i, ok := seen[r.CveID()]; ok {
+			// Prefer the record with an explicit severity.
+			if matches[i].DatabaseSpecific == nil && len(matches[i].Severity) == 0 {
+				matches[i] = r
+			}
+			continue
+		}
+		seen[r.CveID()] = len(matches)
+		matches = append(matches, r)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].CveID() < matches[j].CveID()
+	})
+	return matches
+}
+
+func (r *Record) affects(ecosystem, name, version string) bool {
+	for _, a := range r.Affected {
+		if baseEcosystem(a.Package.Ecosystem) != ecosystem || normalizeName(ecosystem, a.Package.Name) != normalizeName(ecosystem, name) {
+			continue
+		}
+		if affects(a, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// VulnerabilitiesForIngredients is the offline equivalent of model.FetchVulnerabilitiesForIngredients.
+func (db *DB) VulnerabilitiesForIngredients(ingredients []*request.Ingredient) []*model.VulnerabilityIngredient {
+	var result []*model.VulnerabilityIngredient
+	for _, ing := range ingredients {
+		matches := db.Match(ing.Namespace, ing.Name, ing.Version)
+		if len(matches) == 0 {
+			continue
+		}
+		vulns := &model.Vulnerabilities{Critical: []string{}, High: []string{}, Medium: []string{}, Low: []string{}}
+		for _, r := range matches {
+			switch r.severity() {
+			case vulnModel.SeverityCritical:
+				vulns.Critical = append(vulns.Critical, r.CveID())
+			case vulnModel.SeverityHigh:
+				vulns.High = append(vulns.High, r.CveID())
+			case vulnModel.SeverityMedium:
+				vulns.Medium = append(vulns.Medium, r.CveID())
+			case vulnModel.SeverityLow:
+				vulns.Low = append(vulns.Low, r.CveID())
+			}
+		}
+		result = append(result, &model.VulnerabilityIngredient{
+			Name:             ing.Name,
+			PrimaryNamespace: ing.Namespace,
+			Version:          ing.Version,
+			Vulnerabilities:  vulns,
+		})
+	}
+	return result
+}
+
+// CommitVulnerabilities is the offline equivalent of model.FetchCommitVulnerabilities, for the given
+// ingredients of a commit.
+func (db *DB) CommitVulnerabilities(commitID string, ingredients []*request.Ingredient) *medmodel.CommitVulnerabilities {
+	result := &medmodel.CommitVulnerabilities{CommitID: commitID}
+	counts := map[string]int{}
+	for _, ing := range ingredients {
+		matches := db.Match(ing.Namespace, ing.Name, ing.Version)
+		if len(matches) == 0 {
+			continue
+		}
+		source := medmodel.SourceVulnerability{Name: ing.Name, Version: ing.Version}
+		for _, r := range matches {
+			severity := strings.ToUpper(r.severity())
+			counts[severity]++
+			var altIDs []string
+			for _, id := range append([]string{r.ID}, r.Aliases...) {
+				if id != r.CveID() {
+					altIDs = append(altIDs, id)
+				}
+			}
+			source.Vulnerabilities = append(source.Vulnerabilities, medmodel.Vulnerability{
+				Severity: severity,
+				CveID:    r.CveID(),
+				AltIds:   altIDs,
+			})
+		}
+		result.Sources = append(result.Sources, source)
+	}
+
+	for _, severity := range []string{vulnModel.SeverityCritical, vulnModel.SeverityHigh, vulnModel.SeverityMedium, vulnModel.SeverityLow} {
+		if count := counts[strings.ToUpper(severity)]; count > 0 {
+			result.VulnerabilityHistogram = append(result.VulnerabilityHistogram, medmodel.SeverityCount{
+				Severity: strings.ToUpper(severity),
+				Count:    count,
+			})
+		}
+	}
+	return result
+}
+
+func ecosystemOf(namespace string) string {
+	lang, ok := strings.CutPrefix(namespace, "language/")
+	if !ok {
+		return ""
+	}
+	return ecosystems[lang]
+}
+
+// baseEcosystem strips the release qualifier of an OSV ecosystem, eg. "Debian:11".
+func baseEcosystem(ecosystem string) string {
+	base, _, _ := strings.Cut(ecosystem, ":")
+	return base
+}
+
+var pypiNameRe = regexp.MustCompile(`[-_.]+`)
+
+func normalizeName(ecosystem, name string) string {
+	switch ecosystem {
+	case "PyPI":
+		return strings.ToLower(pypiNameRe.ReplaceAllString(name, "-"))
+	case "Go":
+		return name
+	}
+	return strings.ToLower(name)
+}
+
+func indexKey(ecosystem, name string) string {
+	return ecosystem + "/" + normalizeName(ecosystem, name)
+}
@@ -0,0 +1,175 @@
+package vulndb
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	vulnModel "github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/model"
+	"github.com/ActiveState/cli/pkg/platform/api/vulnerabilities/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func syncFixture(t *testing.T) *DB {
+	db, err := Sync([]string{filepath.Join("testdata", "osv.json")})
+	require.NoError(t, err)
+	return db
+}
+
+func ids(records []*Record) []string {
+	var result []string
+	for _, r := range records {
+		result = append(result, r.ID)
+	}
+	return result
+}
+
+func TestSync(t *testing.T) {
+	db := syncFixture(t)
+
+	// Withdrawn records and records of unsupported ecosystems are dropped.
+	assert.Equal(t, []string{"GHSA-xxxx-0001", "PYSEC-2023-0001", "PYSEC-2023-0002", "GHSA-xxxx-0003"}, ids(db.Records))
+
+	dir := t.TempDir()
+	require.NoError(t, db.Save(dir))
+	opened, err := Open(dir)
+	require.NoError(t, err)
+	assert.Equal(t, ids(db.Records), ids(opened.Records))
+	assert.Len(t, opened.Match("language/python", "requests-toolbelt", "0.9.1"), 2)
+
+	_, err = Open(t.TempDir())
+	assert.ErrorIs(t, err, ErrNotSynced)
+}
+
+func TestSyncZip(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "osv.json"))
+	require.NoError(t, err)
+
+	records, err := parseRecords(fixture)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("GHSA-xxxx-0003.json")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`{"id": "GHSA-xxxx-0003", "affected": [{"package": {"ecosystem": "npm", "name": "left-pad"}, "versions": ["1.1.0"]}]}`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	zipped, err := parseRecords(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GHSA-xxxx-0003"}, ids(zipped))
+	assert.Len(t, records, 6)
+
+	_, err = parseRecords([]byte("not a snapshot"))
+	assert.Error(t, err)
+}
+
+func TestMatch(t *testing.T) {
+	db := syncFixture(t)
+
+	tests := []struct {
+		name      string
+		namespace string
+		pkg       string
+		version   string
+		want      []string
+	}{
+		{"introduced at zero", "language/python", "requests-toolbelt", "0.8.0", []string{"GHSA-xxxx-0001"}},
+		{"normalized name", "language/python", "requests.toolbelt", "0.8.0", []string{"GHSA-xxxx-0001"}},
+		{"explicit version", "language/python", "requests-toolbelt", "0.9.1", []string{"GHSA-xxxx-0001", "PYSEC-2023-0002"}},
+		{"fixed", "language/python", "requests-toolbelt", "1.0.0", nil},
+		{"between ranges", "language/python", "requests-toolbelt", "1.5.0", nil},
+		{"reintroduced", "language/python", "requests-toolbelt", "2.0.5", []string{"GHSA-xxxx-0001"}},
+		{"fixed by release candidate", "language/python", "requests-toolbelt", "2.1.0", nil},
+		{"before release candidate", "language/python", "requests-toolbelt", "2.1.0b2", []string{"GHSA-xxxx-0001"}},
+		{"last affected", "language/javascript", "left-pad", "1.3.0", []string{"GHSA-xxxx-0003"}},
+		{"after last affected", "language/javascript", "left-pad", "1.3.1", nil},
+		{"before introduced", "language/javascript", "left-pad", "0.9.0", nil},
+		{"unsupported namespace", "shared", "openssl", "1.1.1", nil},
+		{"unknown package", "language/python", "flask", "1.0.0", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ids(db.Match(tt.namespace, tt.pkg, tt.version)))
+		})
+	}
+}
+
+func TestVulnerabilitiesForIngredients(t *testing.T) {
+	db := syncFixture(t)
+
+	result := db.VulnerabilitiesForIngredients([]*request.Ingredient{
+		{Namespace: "language/python", Name: "requests-toolbelt", Version: "0.9.1"},
+		{Namespace: "language/python", Name: "flask", Version: "1.0.0"},
+		{Namespace: "language/javascript", Name: "left-pad", Version: "1.2.0"},
+	})
+	require.Len(t, result, 2)
+	assert.Equal(t, []string{"PYSEC-2023-0002"}, result[0].Vulnerabilities.Critical)
+	assert.Equal(t, []string{"CVE-2023-0001"}, result[0].Vulnerabilities.High)
+	assert.Equal(t, []string{"CVE-2023-0003"}, result[1].Vulnerabilities.Medium, "unknown severities are reported as medium")
+
+	commit := db.CommitVulnerabilities("00000000-0000-0000-0000-000000000000", []*request.Ingredient{
+		{Namespace: "language/python", Name: "requests-toolbelt", Version: "0.9.1"},
+	})
+	require.Len(t, commit.Sources, 1)
+	assert.Len(t, commit.Sources[0].Vulnerabilities, 2)
+	assert.Equal(t, []string{"GHSA-xxxx-0001"}, commit.Sources[0].Vulnerabilities[0].AltIds)
+	assert.Equal(t, "CRITICAL", commit.VulnerabilityHistogram[0].Severity)
+	assert.Equal(t, "HIGH", commit.VulnerabilityHistogram[1].Severity)
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0.0", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"1.2.3+build.5", "1.2.3", 0},
+		{"1.2.10", "1.2.9", 1},
+		{"1.0rc1", "1.0", -1},
+		{"1.0.0-alpha.1", "1.0.0-beta", -1},
+		{"1.0a1", "1.0b1", -1},
+		{"1.0.dev1", "1.0a1", -1},
+		{"1.0.post1", "1.0", 1},
+		{"1.0.post1", "1.0.1", -1},
+		{"2.0", "10.0", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompareVersions(tt.a, tt.b))
+			assert.Equal(t, -tt.want, CompareVersions(tt.b, tt.a))
+		})
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	tests := []struct {
+		vector string
+		score  float64
+	}{
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N", 6.1},
+		{"CVSS:3.0/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N", 5.5},
+		{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.vector, func(t *testing.T) {
+			score, err := cvss3BaseScore(tt.vector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.score, score)
+		})
+	}
+
+	_, err := cvss3BaseScore("CVSS:2.0/AV:N")
+	assert.Error(t, err)
+
+	r := &Record{DatabaseSpecific: &DatabaseSpecific{Severity: "MODERATE"}}
+	assert.Equal(t, vulnModel.SeverityMedium, r.severity())
+	r = &Record{Severity: []Severity{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N"}}}
+	assert.Equal(t, vulnModel.SeverityMedium, r.severity())
+}
@@ -189,6 +189,67 @@ func (suite *ArtifactsIntegrationTestSuite) TestArtifacts_Download_Remote() {
 	require.FileExists(suite.T(), filepath.Join(ts.Dirs.Work, "bzip2-1.0.8.tar.gz"))
 }
 
+func (suite *ArtifactsIntegrationTestSuite) TestArtifacts_Logs() {
+	suite.OnlyRunForTags(tagsuite.Artifacts)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareProject("ActiveState-CLI/Python-With-Custom-Builds", "993454c7-6613-4b1a-8981-1cee43cc249e")
+
+	suite.Run("downloads log", func() {
+		cp := ts.Spawn("artifacts", "logs", "bzip2@1.0.8", "--output", "json")
+		cp.Expect(`"archived":false`)
+		cp.ExpectExitCode(0)
+	})
+
+	suite.Run("reads from archive", func() {
+		cp := ts.Spawn("artifacts", "logs", "bzip2@1.0.8", "--output", "json")
+		cp.Expect(`"archived":true`)
+		cp.ExpectExitCode(0)
+	})
+
+	suite.Run("grep", func() {
+		cp := ts.Spawn("artifacts", "logs", "bzip2@1.0.8", "--grep", "(?i)bzip2")
+		cp.Expect("bzip2@1.0.8")
+		cp.ExpectExitCode(0)
+		suite.Assert().NotContains(cp.Output(), "No matching lines")
+	})
+
+	suite.Run("name required", func() {
+		cp := ts.Spawn("artifacts", "logs")
+		cp.Expect("--failed")
+		cp.ExpectExitCode(1)
+	})
+}
+
+func (suite *ArtifactsIntegrationTestSuite) TestArtifacts_Diff() {
+	suite.OnlyRunForTags(tagsuite.Artifacts)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	commitID := "993454c7-6613-4b1a-8981-1cee43cc249e"
+	ts.PrepareProject("ActiveState-CLI/Python-With-Custom-Builds", commitID)
+
+	suite.Run("same commit", func() {
+		cp := ts.Spawn("artifacts", "--diff", commitID, commitID)
+		cp.Expect("No changes")
+		cp.ExpectExitCode(0)
+	})
+
+	suite.Run("json", func() {
+		cp := ts.Spawn("artifacts", "--diff", commitID, "--output", "json")
+		cp.Expect(`"changes":[]`)
+		cp.ExpectExitCode(0)
+		AssertValidJSON(suite.T(), cp)
+	})
+
+	suite.Run("commits require diff", func() {
+		cp := ts.Spawn("artifacts", commitID)
+		cp.Expect("--diff")
+		cp.ExpectExitCode(1)
+	})
+}
+
 func (suite *ArtifactsIntegrationTestSuite) extractBuildID(ts *e2e.Session, name string, namespace string) string {
 	args := []string{"builds", "--all", "--output=json"}
 	if namespace != "" {
@@ -1,10 +1,12 @@
 package integration
 
 import (
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/fileutils"
 	"github.com/ActiveState/cli/internal/testhelpers/e2e"
 	"github.com/ActiveState/cli/internal/testhelpers/suite"
 	"github.com/ActiveState/cli/internal/testhelpers/tagsuite"
@@ -186,6 +188,39 @@ func (suite *ConfigIntegrationTestSuite) TestAPIHostConfig() {
 	cp.Expect(`{"name":"api.host","value":""}`)
 }
 
+func (suite *ConfigIntegrationTestSuite) TestLayers() {
+	suite.OnlyRunForTags(tagsuite.Config)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	systemConfig := filepath.Join(ts.Dirs.Work, constants.SystemConfigFileName)
+	err := fileutils.WriteFile(systemConfig, []byte("config:\n  "+constants.OptinBuildscriptsConfig+": true\nlocked:\n  - "+constants.OptinBuildscriptsConfig+"\n"))
+	suite.Require().NoError(err)
+	env := e2e.OptAppendEnv(constants.SystemConfigEnvVarName + "=" + systemConfig)
+
+	cp := ts.SpawnWithOpts(e2e.OptArgs("config", "get", constants.OptinBuildscriptsConfig, "--show-origin"), env)
+	cp.Expect("true")
+	cp.Expect("system")
+	cp.Expect("locked")
+	cp.ExpectExitCode(0)
+
+	cp = ts.SpawnWithOpts(e2e.OptArgs("config", "set", constants.OptinBuildscriptsConfig, "false"), env)
+	cp.Expect("locked by your system administrator")
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+
+	// Unlocked keys can be overridden for a single invocation.
+	cp = ts.Spawn("config", "get", constants.OptinBuildscriptsConfig, "--show-origin", "--config", constants.OptinBuildscriptsConfig+"=true")
+	cp.Expect("true")
+	cp.Expect("override")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("config", "get", constants.OptinBuildscriptsConfig, "--show-origin")
+	cp.Expect("false")
+	cp.Expect("default")
+	cp.ExpectExitCode(0)
+}
+
 func TestConfigIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(ConfigIntegrationTestSuite))
 }
@@ -1,8 +1,10 @@
 package integration
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/ActiveState/cli/internal/fileutils"
 	"github.com/ActiveState/cli/internal/testhelpers/e2e"
 	"github.com/ActiveState/cli/internal/testhelpers/suite"
 	"github.com/ActiveState/cli/internal/testhelpers/tagsuite"
@@ -82,6 +84,28 @@ func (suite *CveIntegrationTestSuite) TestCveInvalidProject() {
 	ts.IgnoreLogErrors()
 }
 
+func (suite *CveIntegrationTestSuite) TestCveOffline() {
+	suite.OnlyRunForTags(tagsuite.Cve)
+
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	snapshot := filepath.Join(ts.Dirs.Work, "osv.json")
+	err := fileutils.WriteFile(snapshot, []byte(`[{"id": "GHSA-test-0001", "aliases": ["CVE-2000-0001"], "affected": [{"package": {"ecosystem": "PyPI", "name": "requests"}, "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}]}]}]}]`))
+	suite.Require().NoError(err)
+
+	cp := ts.Spawn("security", "sync", "--source", snapshot)
+	cp.Expect("Synced")
+	cp.ExpectExitCode(0)
+
+	ts.PrepareProject("ActiveState-CLI/Python3", "59404293-e5a9-4fd0-8843-77cd4761b5b5")
+
+	cp = ts.Spawn("security", "--offline")
+	cp.Expect("runtime to be installed")
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+}
+
 func (suite *CveIntegrationTestSuite) TestJSON() {
 	suite.OnlyRunForTags(tagsuite.Cve, tagsuite.JSON)
 	ts := e2e.New(suite.T(), false)
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ActiveState/cli/internal/constants"
 	"github.com/ActiveState/cli/internal/testhelpers/e2e"
 	"github.com/ActiveState/cli/internal/testhelpers/suite"
 	"github.com/ActiveState/cli/internal/testhelpers/tagsuite"
@@ -54,6 +55,47 @@ func (suite *ExportIntegrationTestSuite) TestExport_Env() {
 	suite.Assert().NotContains(cp.Output(), "ACTIVESTATE_ACTIVATED")
 }
 
+func (suite *ExportIntegrationTestSuite) TestExport_EnvFormat() {
+	suite.OnlyRunForTags(tagsuite.Export)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareEmptyProject()
+	cp := ts.Spawn("export", "env", "--format", "direnv")
+	cp.Expect(`watch_file`)
+	cp.Expect(`PATH_add `)
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("export", "env", "--format", "fish")
+	cp.Expect(`set -gx PATH `)
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("export", "env", "--format", "xml")
+	cp.Expect(`Unknown format`)
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+}
+
+func (suite *ExportIntegrationTestSuite) TestExport_Container() {
+	suite.OnlyRunForTags(tagsuite.Export)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareEmptyProject()
+	cp := ts.Spawn("export", "container")
+	cp.Expect("state checkout ActiveState-CLI/Empty#")
+	cp.Expect("FROM ${BASE_IMAGE} AS runtime")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("export", "container", "--format", "devcontainer")
+	cp.Expect(`"target": "state"`)
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("export", "container", "--format", "vscode-tasks")
+	cp.Expect(`"version": "2.0.0"`)
+	cp.ExpectExitCode(0)
+}
+
 func (suite *ExportIntegrationTestSuite) TestExport_Log() {
 	suite.OnlyRunForTags(tagsuite.Export)
 	ts := e2e.New(suite.T(), false)
@@ -144,6 +186,62 @@ func (suite *ExportIntegrationTestSuite) TestExport_Runtime() {
 	cp.ExpectExitCode(0)
 }
 
+func (suite *ExportIntegrationTestSuite) TestExport_RuntimeReport() {
+	suite.OnlyRunForTags(tagsuite.Export)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareEmptyProject()
+	cp := ts.Spawn("refresh")
+	cp.ExpectExitCode(0, e2e.RuntimeSourcingTimeoutOpt)
+
+	traceFile := filepath.Join(ts.Dirs.Work, "trace.json")
+	cp = ts.Spawn("export", "runtime-report", "--trace", traceFile)
+	cp.Expect("Runtime setup of")
+	cp.Expect("Phases")
+	cp.Expect("Trace written to")
+	cp.ExpectExitCode(0)
+
+	b, err := os.ReadFile(traceFile)
+	suite.Require().NoError(err)
+	var trace struct {
+		TraceEvents []map[string]interface{} `json:"traceEvents"`
+	}
+	suite.Require().NoError(json.Unmarshal(b, &trace))
+	suite.NotEmpty(trace.TraceEvents)
+
+	cp = ts.Spawn("export", "runtime-report", "-o", "json")
+	cp.Expect(`"concurrency":`)
+	cp.ExpectExitCode(0)
+	AssertValidJSON(suite.T(), cp)
+}
+
+func (suite *ExportIntegrationTestSuite) TestExport_MCPTools() {
+	suite.OnlyRunForTags(tagsuite.Export)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	cp := ts.Spawn("export", "_mcp-tools")
+	cp.Expect("manifest")
+	cp.ExpectExitCode(0)
+	suite.NotContains(cp.Output(), "export_jwt", "credentials are not exposed by default")
+	suite.NotContains(cp.Output(), "config_get", "config values are not exposed by default")
+
+	cp = ts.Spawn("export", "_mcp-tools", "-o", "json")
+	cp.Expect(`"name":"manifest"`)
+	cp.ExpectExitCode(0)
+	suite.NotContains(cp.Output(), `"name":"install"`, "tools that change the project are not exposed by default")
+
+	cp = ts.Spawn("config", "set", constants.MCPToolsAllowConfig, "install,uninstall")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("export", "_mcp-tools", "-o", "json")
+	cp.Expect(`"name":"install"`)
+	cp.ExpectExitCode(0)
+	AssertValidJSON(suite.T(), cp)
+	suite.NotContains(cp.Output(), `"name":"manifest"`)
+}
+
 func (suite *ExportIntegrationTestSuite) TestExport_BuildPlan() {
 	suite.OnlyRunForTags(tagsuite.Export)
 	ts := e2e.New(suite.T(), false)
@@ -158,6 +256,28 @@ func (suite *ExportIntegrationTestSuite) TestExport_BuildPlan() {
 	cp.ExpectExitCode(0)
 }
 
+func (suite *ExportIntegrationTestSuite) TestExport_SBOM() {
+	suite.OnlyRunForTags(tagsuite.Export)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareProject("ActiveState-CLI/Python3", "59404293-e5a9-4fd0-8843-77cd4761b5b5")
+
+	cp := ts.Spawn("export", "sbom")
+	cp.Expect(`"bomFormat": "CycloneDX"`)
+	cp.Expect(`"purl": "pkg:pypi/`)
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("export", "sbom", "--format", "spdx-json")
+	cp.Expect(`"spdxVersion": "SPDX-2.3"`)
+	cp.Expect(`"relationshipType": "DEPENDS_ON"`)
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("export", "sbom", "--format", "xml")
+	cp.Expect("Unsupported SBOM format")
+	cp.ExpectExitCode(1)
+}
+
 func (suite *ExportIntegrationTestSuite) TestJSON() {
 	suite.OnlyRunForTags(tagsuite.Export, tagsuite.JSON)
 	ts := e2e.New(suite.T(), false)
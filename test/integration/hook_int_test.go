@@ -0,0 +1,68 @@
+package integration
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/testhelpers/e2e"
+	"github.com/ActiveState/cli/internal/testhelpers/suite"
+	"github.com/ActiveState/cli/internal/testhelpers/tagsuite"
+)
+
+type HookIntegrationTestSuite struct {
+	tagsuite.Suite
+}
+
+func (suite *HookIntegrationTestSuite) TestInstallUninstall() {
+	suite.OnlyRunForTags(tagsuite.Hook)
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("The hook is not supported on Windows")
+	}
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	rcFile := filepath.Join(ts.Dirs.HomeDir, ".bashrc")
+	shellEnv := e2e.OptAppendEnv(constants.OverrideShellEnvVarName + "=bash")
+
+	cp := ts.SpawnWithOpts(e2e.OptArgs("hook", "install"), shellEnv)
+	cp.Expect("The hook was installed")
+	cp.ExpectExitCode(0)
+	suite.Contains(string(fileutils.ReadFileUnsafe(rcFile)), constants.RCAppendHookStartLine)
+	suite.Contains(string(fileutils.ReadFileUnsafe(rcFile)), "hook env --shell bash")
+
+	cp = ts.SpawnWithOpts(e2e.OptArgs("hook", "uninstall"), shellEnv)
+	cp.Expect("The hook was removed")
+	cp.ExpectExitCode(0)
+	suite.NotContains(string(fileutils.ReadFileUnsafe(rcFile)), constants.RCAppendHookStartLine)
+}
+
+func (suite *HookIntegrationTestSuite) TestEnv() {
+	suite.OnlyRunForTags(tagsuite.Hook)
+	if runtime.GOOS == "windows" {
+		suite.T().Skip("The hook is not supported on Windows")
+	}
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareEmptyProject()
+
+	cp := ts.Spawn("refresh")
+	cp.ExpectExitCode(0, e2e.RuntimeSourcingTimeoutOpt)
+
+	cp = ts.Spawn("hook", "env", "--shell", "bash")
+	cp.Expect("export " + constants.HookStateEnvVarName + "=")
+	cp.Expect("export PATH=")
+	cp.ExpectExitCode(0)
+
+	// Outside of a project, and without a previous activation, there is nothing to do.
+	cp = ts.SpawnWithOpts(e2e.OptArgs("hook", "env", "--shell", "bash"), e2e.OptWD(ts.Dirs.HomeDir))
+	cp.ExpectExitCode(0)
+	suite.NotContains(cp.Output(), "export")
+}
+
+func TestHookIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(HookIntegrationTestSuite))
+}
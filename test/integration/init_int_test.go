@@ -219,6 +219,45 @@ func (suite *InitIntegrationTestSuite) TestInit_ChangeSummary() {
 	cp.ExpectExitCode(0)
 }
 
+func (suite *InitIntegrationTestSuite) TestInit_Template() {
+	suite.OnlyRunForTags(tagsuite.Init)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+	ts.LoginAsPersistentUser()
+
+	templates := filepath.Join(ts.Dirs.Work, "templates")
+	tplDir := filepath.Join(templates, "python-service")
+	files := map[string]string{
+		"template.yaml":                      "language: python@3.10\nvariables:\n  - name: module\n",
+		constants.ConfigFileName:             "constants:\n  - name: module\n    value: {{.Vars.module}}\n",
+		"files/{{.Vars.module}}/main.py.tpl": "print('{{.Namespace}}')\n",
+	}
+	for name, contents := range files {
+		suite.Require().NoError(fileutils.WriteFile(filepath.Join(tplDir, name), []byte(contents)))
+	}
+
+	cp := ts.Spawn("config", "set", constants.AsyncRuntimeConfig, "true")
+	cp.ExpectExitCode(0)
+
+	pname := strutils.UUID()
+	namespace := fmt.Sprintf("%s/%s", e2e.PersistentUsername, pname)
+
+	// Required variables must be given.
+	cp = ts.Spawn("init", namespace, "--template", "python-service", "--config", constants.InitTemplatesPathConfig+"="+templates)
+	cp.Expect("requires values for the following variables: module")
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+
+	projectDir := filepath.Join(ts.Dirs.Work, "project")
+	cp = ts.Spawn("init", namespace, projectDir, "--template", tplDir, "--var", "module=app")
+	cp.Expect("successfully initialized", e2e.RuntimeSourcingTimeoutOpt)
+	cp.ExpectExitCode(0)
+	ts.NotifyProjectCreated(e2e.PersistentUsername, pname.String())
+
+	suite.Contains(string(fileutils.ReadFileUnsafe(filepath.Join(projectDir, constants.ConfigFileName))), "value: app")
+	suite.Equal(fmt.Sprintf("print('%s')\n", namespace), string(fileutils.ReadFileUnsafe(filepath.Join(projectDir, "app", "main.py"))))
+}
+
 func TestInitIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(InitIntegrationTestSuite))
 }
@@ -31,6 +31,31 @@ func (suite *InstallIntegrationTestSuite) TestInstall() {
 	cp.ExpectExitCode(0)
 }
 
+func (suite *InstallIntegrationTestSuite) TestInstall_DryRun() {
+	suite.OnlyRunForTags(tagsuite.Install)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	commitID := "5a1e49e5-8ceb-4a09-b605-ed334474855b"
+	ts.PrepareProject("ActiveState-CLI/small-python", commitID)
+
+	cp := ts.Spawn("install", "trender", "--dry-run")
+	cp.Expect("trender", e2e.RuntimeSolvingTimeoutOpt)
+	cp.Expect("Dry run, the changes were not applied")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("install", "trender", "--dry-run", "-o", "json")
+	cp.Expect(`"dry_run":true`, e2e.RuntimeSolvingTimeoutOpt)
+	cp.ExpectExitCode(0)
+	AssertValidJSON(suite.T(), cp)
+
+	// The local commit must not have changed.
+	cp = ts.Spawn("history", "-o", "json")
+	cp.Expect(commitID)
+	cp.ExpectExitCode(0)
+	suite.Assert().NotContains(cp.Output(), "trender")
+}
+
 func (suite *InstallIntegrationTestSuite) TestInstallSuggest() {
 	suite.OnlyRunForTags(tagsuite.Install, tagsuite.Critical)
 	ts := e2e.New(suite.T(), false)
@@ -272,6 +272,80 @@ func (suite *NotificationIntegrationTestSuite) TestNotification_Basic_InterruptE
 	ts.IgnoreLogErrors()
 }
 
+func (suite *NotificationIntegrationTestSuite) TestNotification_LocalFiles() {
+	suite.OnlyRunForTags(tagsuite.Notifications)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	emptyFile, err := fileutils.WriteTempFileToDir(ts.Dirs.Work, "messages.json", []byte(`[]`), 0755)
+	suite.Require().NoError(err)
+
+	notificationsDir := filepath.Join(ts.Dirs.Work, "notifications")
+	suite.Require().NoError(fileutils.WriteFile(filepath.Join(notificationsDir, "local.json"), []byte(`[
+	{
+		"ID": "local",
+		"Message": "This is a [NOTICE]local[/RESET] notification",
+		"Condition": "eq .Project.Namespace \"\""
+	}
+]`)))
+	ts.SetConfig(constants.NotificationsDirConfig, notificationsDir)
+
+	cp := ts.SpawnWithOpts(e2e.OptArgs("--version"), e2e.OptAppendEnv(constants.NotificationsOverrideEnvVarName+"="+emptyFile))
+	cp.Expect(`This is a local notification`)
+	cp.Expect("ActiveState CLI by ActiveState Software Inc.")
+	cp.ExpectExitCode(0)
+}
+
+func (suite *NotificationIntegrationTestSuite) TestNotification_SnoozeAcknowledge() {
+	suite.OnlyRunForTags(tagsuite.Notifications)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	msgFile, err := fileutils.WriteTempFileToDir(ts.Dirs.Work, "messages.json", []byte(`[
+	{
+		"ID": "snoozable",
+		"Message": "This is a [NOTICE]snoozable[/RESET] notification",
+		"Repeat": "Constantly"
+	},
+	{
+		"ID": "acknowledgeable",
+		"Message": "This is an [NOTICE]acknowledgeable[/RESET] notification",
+		"Repeat": "Constantly"
+	}
+]`), 0755)
+	suite.Require().NoError(err)
+	env := e2e.OptAppendEnv(constants.NotificationsOverrideEnvVarName + "=" + msgFile)
+
+	cp := ts.SpawnWithOpts(e2e.OptArgs("--version"), env)
+	cp.Expect(`This is a snoozable notification`)
+	cp.ExpectExitCode(0)
+
+	cp = ts.SpawnWithOpts(e2e.OptArgs("notifications", "snooze", "snoozable", "--days", "2"), env)
+	cp.Expect("Snoozed notification 'snoozable'")
+	cp.ExpectExitCode(0)
+
+	cp = ts.SpawnWithOpts(e2e.OptArgs("notifications", "acknowledge", "acknowledgeable"), env)
+	cp.Expect("Acknowledged notification 'acknowledgeable'")
+	cp.ExpectExitCode(0)
+
+	cp = ts.SpawnWithOpts(e2e.OptArgs("--version"), env)
+	cp.Expect("ActiveState CLI by ActiveState Software Inc.")
+	cp.ExpectExitCode(0)
+	suite.Require().NotContains(cp.Output(), "snoozable notification")
+	suite.Require().NotContains(cp.Output(), "acknowledgeable notification")
+
+	cp = ts.SpawnWithOpts(e2e.OptArgs("notifications", "-o", "json"), env)
+	cp.Expect(`"status":"snoozed"`)
+	cp.Expect(`"status":"acknowledged"`)
+	cp.ExpectExitCode(0)
+	AssertValidJSON(suite.T(), cp)
+
+	cp = ts.SpawnWithOpts(e2e.OptArgs("notifications", "snooze", "unknown"), env)
+	cp.Expect("No notification with ID 'unknown'")
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+}
+
 func (suite *NotificationIntegrationTestSuite) TestNotificationEndpoint_SetBeforeInvocation() {
 	suite.OnlyRunForTags(tagsuite.Notifications)
 
@@ -1,8 +1,12 @@
 package integration
 
 import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/ActiveState/cli/internal/fileutils"
 	"github.com/ActiveState/cli/internal/locale"
 	"github.com/ActiveState/cli/internal/testhelpers/e2e"
 	"github.com/ActiveState/cli/internal/testhelpers/suite"
@@ -32,6 +36,33 @@ func (suite *ProgressIntegrationTestSuite) TestProgress() {
 	cp.ExpectExitCode(0)
 }
 
+func (suite *ProgressIntegrationTestSuite) TestProgress_NDJSON() {
+	suite.OnlyRunForTags(tagsuite.Progress)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	progressFile := filepath.Join(ts.Dirs.Work, "progress.ndjson")
+	cp := ts.Spawn("checkout", "ActiveState-CLI/Empty", "--progress", "ndjson:file:"+progressFile)
+	cp.Expect("Checked out", e2e.RuntimeSourcingTimeoutOpt)
+	cp.ExpectExitCode(0)
+
+	contents := strings.TrimSpace(string(fileutils.ReadFileUnsafe(progressFile)))
+	lines := strings.Split(contents, "\n")
+	suite.Require().NotEmpty(lines)
+	for _, line := range lines {
+		event := map[string]interface{}{}
+		suite.Require().NoError(json.Unmarshal([]byte(line), &event), line)
+		suite.NotEmpty(event["event"])
+		suite.NotEmpty(event["time"])
+	}
+	suite.Contains(lines[0], `"event":"Start"`)
+	suite.Contains(lines[len(lines)-1], `"event":"Success"`)
+
+	cp = ts.Spawn("refresh", "--progress", "json")
+	cp.Expect("Invalid progress target")
+	cp.ExpectNotExitCode(0)
+}
+
 func TestProgressIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(ProgressIntegrationTestSuite))
 }
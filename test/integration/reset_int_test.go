@@ -7,6 +7,7 @@ import (
 
 	"github.com/ActiveState/cli/internal/constants"
 	"github.com/ActiveState/cli/internal/fileutils"
+	"github.com/ActiveState/cli/internal/prompt"
 	"github.com/ActiveState/cli/internal/testhelpers/e2e"
 	"github.com/ActiveState/cli/internal/testhelpers/suite"
 	"github.com/ActiveState/cli/internal/testhelpers/tagsuite"
@@ -95,6 +96,42 @@ func (suite *ResetIntegrationTestSuite) TestRevertInvalidURL() {
 	cp.ExpectExitCode(0)
 }
 
+func (suite *ResetIntegrationTestSuite) TestAnswers() {
+	suite.OnlyRunForTags(tagsuite.Reset)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	cp := ts.Spawn("--dump-prompts", "reset")
+	cp.Expect(prompt.IDResetConfirm)
+	cp.ExpectExitCode(0)
+
+	ts.PrepareEmptyProject()
+	commitID, err := localcommit.Get(ts.Dirs.Work)
+	suite.Require().NoError(err)
+
+	contents := fileutils.ReadFileUnsafe(filepath.Join(ts.Dirs.Work, constants.ConfigFileName))
+	contents = bytes.Replace(contents, []byte(commitID.String()), []byte(""), 1)
+	err = fileutils.WriteFile(filepath.Join(ts.Dirs.Work, constants.ConfigFileName), contents)
+	suite.Require().NoError(err)
+
+	answers := filepath.Join(ts.Dirs.Work, "answers.yaml")
+	suite.Require().NoError(fileutils.WriteFile(answers, []byte(prompt.IDPushName+": name\n")))
+	cp = ts.Spawn("reset", "-n", "--answers", answers)
+	cp.Expect("is not answered by the answers file")
+	cp.ExpectNotExitCode(0)
+
+	suite.Require().NoError(fileutils.WriteFile(answers, []byte(prompt.IDResetConfirm+": false\n")))
+	cp = ts.SpawnWithOpts(e2e.OptArgs("reset", "-n"), e2e.OptAppendEnv(constants.AnswersEnvVarName+"="+answers))
+	cp.Expect("Reset aborted by user")
+	cp.ExpectNotExitCode(0)
+
+	suite.Require().NoError(fileutils.WriteFile(answers, []byte(prompt.IDResetConfirm+": true\n")))
+	cp = ts.Spawn("reset", "-n", "--answers", answers)
+	cp.Expect("from the answers file")
+	cp.Expect("Successfully reset to commit: " + commitID.String())
+	cp.ExpectExitCode(0)
+}
+
 func TestResetIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(ResetIntegrationTestSuite))
 }
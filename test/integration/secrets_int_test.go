@@ -2,6 +2,7 @@ package integration
 
 import (
 	"encoding/json"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -104,6 +105,51 @@ scripts:
 	cp.ExpectExitCode(0)
 }
 
+func (suite *SecretsIntegrationTestSuite) TestSecret_Providers() {
+	suite.OnlyRunForTags(tagsuite.Secrets)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareActiveStateYAML(strings.TrimSpace(`
+project: https://platform.activestate.com/ActiveState-CLI/secrets-test
+secrets:
+  project:
+    - name: env-secret
+      provider: env
+      from: SECRETS_TEST_VALUE
+  user:
+    - name: file-secret
+      provider: file
+      from: secrets.yaml#token
+scripts:
+  - name: provided-secrets
+    language: bash
+    standalone: true
+    value: echo $secrets.project.env-secret $secrets.user.file-secret
+`))
+	ts.PrepareCommitIdFile("c7f8f45d-39e2-4f22-bd2e-4182b914880f")
+	ts.PrepareFile(filepath.Join(ts.Dirs.Work, "secrets.yaml"), "token: file-value\n")
+
+	cp := ts.SpawnWithOpts(
+		e2e.OptArgs("secrets", "get", "project.env-secret"),
+		e2e.OptAppendEnv("SECRETS_TEST_VALUE=env-value"),
+	)
+	cp.Expect("env-value")
+	cp.ExpectExitCode(0)
+
+	cp = ts.SpawnWithOpts(
+		e2e.OptArgs("run", "provided-secrets"),
+		e2e.OptAppendEnv("SECRETS_TEST_VALUE=env-value"),
+	)
+	cp.Expect("env-value file-value")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("secrets", "set", "user.file-secret", "value")
+	cp.Expect("comes from the file provider")
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+}
+
 func clearSecrets(ts *e2e.Session, unset ...string) {
 	for _, secret := range unset {
 		cp := ts.Spawn("secrets", "set", secret, "")
@@ -113,6 +113,42 @@ func (suite *ShowIntegrationTestSuite) TestJSON() {
 	AssertValidJSON(suite.T(), cp)
 }
 
+func (suite *ShowIntegrationTestSuite) TestShowConstants() {
+	suite.OnlyRunForTags(tagsuite.Show)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	ts.PrepareActiveStateYAML(strings.TrimSpace(`
+project: "https://platform.activestate.com/cli-integration-tests/Show?branch=main"
+constants:
+  - name: PORT
+    type: int
+    default: 8080
+  - name: MODE
+    type: enum
+    values: [dev, prod]
+    value: staging
+`))
+	ts.PrepareCommitIdFile("d5d84598-fc2e-4a45-b075-a845e587b5bf")
+
+	cp := ts.Spawn("show", "constants")
+	cp.Expect("PORT")
+	cp.Expect("8080")
+	cp.Expect("default")
+	cp.Expect("MODE")
+	cp.Expect("invalid")
+	cp.Expect("must be one of: dev, prod")
+	cp.ExpectExitCode(0)
+
+	cp = ts.SpawnWithOpts(
+		e2e.OptArgs("show", "constants", "-o", "json"),
+		e2e.OptAppendEnv(constants.ConstantEnvVarPrefix+"PORT=9090"),
+	)
+	cp.Expect(`"value":"9090","source":"environment"`)
+	cp.ExpectExitCode(0)
+	AssertValidJSON(suite.T(), cp)
+}
+
 func TestShowIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(ShowIntegrationTestSuite))
 }
@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/ActiveState/cli/internal/testhelpers/e2e"
+	"github.com/ActiveState/cli/internal/testhelpers/suite"
+	"github.com/ActiveState/cli/internal/testhelpers/tagsuite"
+)
+
+type UsageIntegrationTestSuite struct {
+	tagsuite.Suite
+}
+
+func (suite *UsageIntegrationTestSuite) TestUsage() {
+	suite.OnlyRunForTags(tagsuite.Usage)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	cp := ts.Spawn("usage")
+	cp.Expect("No runtime usage has been recorded yet")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("usage", "-o", "json")
+	cp.Expect(`[]`)
+	cp.ExpectExitCode(0)
+	AssertValidJSON(suite.T(), cp)
+}
+
+func (suite *UsageIntegrationTestSuite) TestUsage_Idle() {
+	suite.OnlyRunForTags(tagsuite.Usage)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	cp := ts.Spawn("usage", "idle")
+	cp.Expect("No runtimes have been idle for 30 days")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("usage", "idle", "--days", "0")
+	cp.Expect("The number of days must be at least 1")
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+}
+
+func TestUsageIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(UsageIntegrationTestSuite))
+}
@@ -0,0 +1,73 @@
+package integration
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/ActiveState/cli/internal/constants"
+	"github.com/ActiveState/cli/internal/testhelpers/e2e"
+	"github.com/ActiveState/cli/internal/testhelpers/suite"
+	"github.com/ActiveState/cli/internal/testhelpers/tagsuite"
+)
+
+type WorkspaceIntegrationTestSuite struct {
+	tagsuite.Suite
+}
+
+func (suite *WorkspaceIntegrationTestSuite) prepareWorkspace(ts *e2e.Session) {
+	for _, member := range []string{"api", "worker"} {
+		ts.PrepareFile(filepath.Join(ts.Dirs.Work, "services", member, constants.ConfigFileName),
+			fmt.Sprintf("project: https://%s/ActiveState-CLI/Empty?commitID=6d79f2ae-f8b5-46bd-917a-d4b2558ec7b8", constants.DefaultAPIHost))
+	}
+	ts.PrepareFile(filepath.Join(ts.Dirs.Work, constants.WorkspaceFileName), "members:\n  - services/*\n")
+}
+
+func (suite *WorkspaceIntegrationTestSuite) TestRefreshAll() {
+	suite.OnlyRunForTags(tagsuite.Workspace, tagsuite.Refresh)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	suite.prepareWorkspace(ts)
+
+	cp := ts.Spawn("refresh", "--all")
+	cp.Expect("services/api", e2e.RuntimeSourcingTimeoutOpt)
+	cp.Expect("services/worker", e2e.RuntimeSourcingTimeoutOpt)
+	cp.Expect("unique artifacts across 2 projects")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("refresh", "--all", "-o", "json")
+	cp.Expect(`"shared_artifacts":`)
+	cp.ExpectExitCode(0)
+	AssertValidJSON(suite.T(), cp)
+
+	cp = ts.SpawnWithOpts(
+		e2e.OptArgs("refresh", "--all"),
+		e2e.OptWD(filepath.Join(ts.Dirs.Work, "services")),
+	)
+	cp.Expect("Up to date")
+	cp.ExpectExitCode(0)
+}
+
+func (suite *WorkspaceIntegrationTestSuite) TestExecProject() {
+	suite.OnlyRunForTags(tagsuite.Workspace, tagsuite.Exec)
+	ts := e2e.New(suite.T(), false)
+	defer ts.Close()
+
+	suite.prepareWorkspace(ts)
+
+	cp := ts.Spawn("exec", "--project", "worker", "--", "echo", "hello")
+	cp.Expect(filepath.Join("services", "worker"), e2e.RuntimeSourcingTimeoutOpt)
+	cp.Expect("hello")
+	cp.ExpectExitCode(0)
+
+	cp = ts.Spawn("exec", "--project", "frontend", "--", "echo", "hello")
+	cp.Expect("The workspace has no member named")
+	cp.Expect("services/api, services/worker")
+	cp.ExpectExitCode(1)
+	ts.IgnoreLogErrors()
+}
+
+func TestWorkspaceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkspaceIntegrationTestSuite))
+}